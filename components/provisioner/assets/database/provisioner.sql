@@ -13,6 +13,8 @@ CREATE TABLE cluster
     is_kubeconfig_encrypted boolean NOT NULL
 );
 
+CREATE INDEX cluster_creation_timestamp_idx ON cluster (creation_timestamp, id);
+
 -- Cluster Config
 
 CREATE TABLE gardener_config
@@ -47,6 +49,13 @@ CREATE TABLE gardener_config
     shoot_networking_filter_disabled boolean,
     control_plane_failure_tolerance varchar(256),
     eu_access boolean NOT NULL,
+    hibernation_schedules jsonb,
+    worker_labels jsonb,
+    worker_taints jsonb,
+    kubelet_config jsonb,
+    cri_name varchar(256),
+    extensions jsonb,
+    networking_cidrs_checked boolean NOT NULL DEFAULT false,
     UNIQUE(cluster_id),
     foreign key (cluster_id) REFERENCES cluster (id) ON DELETE CASCADE
 );
@@ -56,7 +65,8 @@ CREATE TABLE gardener_config
 CREATE TYPE operation_state AS ENUM (
     'IN_PROGRESS',
     'SUCCEEDED',
-    'FAILED'
+    'FAILED',
+    'CANCELLED'
     );
 
 CREATE TYPE operation_type AS ENUM (
@@ -66,6 +76,7 @@ CREATE TYPE operation_type AS ENUM (
     'RECONNECT_RUNTIME',
     'UPGRADE_SHOOT',
     'HIBERNATE',
+    'WAKE_UP',
     'PROVISION_NO_INSTALL',
     'DEPROVISION_NO_INSTALL'
     );
@@ -84,9 +95,37 @@ CREATE TABLE operation
     last_transition timestamp without time zone,
     err_message text NOT NULL,
     reason text NOT NULL,
-    component text NOT NULL
+    component text NOT NULL,
+    lease_owner varchar(256),
+    lease_heartbeat timestamp without time zone,
+    lease_expiration timestamp without time zone,
+    cancel_requested boolean NOT NULL DEFAULT false,
+    trace_context text NOT NULL DEFAULT '',
+    resources_reverted boolean NOT NULL DEFAULT false,
+    warnings jsonb
 );
 
+CREATE INDEX operation_cluster_id_start_timestamp_idx ON operation (cluster_id, start_timestamp);
+CREATE INDEX operation_start_timestamp_idx ON operation (start_timestamp, id);
+
+-- Operation Stage History
+
+CREATE TABLE operation_stage_history
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE,
+    stage varchar(256) NOT NULL,
+    message text,
+    event_timestamp timestamp without time zone NOT NULL,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL,
+    retries integer NOT NULL DEFAULT 0
+);
+
+CREATE INDEX operation_stage_history_operation_id_idx ON operation_stage_history (operation_id);
+
 -- Kyma Release
 
 CREATE TABLE kyma_release
@@ -155,6 +194,16 @@ CREATE TABLE runtime_upgrade
     foreign key (post_upgrade_kyma_config_id) REFERENCES kyma_config (id) ON DELETE CASCADE
 );
 
+-- Shoot Upgrade
+
+CREATE TABLE shoot_upgrade
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL UNIQUE,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE,
+    pre_upgrade_gardener_config jsonb NOT NULL
+);
+
 -- Cluster administrators
 
 CREATE TABLE cluster_administrator
@@ -212,3 +261,29 @@ CREATE TABLE dns_providers
     type varchar(256) NOT NULL,
     foreign key (dns_config_id) REFERENCES dns_config (id) ON DELETE CASCADE
 );
+
+-- Worker pool
+
+CREATE TABLE worker_pool
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    gardener_config_id uuid NOT NULL,
+    name varchar(256) NOT NULL,
+    machine_type varchar(256) NOT NULL,
+    machine_image varchar(256),
+    machine_image_version varchar(256),
+    disk_type varchar(256),
+    volume_size_gb integer,
+    auto_scaler_min integer NOT NULL,
+    auto_scaler_max integer NOT NULL,
+    max_surge integer NOT NULL,
+    max_unavailable integer NOT NULL,
+    zones jsonb,
+    labels jsonb,
+    taints jsonb,
+    kubelet_config jsonb,
+    cri_name varchar(256),
+    pool_order integer NOT NULL,
+    UNIQUE(gardener_config_id, name),
+    foreign key (gardener_config_id) REFERENCES gardener_config (id) ON DELETE CASCADE
+);
@@ -16,8 +16,11 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/director"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/graphql"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/oauth"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -36,9 +39,13 @@ func newProvisioningService(
 	dbsFactory dbsession.Factory,
 	directorService director.DirectorClient,
 	shootProvider gardener.ShootProvider,
+	upgradeChecker gardener.UpgradeChecker,
+	driftReporter gardener.VersionDriftReporter,
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 	defaultEnableKubernetesVersionAutoUpdate,
 	defaultEnableMachineImageVersionAutoUpdate bool) provisioning.Service {
 
@@ -47,10 +54,10 @@ func newProvisioningService(
 	inputConverter := provisioning.NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
 	graphQLConverter := provisioning.NewGraphQLConverter()
 
-	return provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorService, dbsFactory, provisioner, uuidGenerator, shootProvider, provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+	return provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorService, dbsFactory, provisioner, uuidGenerator, shootProvider, upgradeChecker, driftReporter, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 }
 
-func newDirectorClient(config config) (director.DirectorClient, error) {
+func newDirectorOAuthClient(config config) (oauth.Client, error) {
 	file, err := os.ReadFile(config.DirectorOAuthPath)
 	if err != nil {
 		return nil, errors.Wrap(err, "Failed to open director config")
@@ -62,10 +69,13 @@ func newDirectorClient(config config) (director.DirectorClient, error) {
 		return nil, errors.Wrap(err, "Failed to unmarshal director config")
 	}
 
+	return oauth.NewOauthClient(newHTTPClient(config.SkipDirectorCertVerification), cfg.Data.ClientID, cfg.Data.ClientSecret, cfg.Data.TokensEndpoint), nil
+}
+
+func newDirectorClient(config config, oauthClient oauth.Client) director.DirectorClient {
 	gqlClient := graphql.NewGraphQLClient(config.DirectorURL, true, config.SkipDirectorCertVerification)
-	oauthClient := oauth.NewOauthClient(newHTTPClient(config.SkipDirectorCertVerification), cfg.Data.ClientID, cfg.Data.ClientSecret, cfg.Data.TokensEndpoint)
 
-	return director.NewDirectorClient(gqlClient, oauthClient), nil
+	return director.NewDirectorClient(gqlClient, oauthClient)
 }
 
 type DirectorOAuth struct {
@@ -76,7 +86,7 @@ type DirectorOAuth struct {
 	} `json:"data"`
 }
 
-func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string) (*gardener.ShootController, error) {
+func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient.Config, dbsFactory dbsession.Factory, auditLogTenantConfigPath string, operationQueues map[model.OperationType]gardener.OperationQueue) (*gardener.ShootController, error) {
 
 	syncPeriod := defaultSyncPeriod
 
@@ -85,7 +95,7 @@ func newShootController(gardenerNamespace string, gardenerClusterCfg *restclient
 		return nil, fmt.Errorf("unable to create shoot controller manager: %w", err)
 	}
 
-	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath)
+	return gardener.NewShootController(mgr, dbsFactory, auditLogTenantConfigPath, operationQueues)
 }
 
 func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
@@ -99,6 +109,10 @@ func newGardenerClusterConfig(cfg config) (*restclient.Config, error) {
 		return nil, fmt.Errorf("failed to create Gardener cluster config: %s", err.Error())
 	}
 
+	gardenerClusterConfig.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return metrics.InstrumentRoundTripper(metrics.GardenerClient, tracing.NewTransport(rt))
+	})
+
 	return gardenerClusterConfig, nil
 }
 
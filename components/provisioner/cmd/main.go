@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"os"
 	"sync"
 	"time"
 
@@ -25,8 +26,11 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	provisioningStages "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/keyrotation"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/runtime"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/pkg/errors"
@@ -56,23 +60,45 @@ type config struct {
 		SSLMode     string `envconfig:"default=disable"`
 		SSLRootCert string `envconfig:"optional"`
 		SecretKey   string `envconfig:"optional"`
+
+		EncryptionKeys        []string `envconfig:"APP_DATABASE_ENCRYPTION_KEYS,optional"`
+		ActiveEncryptionKeyID string   `envconfig:"APP_DATABASE_ACTIVE_ENCRYPTION_KEY_ID,optional"`
+		ReEncryptionBatchSize int      `envconfig:"default=100"`
+		ReEncryptLegacyValues bool     `envconfig:"default=false"`
 	}
 
 	ProvisioningTimeout   queue.ProvisioningTimeouts
 	DeprovisioningTimeout queue.DeprovisioningTimeouts
 	HibernationTimeout    queue.HibernationTimeouts
 
+	ProvisioningBackoff   queue.ProvisioningBackoffs
+	DeprovisioningBackoff queue.DeprovisioningBackoffs
+	HibernationBackoff    queue.HibernationBackoffs
+
+	OperationLease queue.OperationLeaseConfig
+
+	FailureHandling queue.FailureHandlingConfig
+
+	Tracing tracing.Config
+
+	Readiness healthz.ReadinessConfig
+
+	NetworkingBackfill gardener.NetworkingBackfillConfig
+
 	OperatorRoleBinding provisioningStages.OperatorRoleBinding
 
 	Gardener struct {
-		Project                                    string `envconfig:"default=gardenerProject"`
-		KubeconfigPath                             string `envconfig:"default=./dev/kubeconfig.yaml"`
-		AuditLogsPolicyConfigMap                   string `envconfig:"optional"`
-		AuditLogsTenantConfigPath                  string `envconfig:"optional"`
-		MaintenanceWindowConfigPath                string `envconfig:"optional"`
-		ClusterCleanupResourceSelector             string `envconfig:"default=https://service-manager."`
-		DefaultEnableKubernetesVersionAutoUpdate   bool   `envconfig:"default=false"`
-		DefaultEnableMachineImageVersionAutoUpdate bool   `envconfig:"default=false"`
+		Project                                    string   `envconfig:"default=gardenerProject"`
+		KubeconfigPath                             string   `envconfig:"default=./dev/kubeconfig.yaml"`
+		AuditLogsPolicyConfigMap                   string   `envconfig:"optional"`
+		AuditLogsTenantConfigPath                  string   `envconfig:"optional"`
+		MaintenanceWindowConfigPath                string   `envconfig:"optional"`
+		HibernationPolicyConfigPath                string   `envconfig:"optional"`
+		ClusterCleanupResourceSelector             string   `envconfig:"default=https://service-manager."`
+		DefaultEnableKubernetesVersionAutoUpdate   bool     `envconfig:"default=false"`
+		DefaultEnableMachineImageVersionAutoUpdate bool     `envconfig:"default=false"`
+		ReservedSeedCIDRs                          []string `envconfig:"APP_GARDENER_RESERVED_SEED_CIDRS,optional"`
+		AllowedExtensionTypes                      []string `envconfig:"optional"`
 	}
 
 	LatestDownloadedReleases int  `envconfig:"default=5"`
@@ -89,7 +115,7 @@ func (c *config) String() string {
 	return fmt.Sprintf("Address: %s, APIEndpoint: %s, DirectorURL: %s, "+
 		"SkipDirectorCertVerification: %v, DirectorOAuthPath: %s, "+
 		"DatabaseUser: %s, DatabaseHost: %s, DatabasePort: %s, "+
-		"DatabaseName: %s, DatabaseSSLMode: %s, "+
+		"DatabaseName: %s, DatabaseSSLMode: %s, DatabaseReEncryptLegacyValues: %v, "+
 		"ProvisioningTimeoutClusterCreation: %s "+
 		"ProvisioningTimeoutInstallation: %s, ProvisioningTimeoutUpgrade: %s, "+
 		"ProvisioningTimeoutAgentConfiguration: %s, ProvisioningTimeoutAgentConnection: %s, "+
@@ -98,12 +124,16 @@ func (c *config) String() string {
 		"OperatorRoleBindingL2SubjectName: %s, OperatorRoleBindingL3SubjectName: %s, OperatorRoleBindingCreatingForAdmin: %t "+
 		"GardenerProject: %s, GardenerKubeconfigPath: %s, GardenerAuditLogsPolicyConfigMap: %s, AuditLogsTenantConfigPath: %s, "+
 		"LatestDownloadedReleases: %d, DownloadPreReleases: %v, "+
-		"EnqueueInProgressOperations: %v"+
+		"EnqueueInProgressOperations: %v, "+
+		"OperationLeaseOwner: %s, OperationLeaseDuration: %s, OperationLeaseReclaimInterval: %s, "+
+		"FailureHandlingKeepProvisioningResources: %v, "+
+		"ReadinessCheckTimeout: %s, ReadinessCacheTTL: %s, "+
+		"NetworkingBackfillEnabled: %v, NetworkingBackfillTimeout: %s, "+
 		"LogLevel: %s",
 		c.Address, c.APIEndpoint, c.DirectorURL,
 		c.SkipDirectorCertVerification, c.DirectorOAuthPath,
 		c.Database.User, c.Database.Host, c.Database.Port,
-		c.Database.Name, c.Database.SSLMode,
+		c.Database.Name, c.Database.SSLMode, c.Database.ReEncryptLegacyValues,
 		c.ProvisioningTimeout.ClusterCreation.String(),
 		c.ProvisioningTimeout.Installation.String(), c.ProvisioningTimeout.Upgrade.String(),
 		c.ProvisioningTimeout.AgentConfiguration.String(), c.ProvisioningTimeout.AgentConnection.String(),
@@ -113,6 +143,10 @@ func (c *config) String() string {
 		c.Gardener.Project, c.Gardener.KubeconfigPath, c.Gardener.AuditLogsPolicyConfigMap, c.Gardener.AuditLogsTenantConfigPath,
 		c.LatestDownloadedReleases, c.DownloadPreReleases,
 		c.EnqueueInProgressOperations,
+		c.OperationLease.Owner, c.OperationLease.Duration.String(), c.OperationLease.ReclaimInterval.String(),
+		c.FailureHandling.KeepProvisioningResources,
+		c.Readiness.CheckTimeout.String(), c.Readiness.CacheTTL.String(),
+		c.NetworkingBackfill.Enabled, c.NetworkingBackfill.Timeout.String(),
 		c.LogLevel)
 }
 
@@ -133,16 +167,36 @@ func main() {
 	}
 	log.SetLevel(logLevel)
 
+	if cfg.OperationLease.Owner == "" {
+		cfg.OperationLease.Owner, err = os.Hostname()
+		exitOnError(err, "Failed to determine operation lease owner")
+	}
+
 	log.Infof("Starting Provisioner")
 	log.Infof("Config: %s", cfg.String())
 
+	exitOnError(cfg.ProvisioningBackoff.Validate(cfg.OperationLease.Duration), "Invalid provisioning backoff config")
+	exitOnError(cfg.DeprovisioningBackoff.Validate(cfg.OperationLease.Duration), "Invalid deprovisioning backoff config")
+	exitOnError(cfg.HibernationBackoff.Validate(cfg.OperationLease.Duration), "Invalid hibernation backoff config")
+
+	shutdownTracing, err := tracing.Setup(cfg.Tracing)
+	exitOnError(err, "Failed to initialize tracing")
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Errorf("Failed to flush traces: %s", err.Error())
+		}
+	}()
+
 	connString := fmt.Sprintf(connStringFormat, cfg.Database.Host, cfg.Database.Port, cfg.Database.User,
 		cfg.Database.Password, cfg.Database.Name, cfg.Database.SSLMode, cfg.Database.SSLRootCert)
 
 	connection, err := database.InitializeDatabaseConnection(connString, databaseConnectionRetries)
 	exitOnError(err, "Failed to initialize persistence")
 
-	dbsFactory, err := dbsession.NewFactory(connection, cfg.Database.SecretKey)
+	keyring, err := dbsession.NewKeyring(cfg.Database.ActiveEncryptionKeyID, cfg.Database.EncryptionKeys, cfg.Database.SecretKey)
+	exitOnError(err, "Failed to initialize encryption keyring")
+
+	dbsFactory, err := dbsession.NewFactory(connection, keyring)
 
 	exitOnError(err, "Cannot create database session")
 
@@ -164,8 +218,9 @@ func main() {
 
 	shootClient := gardenerClientSet.Shoots(gardenerNamespace)
 
-	directorClient, err := newDirectorClient(cfg)
+	directorOAuthClient, err := newDirectorOAuthClient(cfg)
 	exitOnError(err, "Failed to initialize Director client")
+	directorClient := newDirectorClient(cfg, directorOAuthClient)
 
 	k8sClientProvider := k8s.NewK8sClientProvider()
 
@@ -173,8 +228,13 @@ func main() {
 	adminKubeconfigRequest := gardenerClient.SubResource("adminkubeconfig")
 	kubeconfigProvider := gardener.NewKubeconfigProvider(shootClient, adminKubeconfigRequest, secretsInterface)
 
+	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath, cfg.Gardener.HibernationPolicyConfigPath)
+
 	provisioningQueue := queue.CreateProvisioningQueue(
 		cfg.ProvisioningTimeout,
+		cfg.ProvisioningBackoff,
+		cfg.OperationLease,
+		cfg.FailureHandling,
 		dbsFactory,
 		directorClient,
 		shootClient,
@@ -183,12 +243,23 @@ func main() {
 		runtimeConfigurator,
 		kubeconfigProvider)
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, dbsFactory, directorClient, shootClient)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(cfg.DeprovisioningTimeout, cfg.DeprovisioningBackoff, cfg.OperationLease, dbsFactory, directorClient, shootClient)
+
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, cfg.ProvisioningBackoff, cfg.OperationLease, dbsFactory, directorClient, shootClient, provisioner, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(cfg.ProvisioningTimeout, dbsFactory, directorClient, shootClient, cfg.OperatorRoleBinding, k8sClientProvider, kubeconfigProvider)
+	hibernationQueue := queue.CreateHibernationQueue(cfg.HibernationTimeout, cfg.HibernationBackoff, cfg.OperationLease, dbsFactory, directorClient, shootClient)
 
-	provisioner := gardener.NewProvisioner(gardenerNamespace, shootClient, dbsFactory, cfg.Gardener.AuditLogsPolicyConfigMap, cfg.Gardener.MaintenanceWindowConfigPath)
-	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath)
+	wakeUpQueue := queue.CreateWakeUpQueue(cfg.HibernationTimeout, cfg.HibernationBackoff, cfg.OperationLease, dbsFactory, directorClient, shootClient)
+
+	// Shoot changes trigger processing of the operations waiting for them instead of waiting for the next poll
+	shootOperationQueues := map[model.OperationType]gardener.OperationQueue{
+		model.Provision:            provisioningQueue,
+		model.DeprovisionNoInstall: deprovisioningQueue,
+		model.UpgradeShoot:         shootUpgradeQueue,
+		model.Hibernate:            hibernationQueue,
+		model.WakeUp:               wakeUpQueue,
+	}
+	shootController, err := newShootController(gardenerNamespace, gardenerClusterConfig, dbsFactory, cfg.Gardener.AuditLogsTenantConfigPath, shootOperationQueues)
 	exitOnError(err, "Failed to create Shoot controller.")
 	go func() {
 		err := shootController.StartShootController()
@@ -201,14 +272,21 @@ func main() {
 		dbsFactory,
 		directorClient,
 		gardener.NewShootProvider(shootClient),
+		gardener.NewUpgradeChecker(gardenerClientSet.CloudProfiles(), gardenerClientSet.Seeds(), gardener.NewAPIServerMetricsFetcher(k8sClientProvider)),
+		gardener.NewVersionDriftReporter(dbsFactory.NewReadSession(), gardenerClientSet.CloudProfiles()),
 		provisioningQueue,
 		deprovisioningQueue,
 		shootUpgradeQueue,
+		hibernationQueue,
+		wakeUpQueue,
 		cfg.Gardener.DefaultEnableKubernetesVersionAutoUpdate,
 		cfg.Gardener.DefaultEnableMachineImageVersionAutoUpdate)
 
 	tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
-	validator := api.NewValidator()
+	reservedSeedCIDRs, err := util.ParseCIDRs(cfg.Gardener.ReservedSeedCIDRs)
+	exitOnError(err, "Failed to parse reserved seed CIDRs")
+
+	validator := api.NewValidator(reservedSeedCIDRs, cfg.Gardener.AllowedExtensionTypes)
 	resolver := api.NewResolver(provisioningSVC, validator, tenantUpdater)
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -220,6 +298,21 @@ func main() {
 
 	shootUpgradeQueue.Run(ctx.Done())
 
+	hibernationQueue.Run(ctx.Done())
+
+	wakeUpQueue.Run(ctx.Done())
+
+	orphanedOperationsReclaimer := queue.NewOrphanedOperationsReclaimer(
+		dbsFactory.NewReadSession(),
+		map[model.OperationType]queue.OperationQueue{
+			model.Provision:            provisioningQueue,
+			model.DeprovisionNoInstall: deprovisioningQueue,
+			model.UpgradeShoot:         shootUpgradeQueue,
+			model.Hibernate:            hibernationQueue,
+			model.WakeUp:               wakeUpQueue,
+		},
+		cfg.OperationLease.ReclaimInterval)
+
 	gqlCfg := gqlschema.Config{
 		Resolvers: resolver,
 	}
@@ -229,6 +322,7 @@ func main() {
 
 	log.Infof("Registering endpoint on %s...", cfg.APIEndpoint)
 	router := mux.NewRouter()
+	router.Use(tracing.Middleware)
 	router.Use(middlewares.ExtractTenant)
 
 	router.HandleFunc("/", playground.Handler("Dataloader", cfg.PlaygroundAPIEndpoint))
@@ -237,12 +331,24 @@ func main() {
 	gqlHandler.AddTransport(transport.POST{})
 	gqlHandler.AddTransport(transport.GET{})
 	gqlHandler.Use(extension.Introspection{})
+	gqlHandler.Use(tracing.GraphQLExtension{})
 	gqlHandler.SetErrorPresenter(presenter.Do)
 	router.Handle(cfg.APIEndpoint, gqlHandler)
 	router.HandleFunc("/healthz", healthz.NewHTTPHandler(log.StandardLogger()))
+	router.Handle("/readyz", healthz.NewReadinessHandler(log.StandardLogger(), cfg.Readiness, map[string]healthz.Check{
+		"database": healthz.NewDatabaseCheck(connection),
+		"gardener": healthz.NewGardenerCheck(shootClient),
+		"director": healthz.NewDirectorCheck(directorOAuthClient),
+	}))
 
 	// Metrics
-	err = metrics.Register(dbsFactory.NewReadSession())
+	err = metrics.Register(dbsFactory.NewReadSession(), map[string]metrics.QueueStatsGetter{
+		"provisioning":   provisioningQueue,
+		"deprovisioning": deprovisioningQueue,
+		"shoot_upgrade":  shootUpgradeQueue,
+		"hibernation":    hibernationQueue,
+		"wake_up":        wakeUpQueue,
+	})
 	exitOnError(err, "Failed to register metrics collectors")
 
 	// Expose metrics on different port as it cannot be secured with mTLS
@@ -275,14 +381,21 @@ func main() {
 	}()
 
 	if cfg.EnqueueInProgressOperations {
-		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+		err = enqueueOperationsInProgress(dbsFactory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 		exitOnError(err, "Failed to enqueue in progress operations")
 	}
 
+	if cfg.NetworkingBackfill.Enabled {
+		go gardener.NewNetworkingBackfill(dbsFactory.NewReadWriteSession(), shootClient, cfg.NetworkingBackfill.Timeout).Run()
+	}
+	go keyrotation.NewReEncryptionJob(dbsFactory.NewReadWriteSession(), cfg.Database.ReEncryptionBatchSize, cfg.Database.ReEncryptLegacyValues).Run()
+
+	orphanedOperationsReclaimer.Run(ctx.Done())
+
 	wg.Wait()
 }
 
-func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue queue.OperationQueue) error {
+func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue queue.OperationQueue) error {
 	readSession := dbFactory.NewReadSession()
 
 	var inProgressOps []model.Operation
@@ -304,12 +417,16 @@ func enqueueOperationsInProgress(dbFactory dbsession.Factory, provisioningQueue,
 
 	for _, op := range inProgressOps {
 		switch op.Type {
-		case model.ProvisionNoInstall:
+		case model.Provision, model.ProvisionNoInstall:
 			provisioningQueue.Add(op.ID)
 		case model.DeprovisionNoInstall:
 			deprovisioningQueue.Add(op.ID)
 		case model.UpgradeShoot:
 			shootUpgradeQueue.Add(op.ID)
+		case model.Hibernate:
+			hibernationQueue.Add(op.ID)
+		case model.WakeUp:
+			wakeUpQueue.Add(op.ID)
 		}
 	}
 
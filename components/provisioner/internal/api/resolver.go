@@ -3,16 +3,22 @@ package api
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/api/middlewares"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/pkg/errors"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
+// defaultVersionDriftPeriodDays is the period in which versions are reported as expiring if the versionDrift query does not specify it
+const defaultVersionDriftPeriodDays = 30
+
 type Resolver struct {
 	provisioning  provisioning.Service
 	validator     Validator
@@ -42,7 +48,7 @@ func NewResolver(provisioningService provisioning.Service, validator Validator,
 	}
 }
 
-func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput) (*gqlschema.OperationStatus, error) {
+func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, dryRun *bool) (*gqlschema.OperationStatus, error) {
 	err := r.validator.ValidateProvisioningInput(config)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s", err)
@@ -57,9 +63,21 @@ func (r *Resolver) ProvisionRuntime(ctx context.Context, config gqlschema.Provis
 
 	subAccount := getSubAccount(ctx)
 
+	if util.UnwrapBoolOrDefault(dryRun, false) {
+		log.Infof("Requested dry run of provisioning of Runtime %s.", config.RuntimeInput.Name)
+
+		operationStatus, err := r.provisioning.DryRunProvisionRuntime(config, tenant, subAccount)
+		if err != nil {
+			log.Errorf("Failed dry run of provisioning of Runtime %s: %s", config.RuntimeInput.Name, err)
+			return nil, err
+		}
+
+		return operationStatus, nil
+	}
+
 	log.Infof("Requested provisioning of Runtime %s.", config.RuntimeInput.Name)
 
-	operationStatus, err := r.provisioning.ProvisionRuntime(config, tenant, subAccount)
+	operationStatus, err := r.provisioning.ProvisionRuntime(ctx, config, tenant, subAccount)
 	if err != nil {
 		log.Errorf("Failed to provision Runtime %s: %s", config.RuntimeInput.Name, err)
 		return nil, err
@@ -78,7 +96,7 @@ func (r *Resolver) DeprovisionRuntime(ctx context.Context, id string) (string, e
 		return "", err
 	}
 
-	operationID, err := r.provisioning.DeprovisionRuntime(id)
+	operationID, err := r.provisioning.DeprovisionRuntime(ctx, id)
 	if err != nil {
 		log.Errorf("Failed to deprovision Runtime %s: %s", id, err)
 		return "", err
@@ -143,10 +161,109 @@ func (r *Resolver) RuntimeOperationStatus(ctx context.Context, operationID strin
 	return status, nil
 }
 
-func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, error) {
+func (r *Resolver) VersionDrift(_ context.Context, provider *string, region *string, expiringWithinDays *int) ([]*gqlschema.VersionDriftGroup, error) {
+	log.Infof("Requested version drift report for provider %q and region %q.", util.UnwrapStrOrDefault(provider, ""), util.UnwrapStrOrDefault(region, ""))
+
+	days := util.UnwrapIntOrDefault(expiringWithinDays, defaultVersionDriftPeriodDays)
+	if days < 0 {
+		return nil, apperrors.BadRequest("expiringWithinDays must not be negative")
+	}
+
+	groups, err := r.provisioning.VersionDrift(util.UnwrapStrOrDefault(provider, ""), util.UnwrapStrOrDefault(region, ""), time.Duration(days)*24*time.Hour)
+	if err != nil {
+		log.Errorf("Failed to report version drift: %s", err)
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+func (r *Resolver) Runtimes(_ context.Context, filter *gqlschema.RuntimesFilter, page *gqlschema.PageInput) (*gqlschema.RuntimePage, error) {
+	log.Infof("Requested to list Runtimes.")
+
+	runtimes, err := r.provisioning.ListRuntimes(filter, page)
+	if err != nil {
+		log.Errorf("Failed to list Runtimes: %s", err)
+		return nil, err
+	}
+
+	return runtimes, nil
+}
+
+func (r *Resolver) Operations(_ context.Context, filter *gqlschema.OperationsFilter, page *gqlschema.PageInput) (*gqlschema.OperationPage, error) {
+	log.Infof("Requested to list operations.")
+
+	operations, err := r.provisioning.ListOperations(filter, page)
+	if err != nil {
+		log.Errorf("Failed to list operations: %s", err)
+		return nil, err
+	}
+
+	return operations, nil
+}
+
+func (r *Resolver) CancelOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to cancel Operation %s.", operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	status, err = r.provisioning.CancelOperation(operationID)
+	if err != nil {
+		log.Errorf("Failed to cancel Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	log.Infof("Cancellation of Operation %s requested.", operationID)
+
+	return status, nil
+}
+
+func (r *Resolver) RetryOperation(ctx context.Context, operationID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested to retry Operation %s.", operationID)
+
+	status, err := r.provisioning.RuntimeOperationStatus(operationID)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	err = r.tenantUpdater.GetAndUpdateTenant(*status.RuntimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	status, err = r.provisioning.RetryOperation(operationID)
+	if err != nil {
+		log.Errorf("Failed to retry Operation %s: %s", operationID, err)
+		return nil, err
+	}
+
+	log.Infof("Operation %s retried.", operationID)
+
+	return status, nil
+}
+
+func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput, dryRun *bool) (*gqlschema.OperationStatus, error) {
 	log.Infof("Requested to upgrade Gardener Shoot cluster specification for Runtime : %s.", runtimeID)
 
-	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	var err apperrors.AppError
+	if util.UnwrapBoolOrDefault(dryRun, false) {
+		// Dry run does not write to the database, so the tenant of the Runtime is only required, not updated
+		_, err = r.tenantUpdater.GetTenant(ctx)
+	} else {
+		err = r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	}
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime  %s: %s", runtimeID, err)
 		return nil, err
@@ -158,7 +275,17 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 		return nil, err
 	}
 
-	status, err := r.provisioning.UpgradeGardenerShoot(runtimeID, input)
+	if util.UnwrapBoolOrDefault(dryRun, false) {
+		status, err := r.provisioning.DryRunUpgradeGardenerShoot(runtimeID, input)
+		if err != nil {
+			log.Errorf("Failed dry run of upgrade of Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
+			return nil, err
+		}
+
+		return status, nil
+	}
+
+	status, err := r.provisioning.UpgradeGardenerShoot(ctx, runtimeID, input)
 	if err != nil {
 		log.Errorf("Failed to upgrade Gardener Shoot cluster specification for Runtime %s: %s", runtimeID, err)
 		return nil, err
@@ -169,8 +296,44 @@ func (r *Resolver) UpgradeShoot(ctx context.Context, runtimeID string, input gql
 	return status, nil
 }
 
-func (r *Resolver) HibernateRuntime(context.Context, string) (*gqlschema.OperationStatus, error) {
-	return nil, nil
+func (r *Resolver) HibernateRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested hibernation of Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.HibernateCluster(ctx, runtimeID)
+	if err != nil {
+		log.Errorf("Failed to hibernate Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	log.Infof("Hibernation started for Runtime %s. Operation id %s", runtimeID, *status.ID)
+
+	return status, nil
+}
+
+func (r *Resolver) WakeUpRuntime(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, error) {
+	log.Infof("Requested wake-up of Runtime %s.", runtimeID)
+
+	err := r.tenantUpdater.GetAndUpdateTenant(runtimeID, ctx)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	status, err := r.provisioning.WakeUpCluster(ctx, runtimeID)
+	if err != nil {
+		log.Errorf("Failed to wake up Runtime %s: %s", runtimeID, err)
+		return nil, err
+	}
+
+	log.Infof("Wake-up started for Runtime %s. Operation id %s", runtimeID, *status.ID)
+
+	return status, nil
 }
 
 func getSubAccount(ctx context.Context) string {
@@ -143,7 +143,7 @@ func azureGardenerClusterConfigInput(zones ...string) gqlschema.ClusterConfigInp
 			MachineImageVersion: util.StringPtr("8.0"),
 			DiskType:            util.StringPtr("Standard_LRS"),
 			VolumeSizeGb:        util.IntPtr(40),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
@@ -151,7 +151,7 @@ func azureGardenerClusterConfigInput(zones ...string) gqlschema.ClusterConfigInp
 			ExposureClassName:   util.StringPtr("exp-class"),
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
-					VnetCidr: "cidr",
+					VnetCidr: "10.250.0.0/16",
 					Zones:    zones,
 				},
 			},
@@ -174,14 +174,14 @@ func azureGardenerClusterConfigInputNoSeed(zones ...string) gqlschema.ClusterCon
 			MachineImageVersion: util.StringPtr("8.0"),
 			DiskType:            util.StringPtr("Standard_LRS"),
 			VolumeSizeGb:        util.IntPtr(40),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
 			MaxUnavailable:      2,
 			ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
 				AzureConfig: &gqlschema.AzureProviderConfigInput{
-					VnetCidr: "cidr",
+					VnetCidr: "10.250.0.0/16",
 					Zones:    zones,
 				},
 			},
@@ -203,7 +203,7 @@ func openStackGardenerClusterConfigInput() gqlschema.ClusterConfigInput {
 			MachineType:         "t3-xlarge",
 			MachineImage:        util.StringPtr("red-hat"),
 			MachineImageVersion: util.StringPtr("8.0"),
-			WorkerCidr:          "cidr",
+			WorkerCidr:          "10.250.0.0/16",
 			AutoScalerMin:       1,
 			AutoScalerMax:       5,
 			MaxSurge:            1,
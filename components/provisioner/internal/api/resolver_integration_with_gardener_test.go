@@ -35,6 +35,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/database"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/testutils"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning"
+	provisioningMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
 	runtimeConfig "github.com/kyma-project/control-plane/components/provisioner/internal/runtime"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
@@ -135,17 +136,22 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 	seedInterface := seeds.NewFakeSeedsInterface(t, cfg)
 	secretsInterface := setupSecretsClient(t, cfg)
 	secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-	dbsFactory, _ := dbsession.NewFactory(connection, secretKey)
+	keyring, err := dbsession.NewKeyring("", nil, secretKey)
+	require.NoError(t, err)
+	dbsFactory, _ := dbsession.NewFactory(connection, keyring)
 
 	queueCtx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
 	kubeconfigProviderMock := &kubeconfigprovidermock.KubeconfigProvider{}
-	kubeconfigProviderMock.On("FetchFromRequest", mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
-	kubeconfigProviderMock.On("FetchFromShoot", mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
+	kubeconfigProviderMock.On("FetchFromRequest", mock.Anything, mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
+	kubeconfigProviderMock.On("FetchFromShoot", mock.Anything, mock.AnythingOfType("string")).Return([]byte(mockedKubeconfig), nil)
 
 	provisioningQueue := queue.CreateProvisioningQueue(
 		testProvisioningTimeouts(),
+		queue.ProvisioningBackoffs{},
+		testOperationLease(),
+		queue.FailureHandlingConfig{},
 		dbsFactory,
 		directorServiceMock,
 		shootInterface,
@@ -155,13 +161,26 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 		kubeconfigProviderMock)
 	provisioningQueue.Run(queueCtx.Done())
 
-	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface)
+	deprovisioningQueue := queue.CreateDeprovisioningQueue(testDeprovisioningTimeouts(), queue.DeprovisioningBackoffs{}, testOperationLease(), dbsFactory, directorServiceMock, shootInterface)
 	deprovisioningQueue.Run(queueCtx.Done())
 
-	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), dbsFactory, directorServiceMock, shootInterface, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock)
+	shootUpgrader := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath, "")
+	shootUpgradeQueue := queue.CreateShootUpgradeQueue(testProvisioningTimeouts(), queue.ProvisioningBackoffs{}, testOperationLease(), dbsFactory, directorServiceMock, shootInterface, shootUpgrader, testOperatorRoleBinding(), mockK8sClientProvider, kubeconfigProviderMock)
 	shootUpgradeQueue.Run(queueCtx.Done())
 
-	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath)
+	hibernationQueue := queue.CreateHibernationQueue(queue.HibernationTimeouts{}, queue.HibernationBackoffs{}, testOperationLease(), dbsFactory, directorServiceMock, shootInterface)
+	hibernationQueue.Run(queueCtx.Done())
+
+	wakeUpQueue := queue.CreateWakeUpQueue(queue.HibernationTimeouts{}, queue.HibernationBackoffs{}, testOperationLease(), dbsFactory, directorServiceMock, shootInterface)
+	wakeUpQueue.Run(queueCtx.Done())
+
+	controler, err := gardener.NewShootController(mgr, dbsFactory, auditLogsConfigPath, map[model.OperationType]gardener.OperationQueue{
+		model.Provision:            provisioningQueue,
+		model.DeprovisionNoInstall: deprovisioningQueue,
+		model.UpgradeShoot:         shootUpgradeQueue,
+		model.Hibernate:            hibernationQueue,
+		model.WakeUp:               wakeUpQueue,
+	})
 	require.NoError(t, err)
 
 	go func() {
@@ -187,12 +206,12 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 			directorServiceMock.Calls = nil
 			directorServiceMock.ExpectedCalls = nil
 
-			directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything).Return(config.runtimeID, nil)
-			directorServiceMock.On("RuntimeExists", mock.Anything, mock.Anything).Return(true, nil)
-			directorServiceMock.On("DeleteRuntime", mock.Anything, mock.Anything).Return(nil)
-			directorServiceMock.On("GetConnectionToken", mock.Anything, mock.Anything).Return(graphql.OneTimeTokenForRuntimeExt{}, nil)
+			directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, mock.Anything).Return(config.runtimeID, nil)
+			directorServiceMock.On("RuntimeExists", mock.Anything, mock.Anything, mock.Anything).Return(true, nil)
+			directorServiceMock.On("DeleteRuntime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			directorServiceMock.On("GetConnectionToken", mock.Anything, mock.Anything, mock.Anything).Return(graphql.OneTimeTokenForRuntimeExt{}, nil)
 
-			directorServiceMock.On("GetRuntime", mock.Anything, mock.Anything).Return(graphql.RuntimeExt{
+			directorServiceMock.On("GetRuntime", mock.Anything, mock.Anything, mock.Anything).Return(graphql.RuntimeExt{
 				Runtime: graphql.Runtime{
 					ID:          config.runtimeID,
 					Name:        runtimeInput.Name,
@@ -200,18 +219,20 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 				},
 			}, nil)
 
-			directorServiceMock.On("UpdateRuntime", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-			directorServiceMock.On("SetRuntimeStatusCondition", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			directorServiceMock.On("UpdateRuntime", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			directorServiceMock.On("SetRuntimeStatusCondition", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 
 			uuidGenerator := uuid.NewUUIDGenerator()
-			provisioner := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath)
+			provisioner := gardener.NewProvisioner(namespace, shootInterface, dbsFactory, auditLogPolicyCMName, maintenanceWindowConfigPath, "")
 
 			inputConverter := provisioning.NewInputConverter(uuidGenerator, "Project", defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
 			graphQLConverter := provisioning.NewGraphQLConverter()
 
-			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), provisioningQueue, deprovisioningQueue, shootUpgradeQueue)
+			upgradeChecker := &provisioningMocks.UpgradeChecker{}
+			upgradeChecker.On("CheckUpgrade", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+			provisioningService := provisioning.NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, dbsFactory, provisioner, uuidGenerator, gardener.NewShootProvider(shootInterface), upgradeChecker, nil, provisioningQueue, deprovisioningQueue, shootUpgradeQueue, hibernationQueue, wakeUpQueue)
 
-			validator := api.NewValidator()
+			validator := api.NewValidator(nil, nil)
 
 			tenantUpdater := api.NewTenantUpdater(dbsFactory.NewReadWriteSession())
 
@@ -231,7 +252,7 @@ func TestProvisioning_ProvisionRuntimeWithDatabase(t *testing.T) {
 func testProvisionRuntime(t *testing.T, ctx context.Context, resolver *api.Resolver, fullConfig gqlschema.ProvisionRuntimeInput, runtimeID string, shootInterface gardener_apis.ShootInterface, secretsInterface v1core.SecretInterface, auditLogConfig *gardener.AuditLogConfig) {
 
 	// when Provisioning Runtime
-	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig)
+	provisionRuntime, err := resolver.ProvisionRuntime(ctx, fullConfig, nil)
 
 	// then
 	require.NoError(t, err)
@@ -307,7 +328,7 @@ func testUpgradeGardenerShoot(t *testing.T, ctx context.Context, resolver *api.R
 	runtimeBeforeUpgrade, err := readSession.GetCluster(runtimeID)
 	require.NoError(t, err)
 
-	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+	upgradeShootOp, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 	require.NoError(t, err)
 
 	// for wait for shoot new version step
@@ -418,6 +439,14 @@ func testDeprovisioningTimeouts() queue.DeprovisioningTimeouts {
 	}
 }
 
+func testOperationLease() queue.OperationLeaseConfig {
+	return queue.OperationLeaseConfig{
+		Owner:           "provisioner-test",
+		Duration:        2 * time.Minute,
+		ReclaimInterval: time.Minute,
+	}
+}
+
 func testOperatorRoleBinding() provisioning2.OperatorRoleBinding {
 	return provisioning2.OperatorRoleBinding{
 		L2SubjectName: "runtimeOperator",
@@ -3,6 +3,7 @@ package api_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
@@ -14,8 +15,10 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -86,11 +89,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(operation, nil)
+		provisioningService.On("ProvisionRuntime", mock.Anything, config, tenant, "").Return(operation, nil)
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := resolver.ProvisionRuntime(ctx, config)
+		status, err := resolver.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.NoError(t, err)
@@ -121,7 +124,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(apperrors.BadRequest("Some error"))
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -148,11 +151,11 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		config := gqlschema.ProvisionRuntimeInput{RuntimeInput: runtimeInput, ClusterConfig: clusterConfig, KymaConfig: kymaConfig}
 
 		tenantUpdater.On("GetTenant", ctx).Return(tenant, nil)
-		provisioningService.On("ProvisionRuntime", config, tenant, "").Return(nil, apperrors.Internal("Provisioning failed"))
+		provisioningService.On("ProvisionRuntime", mock.Anything, config, tenant, "").Return(nil, apperrors.Internal("Provisioning failed"))
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -189,7 +192,7 @@ func TestResolver_ProvisionRuntime(t *testing.T) {
 		validator.On("ValidateProvisioningInput", config).Return(nil)
 
 		//when
-		status, err := provisioner.ProvisionRuntime(ctx, config)
+		status, err := provisioner.ProvisionRuntime(ctx, config, nil)
 
 		//then
 		require.Error(t, err)
@@ -209,7 +212,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 
 		expectedID := "ec781980-0533-4098-aab7-96b535569732"
 
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return(expectedID, nil)
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID).Return(expectedID, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -226,7 +229,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 		validator := &validatorMocks.Validator{}
 		tenantUpdater := &validatorMocks.TenantUpdater{}
 		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID).Return("", apperrors.Internal("Deprovisioning fails because reasons"))
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 
 		//when
@@ -248,7 +251,7 @@ func TestResolver_DeprovisionRuntime(t *testing.T) {
 
 		ctx := context.Background()
 
-		provisioningService.On("DeprovisionRuntime", runtimeID).Return(expectedID, nil, nil)
+		provisioningService.On("DeprovisionRuntime", mock.Anything, runtimeID).Return(expectedID, nil, nil)
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("tenant header not passed"))
 
 		//when
@@ -375,6 +378,98 @@ func TestResolver_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestResolver_CancelOperation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+
+	operationStatus := &gqlschema.OperationStatus{
+		ID:        util.StringPtr(operationID),
+		Operation: gqlschema.OperationTypeUpgradeShoot,
+		State:     gqlschema.OperationStateInProgress,
+		RuntimeID: &runtimeID,
+	}
+
+	t.Run("Should request operation cancellation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(operationStatus, nil)
+		provisioningService.On("CancelOperation", operationID).Return(operationStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.CancelOperation(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operationStatus, status)
+		provisioningService.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when operation belongs to other tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(operationStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(apperrors.BadRequest("invalid tenant"))
+
+		//when
+		status, err := provisioner.CancelOperation(ctx, operationID)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		require.Empty(t, status)
+		provisioningService.AssertNotCalled(t, "CancelOperation", operationID)
+	})
+}
+
+func TestResolver_RetryOperation(t *testing.T) {
+	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
+	runtimeID := "1100bb59-9c40-4ebb-b846-7477c4dc5bbd"
+
+	t.Run("Should retry operation", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		tenantUpdater := &validatorMocks.TenantUpdater{}
+
+		provisioner := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		failedStatus := &gqlschema.OperationStatus{
+			ID:        util.StringPtr(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateFailed,
+			RuntimeID: &runtimeID,
+		}
+		retriedStatus := &gqlschema.OperationStatus{
+			ID:        util.StringPtr(operationID),
+			Operation: gqlschema.OperationTypeProvision,
+			State:     gqlschema.OperationStateInProgress,
+			RuntimeID: &runtimeID,
+		}
+
+		provisioningService.On("RuntimeOperationStatus", operationID).Return(failedStatus, nil)
+		provisioningService.On("RetryOperation", operationID).Return(retriedStatus, nil)
+		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
+
+		//when
+		status, err := provisioner.RetryOperation(ctx, operationID)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, retriedStatus, status)
+	})
+}
+
 func TestResolver_UpgradeShoot(t *testing.T) {
 	ctx := context.WithValue(context.Background(), middlewares.Tenant, tenant)
 
@@ -396,12 +491,12 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 
 		tenantUpdater.On("GetAndUpdateTenant", runtimeID, ctx).Return(nil)
 		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
-		provisioningService.On("UpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
+		provisioningService.On("UpgradeGardenerShoot", mock.Anything, runtimeID, upgradeShootInput).Return(operation, nil)
 
 		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
 
 		//when
-		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
 
 		//then
 		require.NoError(t, err)
@@ -411,6 +506,36 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		assert.Equal(t, operation, status)
 	})
 
+	t.Run("Should dry run shoot upgrade without updating tenant", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		validator := &validatorMocks.Validator{}
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetTenant", runtimeID).Return("other-tenant", nil).Maybe()
+		tenantUpdater := api.NewTenantUpdater(session)
+
+		operation := &gqlschema.OperationStatus{
+			Operation:    gqlschema.OperationTypeUpgradeShoot,
+			State:        gqlschema.OperationStateSucceeded,
+			RuntimeID:    util.StringPtr(runtimeID),
+			DryRunResult: &gqlschema.DryRunResult{Shoot: "kind: Shoot", Diff: util.StringPtr("")},
+		}
+
+		validator.On("ValidateUpgradeShootInput", upgradeShootInput).Return(nil)
+		provisioningService.On("DryRunUpgradeGardenerShoot", runtimeID, upgradeShootInput).Return(operation, nil)
+
+		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
+
+		//when
+		status, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, util.BoolPtr(true))
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operation, status)
+		provisioningService.AssertNotCalled(t, "UpgradeGardenerShoot", runtimeID, upgradeShootInput)
+		session.AssertNotCalled(t, "UpdateTenant", mock.Anything, mock.Anything)
+	})
+
 	t.Run("Should return error when validation fails", func(t *testing.T) {
 		//given
 		provisioningService := &mocks.Service{}
@@ -423,11 +548,60 @@ func TestResolver_UpgradeShoot(t *testing.T) {
 		resolver := api.NewResolver(provisioningService, validator, tenantUpdater)
 
 		//when
-		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput)
+		_, err := resolver.UpgradeShoot(ctx, runtimeID, upgradeShootInput, nil)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestResolver_VersionDrift(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should return version drift for default period", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		groups := []*gqlschema.VersionDriftGroup{{Provider: "aws", Region: "eu-central-1"}}
+		provisioningService.On("VersionDrift", "aws", "", 30*24*time.Hour).Return(groups, nil)
+
+		//when
+		result, err := resolver.VersionDrift(ctx, util.StringPtr("aws"), nil, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, groups, result)
+	})
+
+	t.Run("Should return version drift for requested period", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		provisioningService.On("VersionDrift", "", "eu-central-1", 7*24*time.Hour).Return([]*gqlschema.VersionDriftGroup{}, nil)
+
+		//when
+		result, err := resolver.VersionDrift(ctx, nil, util.StringPtr("eu-central-1"), util.IntPtr(7))
+
+		//then
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("Should return error when period is negative", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		//when
+		_, err := resolver.VersionDrift(ctx, nil, nil, util.IntPtr(-1))
 
 		//then
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		provisioningService.AssertNotCalled(t, "VersionDrift", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
@@ -454,3 +628,81 @@ func dnsInput() *gqlschema.DNSConfigInput {
 		},
 	}
 }
+
+func TestResolver_Runtimes(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should return listed Runtimes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		filter := &gqlschema.RuntimesFilter{Provider: util.StringPtr("aws")}
+		page := &gqlschema.PageInput{First: util.IntPtr(10)}
+		runtimes := &gqlschema.RuntimePage{
+			Data:     []*gqlschema.RuntimeSummary{{RuntimeID: runtimeID, Provider: "aws"}},
+			PageInfo: &gqlschema.PageInfo{},
+		}
+		provisioningService.On("ListRuntimes", filter, page).Return(runtimes, nil)
+
+		//when
+		result, err := resolver.Runtimes(ctx, filter, page)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, runtimes, result)
+	})
+
+	t.Run("Should return error when failed to list Runtimes", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		provisioningService.On("ListRuntimes", mock.Anything, mock.Anything).Return(nil, apperrors.BadRequest("invalid page cursor"))
+
+		//when
+		_, err := resolver.Runtimes(ctx, nil, &gqlschema.PageInput{After: util.StringPtr("cursor")})
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestResolver_Operations(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Should return listed operations", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		filter := &gqlschema.OperationsFilter{RuntimeID: util.StringPtr(runtimeID)}
+		operations := &gqlschema.OperationPage{
+			Data:     []*gqlschema.OperationStatus{{ID: util.StringPtr("operation"), RuntimeID: util.StringPtr(runtimeID)}},
+			PageInfo: &gqlschema.PageInfo{},
+		}
+		provisioningService.On("ListOperations", filter, (*gqlschema.PageInput)(nil)).Return(operations, nil)
+
+		//when
+		result, err := resolver.Operations(ctx, filter, nil)
+
+		//then
+		require.NoError(t, err)
+		assert.Equal(t, operations, result)
+	})
+
+	t.Run("Should return error when failed to list operations", func(t *testing.T) {
+		//given
+		provisioningService := &mocks.Service{}
+		resolver := api.NewResolver(provisioningService, &validatorMocks.Validator{}, &validatorMocks.TenantUpdater{})
+
+		provisioningService.On("ListOperations", mock.Anything, mock.Anything).Return(nil, apperrors.Internal("error"))
+
+		//when
+		_, err := resolver.Operations(ctx, nil, nil)
+
+		//then
+		require.Error(t, err)
+	})
+}
@@ -1,9 +1,17 @@
 package api
 
 import (
+	"encoding/json"
+	"net"
 	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -11,6 +19,29 @@ import (
 
 const RuntimeAgent = "compass-runtime-agent"
 
+var validTaintEffects = map[string]bool{
+	string(corev1.TaintEffectNoSchedule):       true,
+	string(corev1.TaintEffectPreferNoSchedule): true,
+	string(corev1.TaintEffectNoExecute):        true,
+}
+
+var validCRINames = map[string]bool{
+	string(gardener_types.CRINameContainerD): true,
+}
+
+var validServerGroupPolicies = map[string]bool{
+	"affinity":           true,
+	"anti-affinity":      true,
+	"soft-affinity":      true,
+	"soft-anti-affinity": true,
+}
+
+var validControlPlaneFailureTolerances = map[string]bool{
+	"":     true,
+	"node": true,
+	"zone": true,
+}
+
 //go:generate mockery --name=Validator
 type Validator interface {
 	ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError
@@ -18,10 +49,22 @@ type Validator interface {
 }
 
 type validator struct {
+	reservedSeedCIDRs     []*net.IPNet
+	allowedExtensionTypes map[string]bool
 }
 
-func NewValidator() Validator {
-	return &validator{}
+// NewValidator creates a Validator rejecting cluster networks overlapping with the reserved seed CIDRs
+// and Shoot extensions of types which are not allowed
+func NewValidator(reservedSeedCIDRs []*net.IPNet, allowedExtensionTypes []string) Validator {
+	allowed := make(map[string]bool, len(allowedExtensionTypes))
+	for _, extensionType := range allowedExtensionTypes {
+		allowed[extensionType] = true
+	}
+
+	return &validator{
+		reservedSeedCIDRs:     reservedSeedCIDRs,
+		allowedExtensionTypes: allowed,
+	}
 }
 
 func (v *validator) ValidateProvisioningInput(input gqlschema.ProvisionRuntimeInput) apperrors.AppError {
@@ -66,6 +109,34 @@ func (v *validator) ValidateUpgradeShootInput(input gqlschema.UpgradeShootInput)
 		return apperrors.BadRequest("empty purpose provided")
 	}
 
+	if err := v.validateWorkerPools(config.AdditionalWorkerPools); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerSettings(model.PrimaryWorkerPoolName, config.WorkerLabels, config.WorkerTaints, config.KubeletConfig, config.CriName); err != nil {
+		return err
+	}
+
+	if err := v.validateControlPlaneFailureTolerance(config.ControlPlaneFailureTolerance); err != nil {
+		return err
+	}
+
+	if err := v.validateHibernationSchedules(config.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateExtensions(config.Extensions); err != nil {
+		return err
+	}
+
+	if err := v.validateOpenStackConfig(config.ProviderSpecificConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateProviderNetworking(config.ProviderSpecificConfig, nil, nil, 0); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -105,6 +176,105 @@ func (v *validator) validateClusterConfig(clusterConfig *gqlschema.ClusterConfig
 		return err
 	}
 
+	if err := v.validateWorkerPools(gardenerConfig.AdditionalWorkerPools); err != nil {
+		return err
+	}
+
+	if err := v.validateWorkerSettings(model.PrimaryWorkerPoolName, gardenerConfig.WorkerLabels, gardenerConfig.WorkerTaints, gardenerConfig.KubeletConfig, gardenerConfig.CriName); err != nil {
+		return err
+	}
+
+	for _, pool := range gardenerConfig.AdditionalWorkerPools {
+		if err := v.validateOpenStackVolume(pool.DiskType, pool.VolumeSizeGb, gardenerConfig.Provider); err != nil {
+			return err
+		}
+	}
+
+	if err := v.validateControlPlaneFailureTolerance(gardenerConfig.ControlPlaneFailureTolerance); err != nil {
+		return err
+	}
+
+	if err := v.validateHibernationSchedules(gardenerConfig.HibernationSchedules); err != nil {
+		return err
+	}
+
+	if err := v.validateExtensions(gardenerConfig.Extensions); err != nil {
+		return err
+	}
+
+	if err := v.validateOpenStackConfig(gardenerConfig.ProviderSpecificConfig); err != nil {
+		return err
+	}
+
+	if err := v.validateNetworking(gardenerConfig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (v *validator) validateWorkerPools(pools []*gqlschema.WorkerPoolInput) apperrors.AppError {
+	names := map[string]bool{model.PrimaryWorkerPoolName: true}
+
+	for _, pool := range pools {
+		if pool.Name == "" {
+			return apperrors.BadRequest("error: worker pool name is empty")
+		}
+		if names[pool.Name] {
+			return apperrors.BadRequest("error: worker pool name %s is reserved or not unique", pool.Name)
+		}
+		names[pool.Name] = true
+
+		if pool.MachineType == "" {
+			return apperrors.BadRequest("error: empty machine type provided for worker pool %s", pool.Name)
+		}
+		if util.NotNilOrEmpty(pool.MachineImageVersion) && util.IsNilOrEmpty(pool.MachineImage) {
+			return apperrors.BadRequest("error: Machine Image Version passed while Machine Image is empty for worker pool %s", pool.Name)
+		}
+		if pool.AutoScalerMin > pool.AutoScalerMax {
+			return apperrors.BadRequest("error: autoScalerMin greater than autoScalerMax for worker pool %s", pool.Name)
+		}
+		if err := v.validateWorkerSettings(pool.Name, pool.Labels, pool.Taints, pool.KubeletConfig, pool.CriName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateWorkerSettings(poolName string, labels gqlschema.Labels, taints []*gqlschema.TaintInput, kubeletConfig *gqlschema.KubeletConfigInput, criName *string) apperrors.AppError {
+	for key, value := range labels {
+		if _, ok := value.(string); !ok {
+			return apperrors.BadRequest("error: value of label %s of worker pool %s is not a string", key, poolName)
+		}
+	}
+
+	for _, taint := range taints {
+		if !validTaintEffects[taint.Effect] {
+			return apperrors.BadRequest("error: invalid effect %s of taint %s of worker pool %s", taint.Effect, taint.Key, poolName)
+		}
+	}
+
+	if kubeletConfig != nil {
+		if kubeletConfig.MaxPods != nil && *kubeletConfig.MaxPods <= 0 {
+			return apperrors.BadRequest("error: maxPods of worker pool %s must be greater than 0", poolName)
+		}
+		if gracePeriod := kubeletConfig.EvictionSoftGracePeriod; gracePeriod != nil {
+			for _, duration := range []*string{gracePeriod.MemoryAvailable, gracePeriod.ImageFSAvailable, gracePeriod.ImageFSInodesFree, gracePeriod.NodeFSAvailable, gracePeriod.NodeFSInodesFree} {
+				if duration == nil {
+					continue
+				}
+				if _, err := time.ParseDuration(*duration); err != nil {
+					return apperrors.BadRequest("error: invalid soft eviction grace period %s of worker pool %s", *duration, poolName)
+				}
+			}
+		}
+	}
+
+	if criName != nil && !validCRINames[*criName] {
+		return apperrors.BadRequest("error: invalid container runtime %s of worker pool %s", *criName, poolName)
+	}
+
 	return nil
 }
 
@@ -125,6 +295,95 @@ func (v *validator) validateOpenStackVolume(diskType *string, volumeSizeGb *int,
 	return nil
 }
 
+func (v *validator) validateControlPlaneFailureTolerance(failureTolerance *string) apperrors.AppError {
+	if failureTolerance != nil && !validControlPlaneFailureTolerances[*failureTolerance] {
+		return apperrors.BadRequest("error: invalid control plane failure tolerance %s, allowed values are node and zone", *failureTolerance)
+	}
+
+	return nil
+}
+
+// validateHibernationSchedules rejects the schedules which Gardener cannot evaluate: without start and end,
+// with invalid cron expressions or unknown locations
+func (v *validator) validateHibernationSchedules(schedules []*gqlschema.HibernationScheduleInput) apperrors.AppError {
+	for _, schedule := range schedules {
+		if util.UnwrapStr(schedule.Start) == "" && util.UnwrapStr(schedule.End) == "" {
+			return apperrors.BadRequest("error: hibernation schedule must define start or end")
+		}
+		for _, expression := range []*string{schedule.Start, schedule.End} {
+			if util.UnwrapStr(expression) == "" {
+				continue
+			}
+			if _, err := cron.ParseStandard(*expression); err != nil {
+				return apperrors.BadRequest("error: invalid hibernation schedule cron expression %s: %s", *expression, err.Error())
+			}
+		}
+		if schedule.Location != nil {
+			if _, err := time.LoadLocation(*schedule.Location); err != nil {
+				return apperrors.BadRequest("error: invalid hibernation schedule location %s: %s", *schedule.Location, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateExtensions(extensions []*gqlschema.ExtensionInput) apperrors.AppError {
+	types := map[string]bool{}
+
+	for _, extension := range extensions {
+		if extension.Type == "" {
+			return apperrors.BadRequest("error: extension type is empty")
+		}
+		if types[extension.Type] {
+			return apperrors.BadRequest("error: extension type %s is not unique", extension.Type)
+		}
+		types[extension.Type] = true
+
+		if model.IsManagedExtensionType(extension.Type) {
+			return apperrors.BadRequest("error: extension %s is managed by the provisioner and cannot be configured", extension.Type)
+		}
+		if !v.allowedExtensionTypes[extension.Type] {
+			return apperrors.BadRequest("error: extension %s is not allowed", extension.Type)
+		}
+		if extension.ProviderConfig != nil {
+			var providerConfig map[string]interface{}
+			if err := json.Unmarshal([]byte(*extension.ProviderConfig), &providerConfig); err != nil {
+				return apperrors.BadRequest("error: provider config of extension %s is not a JSON object: %s", extension.Type, err.Error())
+			}
+		}
+	}
+
+	return nil
+}
+
+func (v *validator) validateOpenStackConfig(providerConfig *gqlschema.ProviderSpecificInput) apperrors.AppError {
+	if providerConfig == nil || providerConfig.OpenStackConfig == nil {
+		return nil
+	}
+	config := providerConfig.OpenStackConfig
+
+	if util.NotNilOrEmpty(config.SubnetID) && util.IsNilOrEmpty(config.NetworkID) {
+		return apperrors.BadRequest("error: OpenStack subnetID passed while networkID is empty")
+	}
+	if config.ServerGroupPolicy != nil && !validServerGroupPolicies[*config.ServerGroupPolicy] {
+		return apperrors.BadRequest("error: invalid OpenStack server group policy %s", *config.ServerGroupPolicy)
+	}
+
+	names := map[string]bool{}
+	for _, class := range config.LoadBalancerClasses {
+		if class.Name == "" {
+			return apperrors.BadRequest("error: OpenStack load balancer class name is empty")
+		}
+		if names[class.Name] {
+			return apperrors.BadRequest("error: OpenStack load balancer class name %s is not unique", class.Name)
+		}
+		names[class.Name] = true
+	}
+
+	return nil
+}
+
 func configContainsRuntimeAgentComponent(components []*gqlschema.ComponentConfigurationInput) bool {
 	for _, component := range components {
 		if component.Component == RuntimeAgent {
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"net"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	// reservedAddressesPerSubnet is the number of addresses the hyperscalers reserve in every subnet
+	reservedAddressesPerSubnet = 5
+	// nodePodCIDRMaskSize is the size of the pod range Gardener assigns to every node
+	nodePodCIDRMaskSize = 24
+)
+
+type namedCIDR struct {
+	name    string
+	network *net.IPNet
+}
+
+func (v *validator) validateNetworking(gardenerConfig gqlschema.GardenerConfigInput) apperrors.AppError {
+	nodes, err := parseCIDR("workerCidr", gardenerConfig.WorkerCidr)
+	if err != nil {
+		return err
+	}
+
+	var podsAndServices []namedCIDR
+	if util.NotNilOrEmpty(gardenerConfig.PodsCidr) {
+		pods, err := parseCIDR("podsCidr", *gardenerConfig.PodsCidr)
+		if err != nil {
+			return err
+		}
+		podsAndServices = append(podsAndServices, namedCIDR{name: "podsCidr", network: pods})
+	}
+	if util.NotNilOrEmpty(gardenerConfig.ServicesCidr) {
+		services, err := parseCIDR("servicesCidr", *gardenerConfig.ServicesCidr)
+		if err != nil {
+			return err
+		}
+		podsAndServices = append(podsAndServices, namedCIDR{name: "servicesCidr", network: services})
+	}
+
+	clusterNetworks := append([]namedCIDR{{name: "workerCidr", network: nodes}}, podsAndServices...)
+
+	if err := validateNoOverlaps(clusterNetworks); err != nil {
+		return err
+	}
+
+	for _, clusterNetwork := range clusterNetworks {
+		for _, reserved := range v.reservedSeedCIDRs {
+			if util.CIDRsOverlap(clusterNetwork.network, reserved) {
+				return apperrors.BadRequest("error: %s %s overlaps with range %s reserved for seeds", clusterNetwork.name, clusterNetwork.network, reserved)
+			}
+		}
+	}
+
+	maxNodes := gardenerConfig.AutoScalerMax + gardenerConfig.MaxSurge
+	for _, pool := range gardenerConfig.AdditionalWorkerPools {
+		maxNodes += pool.AutoScalerMax + pool.MaxSurge
+	}
+
+	if err := validateNodesCapacity("workerCidr", nodes, maxNodes); err != nil {
+		return err
+	}
+
+	if len(podsAndServices) > 0 && podsAndServices[0].name == "podsCidr" {
+		if err := validatePodsCapacity(podsAndServices[0].network, maxNodes); err != nil {
+			return err
+		}
+	}
+
+	return v.validateProviderNetworking(gardenerConfig.ProviderSpecificConfig, nodes, podsAndServices, maxNodes)
+}
+
+// validateProviderNetworking validates the provider networks against the nodes CIDR and against the pods and services CIDRs.
+// The provider networks are described by the provider config, see model.NetworkingProviderConfig.
+// The nodes CIDR is nil when validating upgrade input, which cannot change it.
+func (v *validator) validateProviderNetworking(providerConfig *gqlschema.ProviderSpecificInput, nodes *net.IPNet, podsAndServices []namedCIDR, maxNodes int) apperrors.AppError {
+	if providerConfig == nil {
+		return nil
+	}
+
+	gardenerProviderConfig, err := model.NewGardenerProviderConfigFromInput(providerConfig)
+	if err != nil {
+		return err
+	}
+	networkingConfig, ok := gardenerProviderConfig.(model.NetworkingProviderConfig)
+	if !ok {
+		return nil
+	}
+	networks := networkingConfig.Networks()
+
+	vpcNetwork, err := parseCIDR(networks.VPC.Name, networks.VPC.CIDR)
+	if err != nil {
+		return err
+	}
+	vpc := namedCIDR{name: networks.VPC.Name, network: vpcNetwork}
+
+	if networks.NodesWithinVPC && nodes != nil && !util.CIDRContains(vpc.network, nodes) {
+		return apperrors.BadRequest("error: workerCidr %s is not within %s %s", nodes, vpc.name, vpc.network)
+	}
+
+	zoneWorkers, err := parseProviderNetworks(networks.ZoneWorkers)
+	if err != nil {
+		return err
+	}
+	zoneSubnets, err := parseProviderNetworks(networks.ZoneSubnets)
+	if err != nil {
+		return err
+	}
+
+	for _, subnet := range zoneSubnets {
+		if !util.CIDRContains(vpc.network, subnet.network) {
+			return apperrors.BadRequest("error: %s %s is not within %s %s", subnet.name, subnet.network, vpc.name, vpc.network)
+		}
+	}
+	if err := validateNoOverlaps(zoneSubnets); err != nil {
+		return err
+	}
+
+	for _, clusterNetwork := range podsAndServices {
+		if util.CIDRsOverlap(clusterNetwork.network, vpc.network) {
+			return apperrors.BadRequest("error: %s %s overlaps with %s %s", clusterNetwork.name, clusterNetwork.network, vpc.name, vpc.network)
+		}
+	}
+
+	if nodes == nil || len(zoneWorkers) == 0 {
+		return nil
+	}
+
+	maxNodesPerZone := (maxNodes + len(zoneWorkers) - 1) / len(zoneWorkers)
+	for _, subnet := range zoneWorkers {
+		if !util.CIDRContains(nodes, subnet.network) {
+			return apperrors.BadRequest("error: %s %s is not within workerCidr %s", subnet.name, subnet.network, nodes)
+		}
+		if err := validateNodesCapacity(subnet.name, subnet.network, maxNodesPerZone); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseCIDR(name, cidr string) (*net.IPNet, apperrors.AppError) {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, apperrors.BadRequest("error: invalid %s %s", name, cidr)
+	}
+	return network, nil
+}
+
+func parseProviderNetworks(networks []model.ProviderNetwork) ([]namedCIDR, apperrors.AppError) {
+	parsed := make([]namedCIDR, 0, len(networks))
+	for _, providerNetwork := range networks {
+		network, err := parseCIDR(providerNetwork.Name, providerNetwork.CIDR)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, namedCIDR{name: providerNetwork.Name, network: network})
+	}
+	return parsed, nil
+}
+
+func validateNoOverlaps(networks []namedCIDR) apperrors.AppError {
+	for i, a := range networks {
+		for _, b := range networks[i+1:] {
+			if util.CIDRsOverlap(a.network, b.network) {
+				return apperrors.BadRequest("error: %s %s overlaps with %s %s", a.name, a.network, b.name, b.network)
+			}
+		}
+	}
+	return nil
+}
+
+func validateNodesCapacity(name string, network *net.IPNet, maxNodes int) apperrors.AppError {
+	if util.CIDRAddressCount(network) < uint64(maxNodes+reservedAddressesPerSubnet) {
+		return apperrors.BadRequest("error: %s %s is too small for %d nodes", name, network, maxNodes)
+	}
+	return nil
+}
+
+func validatePodsCapacity(pods *net.IPNet, maxNodes int) apperrors.AppError {
+	ones, bits := pods.Mask.Size()
+	if bits != net.IPv4len*8 {
+		return nil
+	}
+	if ones > nodePodCIDRMaskSize || 1<<uint(nodePodCIDRMaskSize-ones) < maxNodes {
+		return apperrors.BadRequest("error: podsCidr %s is too small for %d nodes with a /%d pod range each", pods, maxNodes, nodePodCIDRMaskSize)
+	}
+	return nil
+}
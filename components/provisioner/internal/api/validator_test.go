@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/require"
@@ -14,7 +15,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when config is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -31,7 +32,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return nil when kyma config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{
 			RuntimeInput:  runtimeInput,
@@ -47,7 +48,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return error when config is incorrect", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.ProvisionRuntimeInput{}
 
@@ -60,7 +61,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("Should return error when Runtime Agent component is not passed in installation config", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		kymaConfig := &gqlschema.KymaConfigInput{
 			Version: "1.5",
@@ -87,7 +88,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 
 	t.Run("should return error when machine image version is set, but machine image is empty", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		testClusterConfig := clusterConfig
 		testClusterConfig.GardenerConfig.MachineImageVersion = util.StringPtr("24.3")
@@ -117,7 +118,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 				Seed:                   util.StringPtr("2"),
 				TargetSecret:           "test-secret",
 				DiskType:               util.StringPtr("ssd"),
-				WorkerCidr:             "10.10.10.10/255",
+				WorkerCidr:             "10.250.0.0/16",
 				AutoScalerMin:          1,
 				AutoScalerMax:          3,
 				MaxSurge:               40,
@@ -132,7 +133,7 @@ func TestValidator_ValidateProvisioningInput(t *testing.T) {
 			KymaConfig:    kymaConfig,
 		}
 
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		//when
 		err := validator.ValidateProvisioningInput(config)
@@ -155,7 +156,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return nil when input is correct", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -181,7 +182,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input not provided", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		config := gqlschema.UpgradeShootInput{}
 
@@ -195,7 +196,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for machine type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -222,7 +223,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for disk type", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -249,7 +250,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for purpose", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -276,7 +277,7 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 
 	t.Run("Should return error when Gardener config input provide empty value for kubernetes version", func(t *testing.T) {
 		//given
-		validator := NewValidator()
+		validator := NewValidator(nil, nil)
 
 		input := gqlschema.UpgradeShootInput{
 			GardenerConfig: &gqlschema.GardenerUpgradeInput{
@@ -300,6 +301,389 @@ func TestValidator_ValidateUpgradeShootInput(t *testing.T) {
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
 	})
+
+	t.Run("Should return error when worker pool names are not unique", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{
+					{Name: "gpu-pool", MachineType: "gpu-machine", AutoScalerMin: 1, AutoScalerMax: 2},
+					{Name: "gpu-pool", MachineType: "gpu-machine", AutoScalerMin: 1, AutoScalerMax: 2},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when worker pool uses the primary worker pool name", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{
+					{Name: "cpu-worker-0", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return error when worker pool has invalid settings", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		for _, pool := range []*gqlschema.WorkerPoolInput{
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 3, AutoScalerMax: 2},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, Labels: gqlschema.Labels{"size": 1}},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, Taints: []*gqlschema.TaintInput{{Key: "key", Effect: "Never"}}},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, CriName: util.StringPtr("rkt")},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, CriName: util.StringPtr("docker")},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, KubeletConfig: &gqlschema.KubeletConfigInput{MaxPods: util.IntPtr(0)}},
+			{Name: "pool", MachineType: "machine", AutoScalerMin: 1, AutoScalerMax: 2, KubeletConfig: &gqlschema.KubeletConfigInput{
+				EvictionSoftGracePeriod: &gqlschema.EvictionThresholdsInput{MemoryAvailable: util.StringPtr("90 seconds")},
+			}},
+		} {
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					AdditionalWorkerPools: []*gqlschema.WorkerPoolInput{pool},
+				},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		}
+	})
+
+	t.Run("Should return error when control plane failure tolerance is invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				ControlPlaneFailureTolerance: util.StringPtr("region"),
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+
+	t.Run("Should return nil when hibernation schedules are valid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				HibernationSchedules: []*gqlschema.HibernationScheduleInput{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 07 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+					{Start: util.StringPtr("00 22 * * *")},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should return error when hibernation schedules are invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		for _, schedule := range []*gqlschema.HibernationScheduleInput{
+			{},
+			{Start: util.StringPtr("every evening")},
+			{Start: util.StringPtr("00 20 * * *"), End: util.StringPtr("00 07 * *")},
+			{Start: util.StringPtr("00 20 * * *"), Location: util.StringPtr("Europe/Atlantis")},
+		} {
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					HibernationSchedules: []*gqlschema.HibernationScheduleInput{schedule},
+				},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		}
+	})
+
+	t.Run("Should return nil when extensions are allowed", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, []string{"shoot-oidc-service", "shoot-lakom-service"})
+		input := gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{
+				Extensions: []*gqlschema.ExtensionInput{
+					{Type: "shoot-oidc-service"},
+					{Type: "shoot-lakom-service", Disabled: util.BoolPtr(false), ProviderConfig: util.StringPtr(`{"apiVersion":"lakom.extensions.gardener.cloud/v1alpha1","kind":"LakomConfig"}`)},
+				},
+			},
+		}
+
+		//when
+		err := validator.ValidateUpgradeShootInput(input)
+
+		//then
+		require.NoError(t, err)
+	})
+
+	t.Run("Should return error when extensions are invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, []string{"shoot-oidc-service", model.ShootNetworkingFilterExtensionType})
+
+		for _, extensions := range [][]*gqlschema.ExtensionInput{
+			{{Type: ""}},
+			{{Type: "shoot-lakom-service"}},
+			{{Type: model.ShootNetworkingFilterExtensionType}},
+			{{Type: "shoot-oidc-service"}, {Type: "shoot-oidc-service", Disabled: util.BoolPtr(true)}},
+			{{Type: "shoot-oidc-service", ProviderConfig: util.StringPtr(`["not", "an", "object"]`)}},
+			{{Type: "shoot-oidc-service", ProviderConfig: util.StringPtr(`{"kind":`)}},
+		} {
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					Extensions: extensions,
+				},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		}
+	})
+
+	t.Run("Should return error when OpenStack config is invalid", func(t *testing.T) {
+		//given
+		validator := NewValidator(nil, nil)
+
+		for _, config := range []*gqlschema.OpenStackProviderConfigInput{
+			{SubnetID: util.StringPtr("subnet-id")},
+			{ServerGroupPolicy: util.StringPtr("spread")},
+			{LoadBalancerClasses: []*gqlschema.OpenStackLoadBalancerClassInput{{Name: ""}}},
+			{LoadBalancerClasses: []*gqlschema.OpenStackLoadBalancerClassInput{{Name: "default"}, {Name: "default"}}},
+		} {
+			input := gqlschema.UpgradeShootInput{
+				GardenerConfig: &gqlschema.GardenerUpgradeInput{
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{OpenStackConfig: config},
+				},
+			}
+
+			//when
+			err := validator.ValidateUpgradeShootInput(input)
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		}
+	})
+}
+
+func TestValidator_ValidateNetworking(t *testing.T) {
+	reservedSeedCIDRs, err := util.ParseCIDRs([]string{"10.243.0.0/16"})
+	require.NoError(t, err)
+
+	awsConfig := func() *gqlschema.ProviderSpecificInput {
+		return &gqlschema.ProviderSpecificInput{
+			AwsConfig: &gqlschema.AWSProviderConfigInput{
+				VpcCidr: "10.250.0.0/16",
+				AwsZones: []*gqlschema.AWSZoneInput{
+					{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.250.32.0/20", InternalCidr: "10.250.48.0/20"},
+				},
+			},
+		}
+	}
+
+	azureConfig := func() *gqlschema.ProviderSpecificInput {
+		return &gqlschema.ProviderSpecificInput{
+			AzureConfig: &gqlschema.AzureProviderConfigInput{
+				VnetCidr: "10.250.0.0/16",
+				AzureZones: []*gqlschema.AzureZoneInput{
+					{Name: 1, Cidr: "10.250.0.0/19"},
+					{Name: 2, Cidr: "10.250.32.0/19"},
+				},
+			},
+		}
+	}
+
+	alicloudConfig := func() *gqlschema.ProviderSpecificInput {
+		return &gqlschema.ProviderSpecificInput{
+			AlicloudConfig: &gqlschema.AlicloudProviderConfigInput{
+				VpcCidr: "10.250.0.0/16",
+				AlicloudZones: []*gqlschema.AlicloudZoneInput{
+					{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19"},
+				},
+			},
+		}
+	}
+
+	validNetworking := func(config *gqlschema.GardenerConfigInput) {
+		config.WorkerCidr = "10.250.0.0/16"
+		config.PodsCidr = util.StringPtr("100.96.0.0/11")
+		config.ServicesCidr = util.StringPtr("100.64.0.0/13")
+	}
+
+	t.Run("Should return nil when networking is correct", func(t *testing.T) {
+		for _, providerConfig := range []*gqlschema.ProviderSpecificInput{nil, awsConfig(), azureConfig(), alicloudConfig()} {
+			//given
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			validNetworking(clusterConfig.GardenerConfig)
+			clusterConfig.GardenerConfig.ProviderSpecificConfig = providerConfig
+
+			//when
+			err := NewValidator(reservedSeedCIDRs, nil).ValidateProvisioningInput(gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			})
+
+			//then
+			require.NoError(t, err)
+		}
+	})
+
+	for _, testCase := range []struct {
+		description string
+		modify      func(config *gqlschema.GardenerConfigInput)
+	}{
+		{description: "Should return error when worker CIDR is malformed",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.WorkerCidr = "10.250.0.0/255" }},
+		{description: "Should return error when pods CIDR is malformed",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.PodsCidr = util.StringPtr("pods") }},
+		{description: "Should return error when pods CIDR overlaps with worker CIDR",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.PodsCidr = util.StringPtr("10.250.128.0/17") }},
+		{description: "Should return error when services CIDR overlaps with pods CIDR",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.ServicesCidr = util.StringPtr("100.100.0.0/16") }},
+		{description: "Should return error when services CIDR overlaps with reserved seed CIDR",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.ServicesCidr = util.StringPtr("10.243.0.0/24") }},
+		{description: "Should return error when worker CIDR is too small for maximum number of nodes",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.WorkerCidr = "10.250.0.0/29"
+				config.AutoScalerMax = 3
+				config.MaxSurge = 1
+			}},
+		{description: "Should return error when worker CIDR is too small for nodes of additional worker pools",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.WorkerCidr = "10.250.0.0/28"
+				config.AutoScalerMax = 1
+				config.MaxSurge = 0
+				config.AdditionalWorkerPools = []*gqlschema.WorkerPoolInput{{Name: "pool", MachineType: "machine", AutoScalerMax: 10, MaxSurge: 1}}
+			}},
+		{description: "Should return error when pods CIDR is too small for maximum number of nodes",
+			modify: func(config *gqlschema.GardenerConfigInput) { config.PodsCidr = util.StringPtr("100.96.0.0/23") }},
+		{description: "Should return error when VPC CIDR is malformed",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = awsConfig()
+				config.ProviderSpecificConfig.AwsConfig.VpcCidr = "vpc"
+			}},
+		{description: "Should return error when AWS zone subnet is outside of VPC",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = awsConfig()
+				config.ProviderSpecificConfig.AwsConfig.AwsZones[0].PublicCidr = "10.251.32.0/20"
+			}},
+		{description: "Should return error when AWS zone subnets overlap",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = awsConfig()
+				config.ProviderSpecificConfig.AwsConfig.AwsZones[0].InternalCidr = "10.250.32.0/21"
+			}},
+		{description: "Should return error when AWS zone workers are outside of worker CIDR",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.WorkerCidr = "10.250.128.0/17"
+				config.ProviderSpecificConfig = awsConfig()
+			}},
+		{description: "Should return error when pods CIDR overlaps with VPC",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = awsConfig()
+				config.ProviderSpecificConfig.AwsConfig.VpcCidr = "10.0.0.0/8"
+				config.ProviderSpecificConfig.AwsConfig.AwsZones[0] = &gqlschema.AWSZoneInput{Name: "eu-central-1a", WorkerCidr: "10.250.0.0/19", PublicCidr: "10.1.0.0/20", InternalCidr: "10.2.0.0/20"}
+				config.PodsCidr = util.StringPtr("10.96.0.0/11")
+			}},
+		{description: "Should return error when worker CIDR is outside of Azure VNet",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = azureConfig()
+				config.ProviderSpecificConfig.AzureConfig.VnetCidr = "10.250.0.0/17"
+			}},
+		{description: "Should return error when Azure zone subnets overlap",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = azureConfig()
+				config.ProviderSpecificConfig.AzureConfig.AzureZones[1].Cidr = "10.250.16.0/20"
+			}},
+		{description: "Should return error when Azure zone subnet is too small for nodes of the zone",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = azureConfig()
+				config.ProviderSpecificConfig.AzureConfig.AzureZones[1].Cidr = "10.250.32.0/29"
+			}},
+		{description: "Should return error when Alicloud zone workers are outside of VPC",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = alicloudConfig()
+				config.ProviderSpecificConfig.AlicloudConfig.VpcCidr = "10.250.128.0/17"
+			}},
+		{description: "Should return error when Alicloud config has no zones",
+			modify: func(config *gqlschema.GardenerConfigInput) {
+				config.ProviderSpecificConfig = alicloudConfig()
+				config.ProviderSpecificConfig.AlicloudConfig.AlicloudZones = nil
+			}},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			//given
+			clusterConfig, runtimeInput, kymaConfig := initializeConfigs()
+			validNetworking(clusterConfig.GardenerConfig)
+			testCase.modify(clusterConfig.GardenerConfig)
+
+			//when
+			err := NewValidator(reservedSeedCIDRs, nil).ValidateProvisioningInput(gqlschema.ProvisionRuntimeInput{
+				RuntimeInput:  runtimeInput,
+				ClusterConfig: clusterConfig,
+				KymaConfig:    kymaConfig,
+			})
+
+			//then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("Should return error when upgraded provider config has zone subnet outside of VPC", func(t *testing.T) {
+		//given
+		providerConfig := awsConfig()
+		providerConfig.AwsConfig.AwsZones[0].WorkerCidr = "10.251.0.0/19"
+
+		//when
+		err := NewValidator(reservedSeedCIDRs, nil).ValidateUpgradeShootInput(gqlschema.UpgradeShootInput{
+			GardenerConfig: &gqlschema.GardenerUpgradeInput{ProviderSpecificConfig: providerConfig},
+		})
+
+		//then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
 }
 
 func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput, *gqlschema.KymaConfigInput) {
@@ -314,7 +698,7 @@ func initializeConfigs() (*gqlschema.ClusterConfigInput, *gqlschema.RuntimeInput
 			Seed:                   util.StringPtr("2"),
 			TargetSecret:           "test-secret",
 			DiskType:               util.StringPtr("ssd"),
-			WorkerCidr:             "10.10.10.10/255",
+			WorkerCidr:             "10.250.0.0/16",
 			AutoScalerMin:          1,
 			AutoScalerMax:          3,
 			MaxSurge:               40,
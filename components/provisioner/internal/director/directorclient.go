@@ -1,6 +1,7 @@
 package director
 
 import (
+	"context"
 	"fmt"
 
 	directorApperrors "github.com/kyma-incubator/compass/components/director/pkg/apperrors"
@@ -22,13 +23,13 @@ const (
 
 //go:generate mockery --name=DirectorClient
 type DirectorClient interface {
-	CreateRuntime(config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError)
-	GetRuntime(id, tenant string) (graphql.RuntimeExt, apperrors.AppError)
-	UpdateRuntime(id string, config *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError
-	DeleteRuntime(id, tenant string) apperrors.AppError
-	SetRuntimeStatusCondition(id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError
-	GetConnectionToken(id, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError)
-	RuntimeExists(gardenerClusterName, tenant string) (bool, apperrors.AppError)
+	CreateRuntime(ctx context.Context, config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError)
+	GetRuntime(ctx context.Context, id, tenant string) (graphql.RuntimeExt, apperrors.AppError)
+	UpdateRuntime(ctx context.Context, id string, config *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError
+	DeleteRuntime(ctx context.Context, id, tenant string) apperrors.AppError
+	SetRuntimeStatusCondition(ctx context.Context, id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError
+	GetConnectionToken(ctx context.Context, id, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError)
+	RuntimeExists(ctx context.Context, gardenerClusterName, tenant string) (bool, apperrors.AppError)
 }
 
 type directorClient struct {
@@ -49,7 +50,7 @@ func NewDirectorClient(gqlClient gql.Client, oauthClient oauth.Client) DirectorC
 	}
 }
 
-func (cc *directorClient) CreateRuntime(config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError) {
+func (cc *directorClient) CreateRuntime(ctx context.Context, config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError) {
 	log.Infof("Registering Runtime on Director service")
 
 	if config == nil {
@@ -77,7 +78,7 @@ func (cc *directorClient) CreateRuntime(config *gqlschema.RuntimeInput, tenant s
 	runtimeQuery := cc.queryProvider.createRuntimeMutation(runtimeInput)
 
 	var response CreateRuntimeResponse
-	appErr := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	appErr := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if appErr != nil {
 		return "", appErr.Append("Failed to register runtime in Director. Request failed")
 	}
@@ -92,13 +93,13 @@ func (cc *directorClient) CreateRuntime(config *gqlschema.RuntimeInput, tenant s
 	return response.Result.ID, nil
 }
 
-func (cc *directorClient) GetRuntime(id, tenant string) (graphql.RuntimeExt, apperrors.AppError) {
+func (cc *directorClient) GetRuntime(ctx context.Context, id, tenant string) (graphql.RuntimeExt, apperrors.AppError) {
 	log.Infof("Getting Runtime from Director service")
 
 	runtimeQuery := cc.queryProvider.getRuntimeQuery(id)
 
 	var response GetRuntimeResponse
-	err := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	err := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if err != nil {
 		return graphql.RuntimeExt{}, err.Append("Failed to get runtime %s from Director", id)
 	}
@@ -113,7 +114,7 @@ func (cc *directorClient) GetRuntime(id, tenant string) (graphql.RuntimeExt, app
 	return *response.Result, nil
 }
 
-func (cc *directorClient) UpdateRuntime(id string, directorInput *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError {
+func (cc *directorClient) UpdateRuntime(ctx context.Context, id string, directorInput *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError {
 	log.Infof("Updating Runtime in Director service")
 
 	if directorInput == nil {
@@ -128,7 +129,7 @@ func (cc *directorClient) UpdateRuntime(id string, directorInput *graphql.Runtim
 	runtimeQuery := cc.queryProvider.updateRuntimeMutation(id, runtimeInput)
 
 	var response UpdateRuntimeResponse
-	appErr := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	appErr := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if appErr != nil {
 		return appErr.Append("Failed to update runtime %s in Director", id)
 	}
@@ -143,11 +144,11 @@ func (cc *directorClient) UpdateRuntime(id string, directorInput *graphql.Runtim
 	return nil
 }
 
-func (cc *directorClient) DeleteRuntime(id, tenant string) apperrors.AppError {
+func (cc *directorClient) DeleteRuntime(ctx context.Context, id, tenant string) apperrors.AppError {
 	runtimeQuery := cc.queryProvider.deleteRuntimeMutation(id)
 
 	var response DeleteRuntimeResponse
-	err := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	err := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if err != nil {
 		return err.Append("Failed to unregister runtime %s in Director", id)
 	}
@@ -165,11 +166,11 @@ func (cc *directorClient) DeleteRuntime(id, tenant string) apperrors.AppError {
 	return nil
 }
 
-func (cc *directorClient) RuntimeExists(id, tenant string) (bool, apperrors.AppError) {
+func (cc *directorClient) RuntimeExists(ctx context.Context, id, tenant string) (bool, apperrors.AppError) {
 	runtimeQuery := cc.queryProvider.getRuntimeQuery(id)
 
 	var response GetRuntimeResponse
-	err := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	err := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if err != nil {
 		if err.Code() == apperrors.CodeBadRequest && err.Cause() == apperrors.TenantNotFound {
 			return false, nil
@@ -184,11 +185,11 @@ func (cc *directorClient) RuntimeExists(id, tenant string) (bool, apperrors.AppE
 	return true, nil
 }
 
-func (cc *directorClient) SetRuntimeStatusCondition(id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError {
+func (cc *directorClient) SetRuntimeStatusCondition(ctx context.Context, id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError {
 	// TODO: Set StatusCondition without getting the Runtime
 	//       It'll be possible after this issue implementation:
 	//       - https://github.com/kyma-incubator/compass/issues/1186
-	runtime, err := cc.GetRuntime(id, tenant)
+	runtime, err := cc.GetRuntime(ctx, id, tenant)
 	if err != nil {
 		log.Errorf("Failed to get Runtime by ID: %s", err.Error())
 		return err.Append("failed to get runtime by ID")
@@ -199,7 +200,7 @@ func (cc *directorClient) SetRuntimeStatusCondition(id string, statusCondition g
 		StatusCondition: &statusCondition,
 		Labels:          runtime.Labels,
 	}
-	err = cc.UpdateRuntime(id, runtimeInput, tenant)
+	err = cc.UpdateRuntime(ctx, id, runtimeInput, tenant)
 	if err != nil {
 		log.Errorf("Failed to update Runtime in Director: %s", err.Error())
 		return err.Append("failed to update runtime in Director")
@@ -207,11 +208,11 @@ func (cc *directorClient) SetRuntimeStatusCondition(id string, statusCondition g
 	return nil
 }
 
-func (cc *directorClient) GetConnectionToken(id, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
+func (cc *directorClient) GetConnectionToken(ctx context.Context, id, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
 	runtimeQuery := cc.queryProvider.requestOneTimeTokenMutation(id)
 
 	var response OneTimeTokenResponse
-	err := cc.executeDirectorGraphQLCall(runtimeQuery, tenant, &response)
+	err := cc.executeDirectorGraphQLCall(ctx, runtimeQuery, tenant, &response)
 	if err != nil {
 		return graphql.OneTimeTokenForRuntimeExt{}, err.Append("Failed to get OneTimeToken for Runtime %s in Director", id)
 	}
@@ -239,7 +240,7 @@ func (cc *directorClient) getToken() apperrors.AppError {
 	return nil
 }
 
-func (cc *directorClient) executeDirectorGraphQLCall(directorQuery string, tenant string, response interface{}) apperrors.AppError {
+func (cc *directorClient) executeDirectorGraphQLCall(ctx context.Context, directorQuery string, tenant string, response interface{}) apperrors.AppError {
 	if cc.token.EmptyOrExpired() {
 		log.Infof("Refreshing token to access Director Service")
 		if err := cc.getToken(); err != nil {
@@ -251,7 +252,7 @@ func (cc *directorClient) executeDirectorGraphQLCall(directorQuery string, tenan
 	req.Header.Set(AuthorizationHeader, fmt.Sprintf("Bearer %s", cc.token.AccessToken))
 	req.Header.Set(TenantHeader, tenant)
 
-	if err := cc.gqlClient.Do(req, response); err != nil {
+	if err := cc.gqlClient.Do(ctx, req, response); err != nil {
 		if egErr, ok := err.(gcli.ExtendedError); ok {
 			return mapDirectorErrorToProvisionerError(egErr).Append("Failed to execute GraphQL request to Director")
 		}
@@ -1,6 +1,7 @@
 package director
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"testing"
@@ -105,7 +106,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.NoError(t, err)
@@ -125,7 +126,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -145,7 +146,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -160,7 +161,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -188,7 +189,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -215,7 +216,7 @@ func TestDirectorClient_RuntimeRegistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		receivedRuntimeID, err := configClient.CreateRuntime(runtimeInput, tenantValue)
+		receivedRuntimeID, err := configClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -255,7 +256,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.NoError(t, err)
@@ -274,7 +275,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -293,7 +294,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -307,7 +308,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -335,7 +336,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -361,7 +362,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -395,7 +396,7 @@ func TestDirectorClient_RuntimeUnregistering(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.DeleteRuntime(runtimeTestingID, tenantValue)
+		err := configClient.DeleteRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -436,7 +437,7 @@ func TestDirectorClient_GetConnectionToken(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		receivedOneTimeToken, err := configClient.GetConnectionToken(runtimeTestingID, tenantValue)
+		receivedOneTimeToken, err := configClient.GetConnectionToken(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -458,7 +459,7 @@ func TestDirectorClient_GetConnectionToken(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		receivedOneTimeToken, err := configClient.GetConnectionToken(runtimeTestingID, tenantValue)
+		receivedOneTimeToken, err := configClient.GetConnectionToken(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -478,7 +479,7 @@ func TestDirectorClient_GetConnectionToken(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		receivedOneTimeToken, err := configClient.GetConnectionToken(runtimeTestingID, tenantValue)
+		receivedOneTimeToken, err := configClient.GetConnectionToken(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -504,7 +505,7 @@ func TestDirectorClient_GetConnectionToken(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		receivedOneTimeToken, err := configClient.GetConnectionToken(runtimeTestingID, tenantValue)
+		receivedOneTimeToken, err := configClient.GetConnectionToken(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -544,7 +545,7 @@ func TestDirectorClient_GetRuntime(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		runtime, err := configClient.GetRuntime(runtimeTestingID, tenantValue)
+		runtime, err := configClient.GetRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -565,7 +566,7 @@ func TestDirectorClient_GetRuntime(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		runtime, err := configClient.GetRuntime(runtimeTestingID, tenantValue)
+		runtime, err := configClient.GetRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -592,7 +593,7 @@ func TestDirectorClient_GetRuntime(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		runtime, err := configClient.GetRuntime(runtimeTestingID, tenantValue)
+		runtime, err := configClient.GetRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -619,7 +620,7 @@ func TestDirectorClient_GetRuntime(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		runtime, err := configClient.GetRuntime(runtimeTestingID, tenantValue)
+		runtime, err := configClient.GetRuntime(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -667,7 +668,7 @@ func TestDirectorClient_UpdateRuntime(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.UpdateRuntime(runtimeTestingID, runtimeInput, tenantValue)
+		err := configClient.UpdateRuntime(context.Background(), runtimeTestingID, runtimeInput, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -696,7 +697,7 @@ func TestDirectorClient_UpdateRuntime(t *testing.T) {
 		configClient := NewDirectorClient(nil, mockedOAuthClient)
 
 		// when
-		err := configClient.UpdateRuntime(runtimeTestingID, runtimeInput, tenantValue)
+		err := configClient.UpdateRuntime(context.Background(), runtimeTestingID, runtimeInput, tenantValue)
 
 		// then
 		assert.Error(t, err)
@@ -732,7 +733,7 @@ func TestDirectorClient_UpdateRuntime(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.UpdateRuntime(runtimeTestingID, runtimeInput, tenantValue)
+		err := configClient.UpdateRuntime(context.Background(), runtimeTestingID, runtimeInput, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -794,7 +795,7 @@ func TestDirectorClient_SetRuntimeStatusCondition(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.SetRuntimeStatusCondition(runtimeTestingID, statusCondition, tenantValue)
+		err := configClient.SetRuntimeStatusCondition(context.Background(), runtimeTestingID, statusCondition, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -833,7 +834,7 @@ func TestDirectorClient_SetRuntimeStatusCondition(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.SetRuntimeStatusCondition(runtimeTestingID, statusCondition, tenantValue)
+		err := configClient.SetRuntimeStatusCondition(context.Background(), runtimeTestingID, statusCondition, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -878,7 +879,7 @@ func TestDirectorClient_SetRuntimeStatusCondition(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		err := configClient.SetRuntimeStatusCondition(runtimeTestingID, statusCondition, tenantValue)
+		err := configClient.SetRuntimeStatusCondition(context.Background(), runtimeTestingID, statusCondition, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -917,7 +918,7 @@ func TestDirectorClient_RuntimeExists(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		exists, err := configClient.RuntimeExists(runtimeTestingID, tenantValue)
+		exists, err := configClient.RuntimeExists(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -944,7 +945,7 @@ func TestDirectorClient_RuntimeExists(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		exists, err := configClient.RuntimeExists(runtimeTestingID, tenantValue)
+		exists, err := configClient.RuntimeExists(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -976,7 +977,7 @@ func TestDirectorClient_RuntimeExists(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		exists, err := configClient.RuntimeExists(runtimeTestingID, tenantValue)
+		exists, err := configClient.RuntimeExists(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.Error(t, err)
@@ -1008,7 +1009,7 @@ func TestDirectorClient_RuntimeExists(t *testing.T) {
 		configClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 		// when
-		exists, err := configClient.RuntimeExists(runtimeTestingID, tenantValue)
+		exists, err := configClient.RuntimeExists(context.Background(), runtimeTestingID, tenantValue)
 
 		// then
 		require.NoError(t, err)
@@ -1162,7 +1163,7 @@ func TestDirectorClient_MapDirectorErrors(t *testing.T) {
 			directorClient := NewDirectorClient(gqlClient, mockedOAuthClient)
 
 			// when
-			_, err := directorClient.CreateRuntime(runtimeInput, tenantValue)
+			_, err := directorClient.CreateRuntime(context.Background(), runtimeInput, tenantValue)
 
 			// then
 			require.Error(t, err)
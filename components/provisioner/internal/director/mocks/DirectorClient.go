@@ -1,8 +1,10 @@
-// Code generated by mockery v2.14.0. DO NOT EDIT.
+// Code generated by mockery v2.30.1. DO NOT EDIT.
 
 package mocks
 
 import (
+	context "context"
+
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -17,20 +19,23 @@ type DirectorClient struct {
 	mock.Mock
 }
 
-// CreateRuntime provides a mock function with given fields: config, tenant
-func (_m *DirectorClient) CreateRuntime(config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError) {
-	ret := _m.Called(config, tenant)
+// CreateRuntime provides a mock function with given fields: ctx, config, tenant
+func (_m *DirectorClient) CreateRuntime(ctx context.Context, config *gqlschema.RuntimeInput, tenant string) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, config, tenant)
 
 	var r0 string
-	if rf, ok := ret.Get(0).(func(*gqlschema.RuntimeInput, string) string); ok {
-		r0 = rf(config, tenant)
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, *gqlschema.RuntimeInput, string) (string, apperrors.AppError)); ok {
+		return rf(ctx, config, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *gqlschema.RuntimeInput, string) string); ok {
+		r0 = rf(ctx, config, tenant)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(*gqlschema.RuntimeInput, string) apperrors.AppError); ok {
-		r1 = rf(config, tenant)
+	if rf, ok := ret.Get(1).(func(context.Context, *gqlschema.RuntimeInput, string) apperrors.AppError); ok {
+		r1 = rf(ctx, config, tenant)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -40,13 +45,13 @@ func (_m *DirectorClient) CreateRuntime(config *gqlschema.RuntimeInput, tenant s
 	return r0, r1
 }
 
-// DeleteRuntime provides a mock function with given fields: id, tenant
-func (_m *DirectorClient) DeleteRuntime(id string, tenant string) apperrors.AppError {
-	ret := _m.Called(id, tenant)
+// DeleteRuntime provides a mock function with given fields: ctx, id, tenant
+func (_m *DirectorClient) DeleteRuntime(ctx context.Context, id string, tenant string) apperrors.AppError {
+	ret := _m.Called(ctx, id, tenant)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
-		r0 = rf(id, tenant)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) apperrors.AppError); ok {
+		r0 = rf(ctx, id, tenant)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -56,20 +61,23 @@ func (_m *DirectorClient) DeleteRuntime(id string, tenant string) apperrors.AppE
 	return r0
 }
 
-// GetConnectionToken provides a mock function with given fields: id, tenant
-func (_m *DirectorClient) GetConnectionToken(id string, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
-	ret := _m.Called(id, tenant)
+// GetConnectionToken provides a mock function with given fields: ctx, id, tenant
+func (_m *DirectorClient) GetConnectionToken(ctx context.Context, id string, tenant string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
+	ret := _m.Called(ctx, id, tenant)
 
 	var r0 graphql.OneTimeTokenForRuntimeExt
-	if rf, ok := ret.Get(0).(func(string, string) graphql.OneTimeTokenForRuntimeExt); ok {
-		r0 = rf(id, tenant)
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError)); ok {
+		return rf(ctx, id, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) graphql.OneTimeTokenForRuntimeExt); ok {
+		r0 = rf(ctx, id, tenant)
 	} else {
 		r0 = ret.Get(0).(graphql.OneTimeTokenForRuntimeExt)
 	}
 
-	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
-		r1 = rf(id, tenant)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id, tenant)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -79,20 +87,23 @@ func (_m *DirectorClient) GetConnectionToken(id string, tenant string) (graphql.
 	return r0, r1
 }
 
-// GetRuntime provides a mock function with given fields: id, tenant
-func (_m *DirectorClient) GetRuntime(id string, tenant string) (graphql.RuntimeExt, apperrors.AppError) {
-	ret := _m.Called(id, tenant)
+// GetRuntime provides a mock function with given fields: ctx, id, tenant
+func (_m *DirectorClient) GetRuntime(ctx context.Context, id string, tenant string) (graphql.RuntimeExt, apperrors.AppError) {
+	ret := _m.Called(ctx, id, tenant)
 
 	var r0 graphql.RuntimeExt
-	if rf, ok := ret.Get(0).(func(string, string) graphql.RuntimeExt); ok {
-		r0 = rf(id, tenant)
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (graphql.RuntimeExt, apperrors.AppError)); ok {
+		return rf(ctx, id, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) graphql.RuntimeExt); ok {
+		r0 = rf(ctx, id, tenant)
 	} else {
 		r0 = ret.Get(0).(graphql.RuntimeExt)
 	}
 
-	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
-		r1 = rf(id, tenant)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id, tenant)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -102,20 +113,23 @@ func (_m *DirectorClient) GetRuntime(id string, tenant string) (graphql.RuntimeE
 	return r0, r1
 }
 
-// RuntimeExists provides a mock function with given fields: gardenerClusterName, tenant
-func (_m *DirectorClient) RuntimeExists(gardenerClusterName string, tenant string) (bool, apperrors.AppError) {
-	ret := _m.Called(gardenerClusterName, tenant)
+// RuntimeExists provides a mock function with given fields: ctx, gardenerClusterName, tenant
+func (_m *DirectorClient) RuntimeExists(ctx context.Context, gardenerClusterName string, tenant string) (bool, apperrors.AppError) {
+	ret := _m.Called(ctx, gardenerClusterName, tenant)
 
 	var r0 bool
-	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
-		r0 = rf(gardenerClusterName, tenant)
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, apperrors.AppError)); ok {
+		return rf(ctx, gardenerClusterName, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, gardenerClusterName, tenant)
 	} else {
 		r0 = ret.Get(0).(bool)
 	}
 
-	var r1 apperrors.AppError
-	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
-		r1 = rf(gardenerClusterName, tenant)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, gardenerClusterName, tenant)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -125,13 +139,13 @@ func (_m *DirectorClient) RuntimeExists(gardenerClusterName string, tenant strin
 	return r0, r1
 }
 
-// SetRuntimeStatusCondition provides a mock function with given fields: id, statusCondition, tenant
-func (_m *DirectorClient) SetRuntimeStatusCondition(id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError {
-	ret := _m.Called(id, statusCondition, tenant)
+// SetRuntimeStatusCondition provides a mock function with given fields: ctx, id, statusCondition, tenant
+func (_m *DirectorClient) SetRuntimeStatusCondition(ctx context.Context, id string, statusCondition graphql.RuntimeStatusCondition, tenant string) apperrors.AppError {
+	ret := _m.Called(ctx, id, statusCondition, tenant)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, graphql.RuntimeStatusCondition, string) apperrors.AppError); ok {
-		r0 = rf(id, statusCondition, tenant)
+	if rf, ok := ret.Get(0).(func(context.Context, string, graphql.RuntimeStatusCondition, string) apperrors.AppError); ok {
+		r0 = rf(ctx, id, statusCondition, tenant)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -141,13 +155,13 @@ func (_m *DirectorClient) SetRuntimeStatusCondition(id string, statusCondition g
 	return r0
 }
 
-// UpdateRuntime provides a mock function with given fields: id, config, tenant
-func (_m *DirectorClient) UpdateRuntime(id string, config *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError {
-	ret := _m.Called(id, config, tenant)
+// UpdateRuntime provides a mock function with given fields: ctx, id, config, tenant
+func (_m *DirectorClient) UpdateRuntime(ctx context.Context, id string, config *graphql.RuntimeUpdateInput, tenant string) apperrors.AppError {
+	ret := _m.Called(ctx, id, config, tenant)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, *graphql.RuntimeUpdateInput, string) apperrors.AppError); ok {
-		r0 = rf(id, config, tenant)
+	if rf, ok := ret.Get(0).(func(context.Context, string, *graphql.RuntimeUpdateInput, string) apperrors.AppError); ok {
+		r0 = rf(ctx, id, config, tenant)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -157,13 +171,12 @@ func (_m *DirectorClient) UpdateRuntime(id string, config *graphql.RuntimeUpdate
 	return r0
 }
 
-type mockConstructorTestingTNewDirectorClient interface {
+// NewDirectorClient creates a new instance of DirectorClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewDirectorClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-}
-
-// NewDirectorClient creates a new instance of DirectorClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-func NewDirectorClient(t mockConstructorTestingTNewDirectorClient) *DirectorClient {
+}) *DirectorClient {
 	mock := &DirectorClient{}
 	mock.Mock.Test(t)
 
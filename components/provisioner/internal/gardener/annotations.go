@@ -36,6 +36,15 @@ func annotate(shoot *gardener_types.Shoot, annotation, value string) {
 	shoot.Annotations[annotation] = value
 }
 
+func getOperationId(shoot gardener_types.Shoot) string {
+	operationID, found := shoot.Annotations[operationIDAnnotation]
+	if !found {
+		return shoot.Annotations[legacyOperationIDAnnotation]
+	}
+
+	return operationID
+}
+
 func getRuntimeId(shoot gardener_types.Shoot) string {
 	runtimeID, found := shoot.Annotations[runtimeIDAnnotation]
 	if !found {
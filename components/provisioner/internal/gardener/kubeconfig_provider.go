@@ -35,8 +35,8 @@ type AdminKubeconfigRequester interface {
 	Create(ctx context.Context, obj gardenerClient.Object, subResource gardenerClient.Object, opts ...gardenerClient.SubResourceCreateOption) error
 }
 
-func (kp KubeconfigProvider) FetchFromShoot(shootName string) ([]byte, error) {
-	secret, err := kp.secretsClient.Get(context.Background(), fmt.Sprintf("%s.kubeconfig", shootName), v1.GetOptions{})
+func (kp KubeconfigProvider) FetchFromShoot(ctx context.Context, shootName string) ([]byte, error) {
+	secret, err := kp.secretsClient.Get(ctx, fmt.Sprintf("%s.kubeconfig", shootName), v1.GetOptions{})
 	if err != nil {
 		return nil, util.K8SErrorToAppError(err).Append("error fetching kubeconfig").SetComponent(apperrors.ErrGardenerClient)
 	}
@@ -49,8 +49,8 @@ func (kp KubeconfigProvider) FetchFromShoot(shootName string) ([]byte, error) {
 	return kubeconfig, nil
 }
 
-func (kp KubeconfigProvider) FetchFromRequest(shootName string) ([]byte, error) {
-	shoot, err := kp.gardenerShootClient.Get(context.Background(), shootName, v1.GetOptions{})
+func (kp KubeconfigProvider) FetchFromRequest(ctx context.Context, shootName string) ([]byte, error) {
+	shoot, err := kp.gardenerShootClient.Get(ctx, shootName, v1.GetOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +63,7 @@ func (kp KubeconfigProvider) FetchFromRequest(shootName string) ([]byte, error)
 		},
 	}
 
-	err = kp.adminKubeconfigRequest.Create(context.Background(), shoot, &adminKubeconfigRequest)
+	err = kp.adminKubeconfigRequest.Create(ctx, shoot, &adminKubeconfigRequest)
 	if err != nil {
 		kp.logger.Errorf("failed to create dynamic kubeconfig: %s", err)
 		return nil, err
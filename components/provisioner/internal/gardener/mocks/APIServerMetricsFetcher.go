@@ -0,0 +1,50 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// APIServerMetricsFetcher is an autogenerated mock type for the APIServerMetricsFetcher type
+type APIServerMetricsFetcher struct {
+	mock.Mock
+}
+
+// Fetch provides a mock function with given fields: kubeconfig
+func (_m *APIServerMetricsFetcher) Fetch(kubeconfig string) ([]byte, error) {
+	ret := _m.Called(kubeconfig)
+
+	var r0 []byte
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
+		return rf(kubeconfig)
+	}
+	if rf, ok := ret.Get(0).(func(string) []byte); ok {
+		r0 = rf(kubeconfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(kubeconfig)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAPIServerMetricsFetcher creates a new instance of APIServerMetricsFetcher. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAPIServerMetricsFetcher(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *APIServerMetricsFetcher {
+	mock := &APIServerMetricsFetcher{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// CloudProfileClient is an autogenerated mock type for the CloudProfileClient type
+type CloudProfileClient struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, name, opts
+func (_m *CloudProfileClient) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.CloudProfile, error) {
+	ret := _m.Called(ctx, name, opts)
+
+	var r0 *v1beta1.CloudProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) (*v1beta1.CloudProfile, error)); ok {
+		return rf(ctx, name, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) *v1beta1.CloudProfile); ok {
+		r0 = rf(ctx, name, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.CloudProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, v1.GetOptions) error); ok {
+		r1 = rf(ctx, name, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCloudProfileClient creates a new instance of CloudProfileClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCloudProfileClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CloudProfileClient {
+	mock := &CloudProfileClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,58 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// SeedClient is an autogenerated mock type for the SeedClient type
+type SeedClient struct {
+	mock.Mock
+}
+
+// Get provides a mock function with given fields: ctx, name, opts
+func (_m *SeedClient) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Seed, error) {
+	ret := _m.Called(ctx, name, opts)
+
+	var r0 *v1beta1.Seed
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) (*v1beta1.Seed, error)); ok {
+		return rf(ctx, name, opts)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, v1.GetOptions) *v1beta1.Seed); ok {
+		r0 = rf(ctx, name, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Seed)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, v1.GetOptions) error); ok {
+		r1 = rf(ctx, name, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSeedClient creates a new instance of SeedClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSeedClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SeedClient {
+	mock := &SeedClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,89 @@
+package gardener
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type NetworkingBackfillConfig struct {
+	Enabled bool          `envconfig:"default=false"`
+	Timeout time.Duration `envconfig:"default=10m"`
+}
+
+// NetworkingBackfill stores pods and services CIDRs of clusters provisioned before these values were persisted,
+// reading them from the Shoots of the clusters.
+type NetworkingBackfill struct {
+	session     dbsession.ReadWriteSession
+	shootClient Client
+	timeout     time.Duration
+	log         logrus.FieldLogger
+}
+
+func NewNetworkingBackfill(session dbsession.ReadWriteSession, shootClient Client, timeout time.Duration) *NetworkingBackfill {
+	return &NetworkingBackfill{
+		session:     session,
+		shootClient: shootClient,
+		timeout:     timeout,
+		log:         logrus.WithField("Component", "NetworkingBackfill"),
+	}
+}
+
+// Run fills in the missing CIDRs of the existing clusters within the timeout.
+// Clusters whose Shoots no longer exist or have no CIDRs are marked as checked and are not fetched on the next run.
+func (b *NetworkingBackfill) Run() {
+	ctx, cancel := context.WithTimeout(context.Background(), b.timeout)
+	defer cancel()
+
+	configs, dberr := b.session.ListGardenerConfigsWithoutNetworkingCIDRs()
+	if dberr != nil {
+		b.log.Errorf("failed to list clusters without networking CIDRs: %s", dberr.Error())
+		return
+	}
+
+	updated := 0
+	for _, config := range configs {
+		if ctx.Err() != nil {
+			b.log.Warnf("Timeout of %s reached, remaining clusters will be checked on the next run", b.timeout)
+			break
+		}
+
+		log := b.log.WithField("RuntimeId", config.ClusterID).WithField("Shoot", config.Name)
+
+		shoot, err := b.shootClient.Get(ctx, config.Name, v1.GetOptions{})
+		if err != nil {
+			if errors.IsNotFound(err) {
+				log.Warn("Shoot not found, skipping")
+				b.markChecked(log, config.ClusterID)
+				continue
+			}
+			log.Errorf("failed to get Shoot: %s", err.Error())
+			continue
+		}
+
+		if shoot.Spec.Networking == nil || (shoot.Spec.Networking.Pods == nil && shoot.Spec.Networking.Services == nil) {
+			log.Info("Shoot has no networking CIDRs, skipping")
+			b.markChecked(log, config.ClusterID)
+			continue
+		}
+
+		dberr = b.session.UpdateNetworkingCIDRs(config.ClusterID, shoot.Spec.Networking.Pods, shoot.Spec.Networking.Services)
+		if dberr != nil {
+			log.Errorf("failed to update networking CIDRs: %s", dberr.Error())
+			continue
+		}
+		updated++
+	}
+
+	b.log.Infof("Stored networking CIDRs of %d out of %d clusters", updated, len(configs))
+}
+
+func (b *NetworkingBackfill) markChecked(log logrus.FieldLogger, runtimeID string) {
+	if dberr := b.session.MarkNetworkingCIDRsChecked(runtimeID); dberr != nil {
+		log.Errorf("failed to mark networking CIDRs as checked: %s", dberr.Error())
+	}
+}
@@ -0,0 +1,114 @@
+package gardener
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/mock"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestNetworkingBackfill_Run(t *testing.T) {
+	podsCIDR := util.StringPtr("100.96.0.0/11")
+	servicesCIDR := util.StringPtr("100.104.0.0/13")
+
+	fixShoot := func(networking *gardener_Types.Networking) *gardener_Types.Shoot {
+		return &gardener_Types.Shoot{Spec: gardener_Types.ShootSpec{Networking: networking}}
+	}
+
+	t.Run("should store CIDRs read from Shoots and mark clusters without CIDRs as checked", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		shootClient := &mocks.Client{}
+
+		session.On("ListGardenerConfigsWithoutNetworkingCIDRs").Return([]model.GardenerConfig{
+			{ClusterID: "runtime-1", Name: "shoot-1"},
+			{ClusterID: "runtime-2", Name: "shoot-2"},
+			{ClusterID: "runtime-3", Name: "shoot-3"},
+			{ClusterID: "runtime-4", Name: "shoot-4"},
+		}, nil)
+		shootClient.On("Get", mock.Anything, "shoot-1", metav1.GetOptions{}).
+			Return(fixShoot(&gardener_Types.Networking{Pods: podsCIDR, Services: servicesCIDR}), nil)
+		shootClient.On("Get", mock.Anything, "shoot-2", metav1.GetOptions{}).
+			Return(nil, k8sErrors.NewNotFound(schema.GroupResource{}, "shoot-2"))
+		shootClient.On("Get", mock.Anything, "shoot-3", metav1.GetOptions{}).
+			Return(fixShoot(nil), nil)
+		shootClient.On("Get", mock.Anything, "shoot-4", metav1.GetOptions{}).
+			Return(fixShoot(&gardener_Types.Networking{Pods: podsCIDR}), nil)
+		session.On("UpdateNetworkingCIDRs", "runtime-1", podsCIDR, servicesCIDR).Return(nil)
+		session.On("UpdateNetworkingCIDRs", "runtime-4", podsCIDR, (*string)(nil)).Return(dberrors.Internal("error"))
+		session.On("MarkNetworkingCIDRsChecked", "runtime-2").Return(nil)
+		session.On("MarkNetworkingCIDRsChecked", "runtime-3").Return(nil)
+
+		// when
+		NewNetworkingBackfill(session, shootClient, time.Minute).Run()
+
+		// then
+		session.AssertExpectations(t)
+		shootClient.AssertExpectations(t)
+	})
+
+	t.Run("should continue when failed to get Shoot", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		shootClient := &mocks.Client{}
+
+		session.On("ListGardenerConfigsWithoutNetworkingCIDRs").Return([]model.GardenerConfig{
+			{ClusterID: "runtime-1", Name: "shoot-1"},
+			{ClusterID: "runtime-2", Name: "shoot-2"},
+		}, nil)
+		shootClient.On("Get", mock.Anything, "shoot-1", metav1.GetOptions{}).Return(nil, errors.New("error"))
+		shootClient.On("Get", mock.Anything, "shoot-2", metav1.GetOptions{}).
+			Return(fixShoot(&gardener_Types.Networking{Pods: podsCIDR, Services: servicesCIDR}), nil)
+		session.On("UpdateNetworkingCIDRs", "runtime-2", podsCIDR, servicesCIDR).Return(nil)
+
+		// when
+		NewNetworkingBackfill(session, shootClient, time.Minute).Run()
+
+		// then
+		session.AssertExpectations(t)
+		shootClient.AssertExpectations(t)
+		session.AssertNotCalled(t, "MarkNetworkingCIDRsChecked", "runtime-1")
+	})
+
+	t.Run("should stop getting Shoots when timeout is reached", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		shootClient := &mocks.Client{}
+
+		session.On("ListGardenerConfigsWithoutNetworkingCIDRs").Return([]model.GardenerConfig{
+			{ClusterID: "runtime-1", Name: "shoot-1"},
+		}, nil)
+
+		// when
+		NewNetworkingBackfill(session, shootClient, 0).Run()
+
+		// then
+		session.AssertExpectations(t)
+		shootClient.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should not get Shoots when failed to list clusters", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		shootClient := &mocks.Client{}
+
+		session.On("ListGardenerConfigsWithoutNetworkingCIDRs").Return(nil, dberrors.Internal("error"))
+
+		// when
+		NewNetworkingBackfill(session, shootClient, time.Minute).Run()
+
+		// then
+		session.AssertExpectations(t)
+		shootClient.AssertNotCalled(t, "Get", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
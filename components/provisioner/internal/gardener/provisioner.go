@@ -37,13 +37,14 @@ func NewProvisioner(
 	namespace string,
 	shootClient Client,
 	factory dbsession.Factory,
-	policyConfigMapName string, maintenanceWindowConfigPath string) *GardenerProvisioner {
+	policyConfigMapName string, maintenanceWindowConfigPath string, hibernationPolicyConfigPath string) *GardenerProvisioner {
 	return &GardenerProvisioner{
 		namespace:                   namespace,
 		shootClient:                 shootClient,
 		dbSessionFactory:            factory,
 		policyConfigMapName:         policyConfigMapName,
 		maintenanceWindowConfigPath: maintenanceWindowConfigPath,
+		hibernationPolicyConfigPath: hibernationPolicyConfigPath,
 	}
 }
 
@@ -54,12 +55,44 @@ type GardenerProvisioner struct {
 	directorService             director.DirectorClient
 	policyConfigMapName         string
 	maintenanceWindowConfigPath string
+	hibernationPolicyConfigPath string
 }
 
-func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
+func (g *GardenerProvisioner) ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError {
+	shootTemplate, err := g.newShootTemplate(cluster, operationId)
+	if err != nil {
+		return err
+	}
+
+	_, k8serr := g.shootClient.Create(ctx, shootTemplate, v1.CreateOptions{})
+	if k8serr != nil {
+		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
+		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
+	}
+
+	return nil
+}
+
+// DryRunProvisionCluster renders the Shoot of the cluster and submits it to Gardener server-side dry run, so the Shoot is validated but not created.
+func (g *GardenerProvisioner) DryRunProvisionCluster(cluster model.Cluster) (*gardener_types.Shoot, apperrors.AppError) {
+	shootTemplate, err := g.newShootTemplate(cluster, "")
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, k8serr := g.shootClient.Create(context.Background(), shootTemplate, v1.CreateOptions{DryRun: []string{v1.DryRunAll}})
+	if k8serr != nil {
+		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
+		return nil, appError.Append("error in dry run of creating Shoot for %s cluster", cluster.ID)
+	}
+
+	return shoot, nil
+}
+
+func (g *GardenerProvisioner) newShootTemplate(cluster model.Cluster, operationId string) (*gardener_types.Shoot, apperrors.AppError) {
 	shootTemplate, err := cluster.ClusterConfig.ToShootTemplate(g.namespace, cluster.Tenant, util.UnwrapStr(cluster.SubAccountId), cluster.ClusterConfig.OIDCConfig, cluster.ClusterConfig.DNSConfig)
 	if err != nil {
-		return err.Append("failed to convert cluster config to Shoot template")
+		return nil, err.Append("failed to convert cluster config to Shoot template")
 	}
 
 	region := cluster.ClusterConfig.Region
@@ -72,7 +105,7 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 		err := g.setMaintenanceWindow(shootTemplate, region)
 
 		if err != nil {
-			return err.Append("error setting maintenance window for %s cluster", cluster.ID)
+			return nil, err.Append("error setting maintenance window for %s cluster", cluster.ID)
 		}
 	}
 
@@ -85,18 +118,12 @@ func (g *GardenerProvisioner) ProvisionCluster(cluster model.Cluster, operationI
 		g.applyAuditConfig(shootTemplate)
 	}
 
-	_, k8serr := g.shootClient.Create(context.Background(), shootTemplate, v1.CreateOptions{})
-	if k8serr != nil {
-		appError := util.K8SErrorToAppError(k8serr).SetComponent(apperrors.ErrGardenerClient)
-		return appError.Append("error creating Shoot for %s cluster: %s", cluster.ID)
-	}
-
-	return nil
+	return shootTemplate, nil
 }
 
-func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
+func (g *GardenerProvisioner) UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig, operationID string) apperrors.AppError {
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
-		shoot, err := g.shootClient.Get(context.Background(), upgradeConfig.Name, v1.GetOptions{})
+		shoot, err := g.shootClient.Get(ctx, upgradeConfig.Name, v1.GetOptions{})
 		if err != nil {
 			appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 			return appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, upgradeConfig.Name)
@@ -108,6 +135,9 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 			return appErr.Append("error while updating Gardener shoot configuration")
 		}
 
+		annotate(shoot, operationIDAnnotation, operationID)
+		annotate(shoot, legacyOperationIDAnnotation, operationID)
+
 		setObjectFields(shoot)
 
 		shootData, err := json.Marshal(shoot)
@@ -116,7 +146,7 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 			return apperr.Append("error during marshaling Shoot data")
 		}
 
-		_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
+		_, err = g.shootClient.Patch(ctx, shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
 		return err
 	})
 	if err != nil {
@@ -127,8 +157,75 @@ func (g *GardenerProvisioner) UpgradeCluster(clusterID string, upgradeConfig mod
 	return nil
 }
 
-func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
-	shoot, err := g.shootClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+// DryRunUpgradeCluster applies the upgrade config to the Shoot using Gardener server-side dry run.
+// It returns the current Shoot and the Shoot rendered by Gardener, which is not persisted.
+func (g *GardenerProvisioner) DryRunUpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) (*gardener_types.Shoot, *gardener_types.Shoot, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(context.Background(), upgradeConfig.Name, v1.GetOptions{})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return nil, nil, appErr.Append("error getting Shoot for cluster ID %s and name %s", clusterID, upgradeConfig.Name)
+	}
+
+	upgradedShoot := shoot.DeepCopy()
+
+	appErr := upgradeConfig.GardenerProviderConfig.EditShootConfig(upgradeConfig, upgradedShoot)
+	if appErr != nil {
+		return nil, nil, appErr.Append("error while updating Gardener shoot configuration")
+	}
+
+	setObjectFields(upgradedShoot)
+
+	shootData, err := json.Marshal(upgradedShoot)
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
+		return nil, nil, apperr.Append("error during marshaling Shoot data")
+	}
+
+	renderedShoot, err := g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData,
+		v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true), DryRun: []string{v1.DryRunAll}})
+	if err != nil {
+		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return nil, nil, apperr.Append("error in dry run of updating shoot configuration")
+	}
+
+	return shoot, renderedShoot, nil
+}
+
+func (g *GardenerProvisioner) HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	return g.setHibernation(ctx, clusterID, gardenerConfig.Name, operationID, true)
+}
+
+func (g *GardenerProvisioner) WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	return g.setHibernation(ctx, clusterID, gardenerConfig.Name, operationID, false)
+}
+
+func (g *GardenerProvisioner) setHibernation(ctx context.Context, clusterID, shootName, operationID string, enabled bool) apperrors.AppError {
+	hibernationPatch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				operationIDAnnotation:       operationID,
+				legacyOperationIDAnnotation: operationID,
+			},
+		},
+		"spec": map[string]interface{}{
+			"hibernation": map[string]bool{"enabled": enabled},
+		},
+	})
+	if err != nil {
+		return apperrors.Internal("error during marshaling hibernation patch: %s", err.Error())
+	}
+
+	_, err = g.shootClient.Patch(ctx, shootName, types.MergePatchType, hibernationPatch, v1.PatchOptions{FieldManager: "provisioner"})
+	if err != nil {
+		appErr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		return appErr.Append("error setting hibernation of Shoot for cluster ID %s and name %s", clusterID, shootName)
+	}
+
+	return nil
+}
+
+func (g *GardenerProvisioner) DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
+	shoot, err := g.shootClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		if k8sErrors.IsNotFound(err) {
 			message := fmt.Sprintf("Cluster %s already deleted. Proceeding to DeprovisionCluster stage.", cluster.ID)
@@ -159,7 +256,7 @@ func (g *GardenerProvisioner) DeprovisionCluster(cluster model.Cluster, operatio
 		apperr := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrProvisioner)
 		return model.Operation{}, apperr.Append("error during marshaling Shoot data")
 	}
-	_, err = g.shootClient.Patch(context.Background(), shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
+	_, err = g.shootClient.Patch(ctx, shoot.Name, types.ApplyPatchType, shootData, v1.PatchOptions{FieldManager: "provisioner", Force: util.BoolPtr(true)})
 
 	if err != nil {
 		appError := util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
@@ -207,6 +304,30 @@ func (g *GardenerProvisioner) applyAuditConfig(template *gardener_types.Shoot) {
 	}
 }
 
+// DefaultHibernationSchedules returns the hibernation schedules configured for the cluster purpose in the hibernation policy.
+// No schedules are returned when the hibernation policy is not configured or its file does not exist.
+func (g *GardenerProvisioner) DefaultHibernationSchedules(purpose string) ([]model.HibernationSchedule, apperrors.AppError) {
+	if g.hibernationPolicyConfigPath == "" {
+		return nil, nil
+	}
+
+	if _, err := os.Stat(g.hibernationPolicyConfigPath); os.IsNotExist(err) {
+		logrus.Warnf("Hibernation policy file %s not found, no default hibernation schedules are set", g.hibernationPolicyConfigPath)
+		return nil, nil
+	}
+
+	schedules, err := g.getHibernationSchedulesByPurpose(purpose)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(schedules) == 0 {
+		logrus.Infof("No default hibernation schedules configured for purpose %s", purpose)
+	}
+
+	return schedules, nil
+}
+
 func (g *GardenerProvisioner) setMaintenanceWindow(template *gardener_types.Shoot, region string) apperrors.AppError {
 	window, err := g.getWindowByRegion(region)
 
@@ -251,6 +372,24 @@ func (g *GardenerProvisioner) getWindowByRegion(region string) (TimeWindow, appe
 	return window, nil
 }
 
+func (g *GardenerProvisioner) getHibernationSchedulesByPurpose(purpose string) ([]model.HibernationSchedule, apperrors.AppError) {
+	data, err := getDataFromFile(g.hibernationPolicyConfigPath, purpose)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var schedules []model.HibernationSchedule
+
+	mapErr := mapstructure.Decode(data, &schedules)
+
+	if mapErr != nil {
+		return nil, apperrors.Internal("failed to parse map to struct: %s", mapErr.Error())
+	}
+
+	return schedules, nil
+}
+
 type TimeWindow struct {
 	Begin string
 	End   string
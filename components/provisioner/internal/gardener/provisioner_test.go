@@ -15,13 +15,16 @@ import (
 	"github.com/gardener/gardener/pkg/client/core/clientset/versioned/fake"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	gardenerMocks "github.com/kyma-project/control-plane/components/provisioner/internal/gardener/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -52,10 +55,10 @@ func TestGardenerProvisioner_ProvisionCluster(t *testing.T) {
 		// given
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath)
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, maintWindowConfigPath, "")
 
 		// when
-		apperr := provisionerClient.ProvisionCluster(cluster, operationId)
+		apperr := provisionerClient.ProvisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -104,12 +107,12 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "")
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", "")
 
 		// when
 		sessionFactoryMock.On("NewWriteSession").Return(session)
 
-		operation, apperr := provisionerClient.DeprovisionCluster(cluster, operationId)
+		operation, apperr := provisionerClient.DeprovisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -131,13 +134,13 @@ func TestGardenerProvisioner_DeprovisionCluster(t *testing.T) {
 
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
-		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "")
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, sessionFactoryMock, auditLogsPolicyCMName, "", "")
 
 		// when
 		sessionFactoryMock.On("NewWriteSession").Return(session)
 		session.On("MarkClusterAsDeleted", cluster.ID).Return(nil)
 
-		operation, apperr := provisionerClient.DeprovisionCluster(cluster, operationId)
+		operation, apperr := provisionerClient.DeprovisionCluster(context.Background(), cluster, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -175,6 +178,10 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 				ToWorker()).
 		WithPSPAdmissionPluginDisabled().
 		ToShoot()
+	expectedShoot.Annotations = map[string]string{
+		operationIDAnnotation:       operationId,
+		legacyOperationIDAnnotation: operationId,
+	}
 
 	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
 	require.NoError(t, err)
@@ -186,10 +193,10 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
 		sessionFactory := &sessionMocks.Factory{}
-		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", "")
 
 		// when
-		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
+		apperr := provisioner.UpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig, operationId)
 		require.NoError(t, apperr)
 
 		// then
@@ -203,10 +210,10 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
 
 		sessionFactory := &sessionMocks.Factory{}
-		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "")
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", "")
 
 		// when
-		apperr := provisioner.UpgradeCluster(cluster.ID, cluster.ClusterConfig)
+		apperr := provisioner.UpgradeCluster(context.Background(), cluster.ID, cluster.ClusterConfig, operationId)
 
 		// then
 		require.Error(t, apperr)
@@ -214,6 +221,63 @@ func TestGardenerProvisioner_UpgradeCluster(t *testing.T) {
 	})
 }
 
+func TestGardenerProvisioner_DryRun(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	isDryRun := func(dryRun []string) bool {
+		return len(dryRun) == 1 && dryRun[0] == v1.DryRunAll
+	}
+
+	t.Run("should create Shoot in dry run mode", func(t *testing.T) {
+		// given
+		shootClient := &gardenerMocks.Client{}
+		renderedShoot := testkit.NewTestShoot(clusterName).ToShoot()
+		shootClient.On("Create", mock.Anything, mock.AnythingOfType("*v1beta1.Shoot"), mock.MatchedBy(func(opts v1.CreateOptions) bool {
+			return isDryRun(opts.DryRun)
+		})).Return(renderedShoot, nil)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", "")
+
+		// when
+		shoot, apperr := provisioner.DryRunProvisionCluster(cluster)
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, renderedShoot, shoot)
+		shootClient.AssertExpectations(t)
+	})
+
+	t.Run("should patch Shoot in dry run mode", func(t *testing.T) {
+		// given
+		shootClient := &gardenerMocks.Client{}
+		currentShoot := testkit.NewTestShoot(clusterName).
+			InNamespace(gardenerNamespace).
+			WithAutoUpdate(false, false).
+			WithWorkers(testkit.NewTestWorker("peon").ToWorker()).
+			ToShoot()
+		renderedShoot := currentShoot.DeepCopy()
+		renderedShoot.Spec.Kubernetes.Version = cluster.ClusterConfig.KubernetesVersion
+
+		shootClient.On("Get", mock.Anything, clusterName, v1.GetOptions{}).Return(currentShoot, nil)
+		shootClient.On("Patch", mock.Anything, clusterName, types.ApplyPatchType, mock.Anything, mock.MatchedBy(func(opts v1.PatchOptions) bool {
+			return isDryRun(opts.DryRun)
+		})).Return(renderedShoot, nil)
+
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", "")
+
+		// when
+		current, rendered, apperr := provisioner.DryRunUpgradeCluster(cluster.ID, cluster.ClusterConfig)
+
+		// then
+		require.NoError(t, apperr)
+		assert.Equal(t, "", current.Spec.Kubernetes.Version)
+		assert.Equal(t, renderedShoot, rendered)
+		shootClient.AssertExpectations(t)
+	})
+}
+
 func newClusterConfig(name string, subAccountID *string, providerConfig model.GardenerProviderConfig, region string, purpose string) model.Cluster {
 	return model.Cluster{
 		ID:           runtimeId,
@@ -255,15 +319,15 @@ func TestGardenerProvisioner_ClusterPurpose(t *testing.T) {
 
 	t.Run("should start provisioning with 2 clusters with different purpose", func(t *testing.T) {
 		shootClient_A := clientset_A.CoreV1beta1().Shoots(gardenerNamespace)
-		provisionerClient_A := NewProvisioner(gardenerNamespace, shootClient_A, nil, auditLogsPolicyCMName, maintWindowConfigPath)
+		provisionerClient_A := NewProvisioner(gardenerNamespace, shootClient_A, nil, auditLogsPolicyCMName, maintWindowConfigPath, "")
 
 		shootClient_B := clientset_B.CoreV1beta1().Shoots(gardenerNamespace)
-		provisionerClient_B := NewProvisioner(gardenerNamespace, shootClient_B, nil, auditLogsPolicyCMName, maintWindowConfigPath)
+		provisionerClient_B := NewProvisioner(gardenerNamespace, shootClient_B, nil, auditLogsPolicyCMName, maintWindowConfigPath, "")
 
 		//when
-		apperr_A := provisionerClient_A.ProvisionCluster(cluster_A, operationId)
+		apperr_A := provisionerClient_A.ProvisionCluster(context.Background(), cluster_A, operationId)
 		require.NoError(t, apperr_A)
-		apperr_B := provisionerClient_B.ProvisionCluster(cluster_B, operationId)
+		apperr_B := provisionerClient_B.ProvisionCluster(context.Background(), cluster_B, operationId)
 		require.NoError(t, apperr_B)
 
 		//then
@@ -275,6 +339,89 @@ func TestGardenerProvisioner_ClusterPurpose(t *testing.T) {
 	})
 }
 
+func TestGardenerProvisioner_DefaultHibernationSchedules(t *testing.T) {
+	hibernationPolicyConfigPath := filepath.Join("testdata", "hibernationpolicy.json")
+
+	t.Run("should return default hibernation schedules of cluster purpose", func(t *testing.T) {
+		// given
+		provisionerClient := NewProvisioner(gardenerNamespace, nil, nil, auditLogsPolicyCMName, "", hibernationPolicyConfigPath)
+
+		// when
+		schedules, apperr := provisionerClient.DefaultHibernationSchedules("development")
+
+		// then
+		require.NoError(t, apperr)
+		require.Len(t, schedules, 1)
+		assert.Equal(t, "00 20 * * 1,2,3,4,5", *schedules[0].Start)
+		assert.Equal(t, "00 07 * * 1,2,3,4,5", *schedules[0].End)
+		assert.Equal(t, "Europe/Berlin", *schedules[0].Location)
+	})
+
+	t.Run("should not return hibernation schedules for purpose without policy", func(t *testing.T) {
+		// given
+		provisionerClient := NewProvisioner(gardenerNamespace, nil, nil, auditLogsPolicyCMName, "", hibernationPolicyConfigPath)
+
+		// when
+		schedules, apperr := provisionerClient.DefaultHibernationSchedules(purpose)
+
+		// then
+		require.NoError(t, apperr)
+		assert.Empty(t, schedules)
+	})
+
+	t.Run("should not return hibernation schedules when policy file does not exist", func(t *testing.T) {
+		// given
+		provisionerClient := NewProvisioner(gardenerNamespace, nil, nil, auditLogsPolicyCMName, "", filepath.Join("testdata", "not-existing.json"))
+
+		// when
+		schedules, apperr := provisionerClient.DefaultHibernationSchedules("development")
+
+		// then
+		require.NoError(t, apperr)
+		assert.Nil(t, schedules)
+	})
+
+	t.Run("should not return hibernation schedules when policy is not configured", func(t *testing.T) {
+		// given
+		provisionerClient := NewProvisioner(gardenerNamespace, nil, nil, auditLogsPolicyCMName, "", "")
+
+		// when
+		schedules, apperr := provisionerClient.DefaultHibernationSchedules("development")
+
+		// then
+		require.NoError(t, apperr)
+		assert.Nil(t, schedules)
+	})
+}
+
+func TestGardenerProvisioner_HibernationSchedules(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+
+	t.Run("should set hibernation schedules of cluster", func(t *testing.T) {
+		// given
+		cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, "development")
+		cluster.ClusterConfig.HibernationSchedules = []model.HibernationSchedule{
+			{Start: util.StringPtr("00 22 * * *"), End: util.StringPtr("00 06 * * *")},
+		}
+
+		shootClient := fake.NewSimpleClientset().CoreV1beta1().Shoots(gardenerNamespace)
+		provisionerClient := NewProvisioner(gardenerNamespace, shootClient, nil, auditLogsPolicyCMName, "", filepath.Join("testdata", "hibernationpolicy.json"))
+
+		// when
+		apperr := provisionerClient.ProvisionCluster(context.Background(), cluster, operationId)
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Hibernation)
+		require.Len(t, shoot.Spec.Hibernation.Schedules, 1)
+		assert.Equal(t, "00 22 * * *", *shoot.Spec.Hibernation.Schedules[0].Start)
+		assert.Nil(t, shoot.Spec.Hibernation.Schedules[0].Location)
+	})
+}
+
 func assertAnnotation(t *testing.T, shoot *gardener_types.Shoot, name, value string) {
 	annotations := shoot.Annotations
 	if annotations == nil {
@@ -290,3 +437,56 @@ func assertAnnotation(t *testing.T, shoot *gardener_types.Shoot, name, value str
 
 	assert.Equal(t, value, val, fmt.Sprintf("invalid value for %s annotation", name))
 }
+
+func TestGardenerProvisioner_HibernateCluster(t *testing.T) {
+	gcpGardenerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"zone-1"}})
+	require.NoError(t, err)
+	cluster := newClusterConfig(clusterName, nil, gcpGardenerConfig, region, purpose)
+
+	t.Run("should enable and disable Shoot hibernation", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset(testkit.NewTestShoot(clusterName).InNamespace(gardenerNamespace).ToShoot())
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", "")
+
+		// when
+		apperr := provisioner.HibernateCluster(context.Background(), cluster.ID, cluster.ClusterConfig, "hibernation-operation")
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err := shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		require.NotNil(t, shoot.Spec.Hibernation)
+		assert.True(t, *shoot.Spec.Hibernation.Enabled)
+		assertAnnotation(t, shoot, operationIDAnnotation, "hibernation-operation")
+		assertAnnotation(t, shoot, legacyOperationIDAnnotation, "hibernation-operation")
+
+		// when
+		apperr = provisioner.WakeUpCluster(context.Background(), cluster.ID, cluster.ClusterConfig, "wake-up-operation")
+		require.NoError(t, apperr)
+
+		// then
+		shoot, err = shootClient.Get(context.Background(), clusterName, v1.GetOptions{})
+		require.NoError(t, err)
+		assert.False(t, *shoot.Spec.Hibernation.Enabled)
+		assertAnnotation(t, shoot, operationIDAnnotation, "wake-up-operation")
+		assertAnnotation(t, shoot, legacyOperationIDAnnotation, "wake-up-operation")
+	})
+
+	t.Run("should return error when Shoot does not exist", func(t *testing.T) {
+		// given
+		clientset := fake.NewSimpleClientset()
+		shootClient := clientset.CoreV1beta1().Shoots(gardenerNamespace)
+
+		sessionFactory := &sessionMocks.Factory{}
+		provisioner := NewProvisioner(gardenerNamespace, shootClient, sessionFactory, auditLogsPolicyCMName, "", "")
+
+		// when
+		apperr := provisioner.HibernateCluster(context.Background(), cluster.ID, cluster.ClusterConfig, operationId)
+
+		// then
+		require.Error(t, apperr)
+	})
+}
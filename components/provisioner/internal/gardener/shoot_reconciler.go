@@ -3,6 +3,7 @@ package gardener
 import (
 	"context"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"k8s.io/apimachinery/pkg/types"
 
@@ -18,10 +19,16 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// OperationQueue is used to trigger processing of the operation when its Shoot changes.
+type OperationQueue interface {
+	Add(operationId string)
+}
+
 func NewReconciler(
 	mgr ctrl.Manager,
 	dbsFactory dbsession.Factory,
-	auditLogConfigurator AuditLogConfigurator) *Reconciler {
+	auditLogConfigurator AuditLogConfigurator,
+	operationQueues map[model.OperationType]OperationQueue) *Reconciler {
 	return &Reconciler{
 		client: mgr.GetClient(),
 		scheme: mgr.GetScheme(),
@@ -29,6 +36,7 @@ func NewReconciler(
 
 		dbsFactory:           dbsFactory,
 		auditLogConfigurator: auditLogConfigurator,
+		operationQueues:      operationQueues,
 	}
 }
 
@@ -40,6 +48,7 @@ type Reconciler struct {
 	log *logrus.Entry
 
 	auditLogConfigurator AuditLogConfigurator
+	operationQueues      map[model.OperationType]OperationQueue
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -49,6 +58,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	var shoot gardener_types.Shoot
 	if err := r.client.Get(ctx, req.NamespacedName, &shoot); err != nil {
 		if errors.IsNotFound(err) {
+			r.enqueueLastOperation(log, req.Name)
 			return ctrl.Result{}, nil
 		}
 
@@ -68,6 +78,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	runtimeId := getRuntimeId(shoot)
 	log = log.WithField("RuntimeId", runtimeId)
 
+	r.enqueueOperation(log, getOperationId(shoot))
+
 	seedName := getSeedName(shoot)
 
 	if r.auditLogConfigurator.CanEnableAuditLogsForShoot(seedName) {
@@ -93,6 +105,54 @@ func (r *Reconciler) shouldReconcileShoot(shoot gardener_types.Shoot) (bool, err
 	return true, nil
 }
 
+// enqueueOperation triggers processing of the in progress operation, so that it does not wait for the next poll of the Shoot
+func (r *Reconciler) enqueueOperation(logger logrus.FieldLogger, operationId string) {
+	if operationId == "" {
+		return
+	}
+
+	operation, err := r.dbsFactory.NewReadSession().GetOperation(operationId)
+	if err != nil {
+		logger.Debugf("Cannot get %s operation: %s", operationId, err.Error())
+		return
+	}
+
+	r.enqueueInProgressOperation(logger, operation)
+}
+
+// enqueueLastOperation triggers processing of the in progress operation of the cluster whose Shoot was removed
+func (r *Reconciler) enqueueLastOperation(logger logrus.FieldLogger, shootName string) {
+	session := r.dbsFactory.NewReadSession()
+
+	cluster, err := session.GetGardenerClusterByName(shootName)
+	if err != nil {
+		logger.Debugf("Cannot get cluster of %s shoot: %s", shootName, err.Error())
+		return
+	}
+
+	operation, err := session.GetLastOperation(cluster.ID)
+	if err != nil {
+		logger.Debugf("Cannot get last operation of %s runtime: %s", cluster.ID, err.Error())
+		return
+	}
+
+	r.enqueueInProgressOperation(logger, operation)
+}
+
+func (r *Reconciler) enqueueInProgressOperation(logger logrus.FieldLogger, operation model.Operation) {
+	if operation.State != model.InProgress {
+		return
+	}
+
+	operationQueue, found := r.operationQueues[operation.Type]
+	if !found {
+		return
+	}
+
+	logger.Debugf("Enqueuing %s operation %s after Shoot change", operation.Type, operation.ID)
+	operationQueue.Add(operation.ID)
+}
+
 func (r *Reconciler) updateShoot(modifiedShoot *gardener_types.Shoot) error {
 	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		return r.client.Update(context.Background(), modifiedShoot)
@@ -0,0 +1,138 @@
+package gardener
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	operationsMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestReconciler_Reconcile_EnqueueOperation(t *testing.T) {
+	const (
+		shootName   = "shoot"
+		runtimeID   = "runtime-id"
+		operationID = "operation-id"
+		namespace   = "garden-project"
+	)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, gardener_types.AddToScheme(scheme))
+
+	shoot := &gardener_types.Shoot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      shootName,
+			Namespace: namespace,
+			Annotations: map[string]string{
+				runtimeIDAnnotation:   runtimeID,
+				operationIDAnnotation: operationID,
+			},
+		},
+	}
+
+	request := ctrl.Request{NamespacedName: types.NamespacedName{Name: shootName, Namespace: namespace}}
+
+	newReconciler := func(readSession *sessionMocks.ReadSession, queues map[model.OperationType]OperationQueue, objects ...*gardener_types.Shoot) *Reconciler {
+		clientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+		for _, object := range objects {
+			clientBuilder = clientBuilder.WithObjects(object)
+		}
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadSession").Return(readSession)
+
+		return &Reconciler{
+			client:               clientBuilder.Build(),
+			scheme:               scheme,
+			dbsFactory:           factory,
+			log:                  logrus.WithField("Component", "ShootReconciler"),
+			auditLogConfigurator: NewAuditLogConfigurator(""),
+			operationQueues:      queues,
+		}
+	}
+
+	t.Run("should enqueue in progress operation annotated on Shoot", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("GetGardenerClusterByName", shootName).Return(model.Cluster{ID: runtimeID}, nil)
+		readSession.On("GetOperation", operationID).Return(model.Operation{ID: operationID, Type: model.UpgradeShoot, State: model.InProgress}, nil)
+
+		upgradeQueue := &operationsMocks.OperationQueue{}
+		upgradeQueue.On("Add", operationID).Return()
+
+		reconciler := newReconciler(readSession, map[model.OperationType]OperationQueue{model.UpgradeShoot: upgradeQueue}, shoot.DeepCopy())
+
+		// when
+		_, err := reconciler.Reconcile(context.Background(), request)
+
+		// then
+		require.NoError(t, err)
+		upgradeQueue.AssertExpectations(t)
+	})
+
+	t.Run("should not enqueue finished operation", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("GetGardenerClusterByName", shootName).Return(model.Cluster{ID: runtimeID}, nil)
+		readSession.On("GetOperation", operationID).Return(model.Operation{ID: operationID, Type: model.UpgradeShoot, State: model.Succeeded}, nil)
+
+		upgradeQueue := &operationsMocks.OperationQueue{}
+
+		reconciler := newReconciler(readSession, map[model.OperationType]OperationQueue{model.UpgradeShoot: upgradeQueue}, shoot.DeepCopy())
+
+		// when
+		_, err := reconciler.Reconcile(context.Background(), request)
+
+		// then
+		require.NoError(t, err)
+		upgradeQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("should enqueue last operation of cluster when Shoot was removed", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("GetGardenerClusterByName", shootName).Return(model.Cluster{ID: runtimeID}, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: operationID, Type: model.DeprovisionNoInstall, State: model.InProgress}, nil)
+
+		deprovisioningQueue := &operationsMocks.OperationQueue{}
+		deprovisioningQueue.On("Add", operationID).Return()
+
+		reconciler := newReconciler(readSession, map[model.OperationType]OperationQueue{model.DeprovisionNoInstall: deprovisioningQueue})
+
+		// when
+		_, err := reconciler.Reconcile(context.Background(), request)
+
+		// then
+		require.NoError(t, err)
+		deprovisioningQueue.AssertExpectations(t)
+	})
+
+	t.Run("should ignore Shoot not managed by provisioner", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("GetGardenerClusterByName", shootName).Return(model.Cluster{}, dberrors.NotFound("not found"))
+
+		upgradeQueue := &operationsMocks.OperationQueue{}
+
+		reconciler := newReconciler(readSession, map[model.OperationType]OperationQueue{model.UpgradeShoot: upgradeQueue}, shoot.DeepCopy())
+
+		// when
+		_, err := reconciler.Reconcile(context.Background(), request)
+
+		// then
+		require.NoError(t, err)
+		readSession.AssertNotCalled(t, "GetOperation", operationID)
+		upgradeQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
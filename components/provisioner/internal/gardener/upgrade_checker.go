@@ -0,0 +1,405 @@
+package gardener
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/hashicorp/go-version"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	deprecatedAPIsMetric = "apiserver_requested_deprecated_apis"
+
+	// minZonesForZoneFailureTolerance is the number of zones a Seed needs to host control planes with zone failure tolerance
+	minZonesForZoneFailureTolerance = 3
+)
+
+var metricLabelRegex = regexp.MustCompile(`(\w+)="([^"]*)"`)
+
+//go:generate mockery --name=CloudProfileClient
+type CloudProfileClient interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*gardener_types.CloudProfile, error)
+}
+
+//go:generate mockery --name=SeedClient
+type SeedClient interface {
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*gardener_types.Seed, error)
+}
+
+//go:generate mockery --name=APIServerMetricsFetcher
+type APIServerMetricsFetcher interface {
+	Fetch(kubeconfig string) ([]byte, error)
+}
+
+// UpgradeChecker runs pre-flight checks of Shoot upgrades against the CloudProfile of the Shoot and the API usage in the Runtime.
+type UpgradeChecker struct {
+	cloudProfileClient CloudProfileClient
+	seedClient         SeedClient
+	metricsFetcher     APIServerMetricsFetcher
+	now                func() time.Time
+}
+
+func NewUpgradeChecker(cloudProfileClient CloudProfileClient, seedClient SeedClient, metricsFetcher APIServerMetricsFetcher) UpgradeChecker {
+	return UpgradeChecker{
+		cloudProfileClient: cloudProfileClient,
+		seedClient:         seedClient,
+		metricsFetcher:     metricsFetcher,
+		now:                time.Now,
+	}
+}
+
+// CheckUpgrade returns an error if the upgraded config uses versions which are not offered by the CloudProfile, are expired,
+// or skip a Kubernetes minor version, or if it changes the control plane failure tolerance in a way Gardener does not allow.
+// Deprecated and expiring versions, and removed APIs still used in the Runtime are returned as warnings.
+func (c UpgradeChecker) CheckUpgrade(shoot gardener_types.Shoot, config model.GardenerConfig, kubeconfig *string) ([]model.UpgradeWarning, apperrors.AppError) {
+	if err := c.checkControlPlaneFailureTolerance(shoot, config); err != nil {
+		return nil, err
+	}
+
+	cloudProfile, err := c.cloudProfileClient.Get(context.Background(), shoot.Spec.CloudProfileName, v1.GetOptions{})
+	if err != nil {
+		return nil, apperrors.Internal("failed to get CloudProfile %s: %s", shoot.Spec.CloudProfileName, err.Error())
+	}
+
+	currentVersion := shoot.Spec.Kubernetes.Version
+	targetVersion := config.KubernetesVersion
+
+	warnings, appErr := c.checkKubernetesVersion(cloudProfile, currentVersion, targetVersion)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	imageWarnings, appErr := c.checkMachineImages(cloudProfile, shoot, config)
+	if appErr != nil {
+		return nil, appErr
+	}
+	warnings = append(warnings, imageWarnings...)
+
+	minorUpgrade, appErr := isMinorUpgrade(currentVersion, targetVersion)
+	if appErr != nil {
+		return nil, appErr
+	}
+	if minorUpgrade {
+		warnings = append(warnings, c.checkRemovedAPIs(kubeconfig, targetVersion)...)
+	}
+
+	return warnings, nil
+}
+
+// checkControlPlaneFailureTolerance follows the Gardener rules for control plane high availability: the failure tolerance
+// cannot be changed once set, cannot be set while the Shoot is hibernated, and zone failure tolerance requires a Seed with enough zones.
+func (c UpgradeChecker) checkControlPlaneFailureTolerance(shoot gardener_types.Shoot, config model.GardenerConfig) apperrors.AppError {
+	target := util.UnwrapStrOrDefault(config.ControlPlaneFailureTolerance, "")
+	current := ""
+	if shoot.Spec.ControlPlane != nil && shoot.Spec.ControlPlane.HighAvailability != nil {
+		current = string(shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type)
+	}
+
+	// Empty failure tolerance leaves the control plane of the Shoot unchanged
+	if target == "" || target == current {
+		return nil
+	}
+
+	if current != "" {
+		return apperrors.BadRequest("control plane failure tolerance cannot be changed from %s to %s", current, target)
+	}
+
+	if shoot.Spec.Hibernation != nil && shoot.Spec.Hibernation.Enabled != nil && *shoot.Spec.Hibernation.Enabled {
+		return apperrors.BadRequest("control plane failure tolerance cannot be set while the Runtime is hibernated")
+	}
+
+	if target != string(gardener_types.FailureToleranceTypeZone) || shoot.Spec.SeedName == nil {
+		return nil
+	}
+
+	seed, err := c.seedClient.Get(context.Background(), *shoot.Spec.SeedName, v1.GetOptions{})
+	if err != nil {
+		return apperrors.Internal("failed to get Seed %s: %s", *shoot.Spec.SeedName, err.Error())
+	}
+
+	if len(seed.Spec.Provider.Zones) < minZonesForZoneFailureTolerance {
+		return apperrors.BadRequest("zone control plane failure tolerance requires a Seed with at least %d zones, Seed %s has %d",
+			minZonesForZoneFailureTolerance, seed.Name, len(seed.Spec.Provider.Zones))
+	}
+
+	return nil
+}
+
+func (c UpgradeChecker) checkKubernetesVersion(cloudProfile *gardener_types.CloudProfile, currentVersion, targetVersion string) ([]model.UpgradeWarning, apperrors.AppError) {
+	if targetVersion != currentVersion {
+		current, err := parseMajorMinor(currentVersion)
+		if err != nil {
+			return nil, err
+		}
+		target, err := parseMajorMinor(targetVersion)
+		if err != nil {
+			return nil, err
+		}
+		if target[0] != current[0] || target[1] > current[1]+1 {
+			return nil, apperrors.BadRequest("upgrade of Kubernetes from %s to %s skips a minor version, upgrade to %d.%d first", currentVersion, targetVersion, current[0], current[1]+1)
+		}
+	}
+
+	expirableVersion, found := findExpirableVersion(cloudProfile.Spec.Kubernetes.Versions, targetVersion)
+	if !found {
+		if targetVersion == currentVersion {
+			return nil, nil
+		}
+		return nil, apperrors.BadRequest("Kubernetes version %s is not offered by CloudProfile %s", targetVersion, cloudProfile.Name)
+	}
+
+	subject := fmt.Sprintf("Kubernetes version %s", targetVersion)
+	return c.checkExpirableVersion(expirableVersion, subject, targetVersion != currentVersion, model.KubernetesVersionDeprecated, model.KubernetesVersionExpiring)
+}
+
+func (c UpgradeChecker) checkMachineImages(cloudProfile *gardener_types.CloudProfile, shoot gardener_types.Shoot, config model.GardenerConfig) ([]model.UpgradeWarning, apperrors.AppError) {
+	currentImages := make(map[string]*gardener_types.ShootMachineImage, len(shoot.Spec.Provider.Workers))
+	for _, worker := range shoot.Spec.Provider.Workers {
+		currentImages[worker.Name] = worker.Machine.Image
+	}
+
+	type workerImage struct {
+		workerName string
+		name       *string
+		version    *string
+	}
+	images := []workerImage{{name: config.MachineImage, version: config.MachineImageVersion}}
+	if len(shoot.Spec.Provider.Workers) > 0 {
+		images[0].workerName = shoot.Spec.Provider.Workers[0].Name
+	}
+	for _, pool := range config.AdditionalWorkerPools {
+		images = append(images, workerImage{workerName: pool.Name, name: pool.MachineImage, version: pool.MachineImageVersion})
+	}
+
+	var warnings []model.UpgradeWarning
+	checked := map[string]bool{}
+	for _, image := range images {
+		if image.name == nil || image.version == nil {
+			continue
+		}
+		key := *image.name + ":" + *image.version
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		current := currentImages[image.workerName]
+		changed := current == nil || current.Name != *image.name || util.UnwrapStrOrDefault(current.Version, "") != *image.version
+
+		expirableVersion, found := findMachineImageVersion(cloudProfile.Spec.MachineImages, *image.name, *image.version)
+		if !found {
+			if !changed {
+				continue
+			}
+			return nil, apperrors.BadRequest("machine image %s version %s is not offered by CloudProfile %s", *image.name, *image.version, cloudProfile.Name)
+		}
+
+		subject := fmt.Sprintf("machine image %s version %s", *image.name, *image.version)
+		imageWarnings, err := c.checkExpirableVersion(expirableVersion, subject, changed, model.MachineImageVersionDeprecated, model.MachineImageVersionExpiring)
+		if err != nil {
+			return nil, err
+		}
+		warnings = append(warnings, imageWarnings...)
+	}
+
+	return warnings, nil
+}
+
+// checkExpirableVersion refuses upgrades to expired versions and warns about versions which are deprecated or have an expiration date.
+func (c UpgradeChecker) checkExpirableVersion(expirableVersion gardener_types.ExpirableVersion, subject string, changed bool, deprecatedCode, expiringCode model.UpgradeWarningCode) ([]model.UpgradeWarning, apperrors.AppError) {
+	expirationDate := expirableVersion.ExpirationDate
+
+	if changed && expirationDate != nil && !expirationDate.Time.After(c.now()) {
+		return nil, apperrors.BadRequest("%s expired on %s", subject, expirationDate.Format(time.RFC3339))
+	}
+
+	if expirableVersion.Classification != nil && *expirableVersion.Classification == gardener_types.ClassificationDeprecated {
+		message := fmt.Sprintf("%s is deprecated", subject)
+		if expirationDate != nil {
+			message = fmt.Sprintf("%s and expires on %s", message, expirationDate.Format(time.RFC3339))
+		}
+		return []model.UpgradeWarning{{Code: deprecatedCode, Message: message}}, nil
+	}
+
+	if expirationDate != nil {
+		return []model.UpgradeWarning{{
+			Code:    expiringCode,
+			Message: fmt.Sprintf("%s expires on %s", subject, expirationDate.Format(time.RFC3339)),
+		}}, nil
+	}
+
+	return nil, nil
+}
+
+// checkRemovedAPIs warns about deprecated APIs requested in the Runtime which are removed in the target Kubernetes version.
+// The check relies on the apiserver_requested_deprecated_apis metric, which covers requests made since the API server started.
+func (c UpgradeChecker) checkRemovedAPIs(kubeconfig *string, targetVersion string) []model.UpgradeWarning {
+	if kubeconfig == nil {
+		return []model.UpgradeWarning{{Code: model.RemovedAPICheckSkipped, Message: "check of removed APIs skipped: kubeconfig of the Runtime not available"}}
+	}
+
+	metrics, err := c.metricsFetcher.Fetch(*kubeconfig)
+	if err != nil {
+		return []model.UpgradeWarning{{Code: model.RemovedAPICheckSkipped, Message: fmt.Sprintf("check of removed APIs skipped: failed to fetch API server metrics: %s", err.Error())}}
+	}
+
+	target, appErr := parseMajorMinor(targetVersion)
+	if appErr != nil {
+		return []model.UpgradeWarning{{Code: model.RemovedAPICheckSkipped, Message: fmt.Sprintf("check of removed APIs skipped: %s", appErr.Error())}}
+	}
+
+	var warnings []model.UpgradeWarning
+	for _, api := range parseRequestedDeprecatedAPIs(metrics) {
+		removedIn, err := parseMajorMinor(api.removedRelease)
+		if err != nil || removedIn[0] != target[0] || removedIn[1] > target[1] {
+			continue
+		}
+
+		warnings = append(warnings, model.UpgradeWarning{
+			Code:    model.RemovedAPIInUse,
+			Message: fmt.Sprintf("%s in API version %s is still used in the Runtime and is removed in Kubernetes %s", api.resource, api.groupVersion(), api.removedRelease),
+		})
+	}
+
+	return warnings
+}
+
+type requestedDeprecatedAPI struct {
+	group          string
+	version        string
+	resource       string
+	removedRelease string
+}
+
+func (a requestedDeprecatedAPI) groupVersion() string {
+	if a.group == "" {
+		return a.version
+	}
+	return a.group + "/" + a.version
+}
+
+// parseRequestedDeprecatedAPIs extracts the deprecated APIs with a known removal release from API server metrics in the Prometheus text format.
+func parseRequestedDeprecatedAPIs(metrics []byte) []requestedDeprecatedAPI {
+	apis := map[requestedDeprecatedAPI]bool{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(metrics))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, deprecatedAPIsMetric+"{") {
+			continue
+		}
+
+		end := strings.LastIndex(line, "}")
+		if end == -1 || strings.TrimSpace(line[end+1:]) == "0" {
+			continue
+		}
+
+		labels := map[string]string{}
+		for _, match := range metricLabelRegex.FindAllStringSubmatch(line[:end], -1) {
+			labels[match[1]] = match[2]
+		}
+		if labels["removed_release"] == "" {
+			continue
+		}
+
+		apis[requestedDeprecatedAPI{
+			group:          labels["group"],
+			version:        labels["version"],
+			resource:       labels["resource"],
+			removedRelease: labels["removed_release"],
+		}] = true
+	}
+
+	result := make([]requestedDeprecatedAPI, 0, len(apis))
+	for api := range apis {
+		result = append(result, api)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return fmt.Sprint(result[i]) < fmt.Sprint(result[j])
+	})
+
+	return result
+}
+
+func findExpirableVersion(versions []gardener_types.ExpirableVersion, version string) (gardener_types.ExpirableVersion, bool) {
+	for _, v := range versions {
+		if v.Version == version {
+			return v, true
+		}
+	}
+	return gardener_types.ExpirableVersion{}, false
+}
+
+func findMachineImageVersion(images []gardener_types.MachineImage, name, version string) (gardener_types.ExpirableVersion, bool) {
+	for _, image := range images {
+		if image.Name != name {
+			continue
+		}
+		for _, v := range image.Versions {
+			if v.Version == version {
+				return v.ExpirableVersion, true
+			}
+		}
+	}
+	return gardener_types.ExpirableVersion{}, false
+}
+
+func isMinorUpgrade(currentVersion, targetVersion string) (bool, apperrors.AppError) {
+	if currentVersion == targetVersion {
+		return false, nil
+	}
+	current, err := parseMajorMinor(currentVersion)
+	if err != nil {
+		return false, err
+	}
+	target, err := parseMajorMinor(targetVersion)
+	if err != nil {
+		return false, err
+	}
+	return target[0] > current[0] || (target[0] == current[0] && target[1] > current[1]), nil
+}
+
+func parseMajorMinor(v string) ([2]int, apperrors.AppError) {
+	parsed, err := version.NewVersion(v)
+	if err != nil {
+		return [2]int{}, apperrors.BadRequest("failed to parse \"%s\" as a version", v)
+	}
+	segments := parsed.Segments()
+	return [2]int{segments[0], segments[1]}, nil
+}
+
+// apiServerMetricsTimeout limits fetching the metrics of the Runtime API server, so an unreachable Runtime does not block the upgrade request.
+// A failed fetch only results in a warning.
+const apiServerMetricsTimeout = 5 * time.Second
+
+type apiServerMetricsFetcher struct {
+	clientProvider k8s.K8sClientProvider
+}
+
+func NewAPIServerMetricsFetcher(clientProvider k8s.K8sClientProvider) APIServerMetricsFetcher {
+	return apiServerMetricsFetcher{clientProvider: clientProvider}
+}
+
+func (f apiServerMetricsFetcher) Fetch(kubeconfig string) ([]byte, error) {
+	client, err := f.clientProvider.CreateK8SClient(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), apiServerMetricsTimeout)
+	defer cancel()
+
+	return client.Discovery().RESTClient().Get().AbsPath("/metrics").DoRaw(ctx)
+}
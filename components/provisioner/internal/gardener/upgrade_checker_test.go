@@ -0,0 +1,360 @@
+package gardener
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const apiServerMetrics = `# HELP apiserver_requested_deprecated_apis [STABLE] Gauge of deprecated APIs that have been requested, broken out by API group, version, resource, subresource, and removed_release.
+# TYPE apiserver_requested_deprecated_apis gauge
+apiserver_requested_deprecated_apis{group="policy",removed_release="1.25",resource="podsecuritypolicies",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="batch",removed_release="1.25",resource="cronjobs",subresource="",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="batch",removed_release="1.25",resource="cronjobs",subresource="status",version="v1beta1"} 1
+apiserver_requested_deprecated_apis{group="autoscaling",removed_release="1.26",resource="horizontalpodautoscalers",subresource="",version="v2beta2"} 1
+apiserver_requested_deprecated_apis{group="discovery.k8s.io",removed_release="1.25",resource="endpointslices",subresource="",version="v1beta1"} 0
+apiserver_requested_deprecated_apis{group="",removed_release="",resource="componentstatuses",subresource="",version="v1"} 1
+apiserver_request_total{code="200",resource="pods",verb="LIST",version="v1"} 42
+`
+
+func TestUpgradeChecker_CheckUpgrade(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	expired := metav1.NewTime(now.Add(-24 * time.Hour))
+	expiring := metav1.NewTime(now.Add(30 * 24 * time.Hour))
+	deprecated := gardener_Types.ClassificationDeprecated
+	kubeconfig := util.StringPtr("kubeconfig")
+
+	cloudProfile := &gardener_Types.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws"},
+		Spec: gardener_Types.CloudProfileSpec{
+			Kubernetes: gardener_Types.KubernetesSettings{
+				Versions: []gardener_Types.ExpirableVersion{
+					{Version: "1.26.5"},
+					{Version: "1.25.10", Classification: &deprecated, ExpirationDate: &expiring},
+					{Version: "1.25.9", ExpirationDate: &expired},
+					{Version: "1.24.12", ExpirationDate: &expiring},
+				},
+			},
+			MachineImages: []gardener_Types.MachineImage{
+				{
+					Name: "gardenlinux",
+					Versions: []gardener_Types.MachineImageVersion{
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.8.0"}},
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.7.0", Classification: &deprecated}},
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.6.0", ExpirationDate: &expired}},
+					},
+				},
+			},
+		},
+	}
+
+	fixShoot := func(kubernetesVersion, imageVersion string) gardener_Types.Shoot {
+		return gardener_Types.Shoot{
+			Spec: gardener_Types.ShootSpec{
+				CloudProfileName: "aws",
+				Kubernetes:       gardener_Types.Kubernetes{Version: kubernetesVersion},
+				Provider: gardener_Types.Provider{
+					Workers: []gardener_Types.Worker{{
+						Name: "cpu-worker-0",
+						Machine: gardener_Types.Machine{
+							Image: &gardener_Types.ShootMachineImage{Name: "gardenlinux", Version: util.StringPtr(imageVersion)},
+						},
+					}},
+				},
+			},
+		}
+	}
+
+	fixConfig := func(kubernetesVersion, imageVersion string) model.GardenerConfig {
+		return model.GardenerConfig{
+			KubernetesVersion:   kubernetesVersion,
+			MachineImage:        util.StringPtr("gardenlinux"),
+			MachineImageVersion: util.StringPtr(imageVersion),
+		}
+	}
+
+	for _, testCase := range []struct {
+		description      string
+		shoot            gardener_Types.Shoot
+		config           model.GardenerConfig
+		kubeconfig       *string
+		metrics          string
+		metricsErr       error
+		expectedWarnings []model.UpgradeWarning
+	}{
+		{
+			description: "should pass patch upgrade without checking removed APIs",
+			shoot:       fixShoot("1.26.4", "934.8.0"),
+			config:      fixConfig("1.26.5", "934.8.0"),
+			kubeconfig:  kubeconfig,
+		},
+		{
+			description: "should pass when versions do not change even if they are not offered anymore",
+			shoot:       fixShoot("1.23.17", "318.9.0"),
+			config:      fixConfig("1.23.17", "318.9.0"),
+			kubeconfig:  kubeconfig,
+		},
+		{
+			description: "should warn about deprecated and expiring versions",
+			shoot:       fixShoot("1.24.12", "934.8.0"),
+			config:      fixConfig("1.24.12", "934.7.0"),
+			kubeconfig:  kubeconfig,
+			expectedWarnings: []model.UpgradeWarning{
+				{Code: model.KubernetesVersionExpiring, Message: "Kubernetes version 1.24.12 expires on 2026-11-17T00:00:00Z"},
+				{Code: model.MachineImageVersionDeprecated, Message: "machine image gardenlinux version 934.7.0 is deprecated"},
+			},
+		},
+		{
+			description: "should warn about removed APIs still used in the Runtime",
+			shoot:       fixShoot("1.24.12", "934.8.0"),
+			config:      fixConfig("1.25.10", "934.8.0"),
+			kubeconfig:  kubeconfig,
+			metrics:     apiServerMetrics,
+			expectedWarnings: []model.UpgradeWarning{
+				{Code: model.KubernetesVersionDeprecated, Message: "Kubernetes version 1.25.10 is deprecated and expires on 2026-11-17T00:00:00Z"},
+				{Code: model.RemovedAPIInUse, Message: "cronjobs in API version batch/v1beta1 is still used in the Runtime and is removed in Kubernetes 1.25"},
+				{Code: model.RemovedAPIInUse, Message: "podsecuritypolicies in API version policy/v1beta1 is still used in the Runtime and is removed in Kubernetes 1.25"},
+			},
+		},
+		{
+			description: "should warn when kubeconfig of the Runtime is not available",
+			shoot:       fixShoot("1.25.10", "934.8.0"),
+			config:      fixConfig("1.26.5", "934.8.0"),
+			expectedWarnings: []model.UpgradeWarning{
+				{Code: model.RemovedAPICheckSkipped, Message: "check of removed APIs skipped: kubeconfig of the Runtime not available"},
+			},
+		},
+		{
+			description: "should warn when failed to fetch API server metrics",
+			shoot:       fixShoot("1.25.10", "934.8.0"),
+			config:      fixConfig("1.26.5", "934.8.0"),
+			kubeconfig:  kubeconfig,
+			metricsErr:  errors.New("connection refused"),
+			expectedWarnings: []model.UpgradeWarning{
+				{Code: model.RemovedAPICheckSkipped, Message: "check of removed APIs skipped: failed to fetch API server metrics: connection refused"},
+			},
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			cloudProfileClient := &mocks.CloudProfileClient{}
+			cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(cloudProfile, nil)
+			metricsFetcher := &mocks.APIServerMetricsFetcher{}
+			metricsFetcher.On("Fetch", "kubeconfig").Return([]byte(testCase.metrics), testCase.metricsErr)
+
+			checker := NewUpgradeChecker(cloudProfileClient, &mocks.SeedClient{}, metricsFetcher)
+			checker.now = func() time.Time { return now }
+
+			// when
+			warnings, err := checker.CheckUpgrade(testCase.shoot, testCase.config, testCase.kubeconfig)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedWarnings, warnings)
+		})
+	}
+
+	for _, testCase := range []struct {
+		description   string
+		shoot         gardener_Types.Shoot
+		config        model.GardenerConfig
+		expectedError string
+	}{
+		{
+			description:   "should refuse skipping a minor version",
+			shoot:         fixShoot("1.24.12", "934.8.0"),
+			config:        fixConfig("1.26.5", "934.8.0"),
+			expectedError: "upgrade of Kubernetes from 1.24.12 to 1.26.5 skips a minor version, upgrade to 1.25 first",
+		},
+		{
+			description:   "should refuse Kubernetes version not offered by CloudProfile",
+			shoot:         fixShoot("1.26.5", "934.8.0"),
+			config:        fixConfig("1.26.6", "934.8.0"),
+			expectedError: "Kubernetes version 1.26.6 is not offered by CloudProfile aws",
+		},
+		{
+			description:   "should refuse expired Kubernetes version",
+			shoot:         fixShoot("1.24.12", "934.8.0"),
+			config:        fixConfig("1.25.9", "934.8.0"),
+			expectedError: "Kubernetes version 1.25.9 expired on 2026-10-17T00:00:00Z",
+		},
+		{
+			description:   "should refuse machine image version not offered by CloudProfile",
+			shoot:         fixShoot("1.26.5", "934.8.0"),
+			config:        fixConfig("1.26.5", "934.9.0"),
+			expectedError: "machine image gardenlinux version 934.9.0 is not offered by CloudProfile aws",
+		},
+		{
+			description:   "should refuse expired machine image version",
+			shoot:         fixShoot("1.26.5", "934.5.0"),
+			config:        fixConfig("1.26.5", "934.6.0"),
+			expectedError: "machine image gardenlinux version 934.6.0 expired on 2026-10-17T00:00:00Z",
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			cloudProfileClient := &mocks.CloudProfileClient{}
+			cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(cloudProfile, nil)
+			metricsFetcher := &mocks.APIServerMetricsFetcher{}
+
+			checker := NewUpgradeChecker(cloudProfileClient, &mocks.SeedClient{}, metricsFetcher)
+			checker.now = func() time.Time { return now }
+
+			// when
+			_, err := checker.CheckUpgrade(testCase.shoot, testCase.config, nil)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			assert.Equal(t, testCase.expectedError, err.Error())
+			metricsFetcher.AssertNotCalled(t, "Fetch", mock.Anything)
+		})
+	}
+
+	t.Run("control plane failure tolerance", func(t *testing.T) {
+		fixHAShoot := func(failureTolerance string, hibernated bool) gardener_Types.Shoot {
+			shoot := fixShoot("1.26.5", "934.8.0")
+			shoot.Spec.SeedName = util.StringPtr("aws-eu1")
+			shoot.Spec.Hibernation = &gardener_Types.Hibernation{Enabled: &hibernated}
+			if failureTolerance != "" {
+				shoot.Spec.ControlPlane = &gardener_Types.ControlPlane{
+					HighAvailability: &gardener_Types.HighAvailability{
+						FailureTolerance: gardener_Types.FailureTolerance{Type: gardener_Types.FailureToleranceType(failureTolerance)},
+					},
+				}
+			}
+			return shoot
+		}
+
+		fixHAConfig := func(failureTolerance string) model.GardenerConfig {
+			config := fixConfig("1.26.5", "934.8.0")
+			config.ControlPlaneFailureTolerance = util.StringPtr(failureTolerance)
+			return config
+		}
+
+		fixSeed := func(zones ...string) *gardener_Types.Seed {
+			return &gardener_Types.Seed{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-eu1"},
+				Spec:       gardener_Types.SeedSpec{Provider: gardener_Types.SeedProvider{Zones: zones}},
+			}
+		}
+
+		for _, testCase := range []struct {
+			description   string
+			shoot         gardener_Types.Shoot
+			config        model.GardenerConfig
+			seed          *gardener_Types.Seed
+			expectedError string
+		}{
+			{
+				description: "should allow setting node failure tolerance",
+				shoot:       fixHAShoot("", false),
+				config:      fixHAConfig("node"),
+			},
+			{
+				description: "should allow setting zone failure tolerance on multi-zone Seed",
+				shoot:       fixHAShoot("", false),
+				config:      fixHAConfig("zone"),
+				seed:        fixSeed("eu-central-1a", "eu-central-1b", "eu-central-1c"),
+			},
+			{
+				description: "should allow keeping zone failure tolerance",
+				shoot:       fixHAShoot("zone", false),
+				config:      fixHAConfig("zone"),
+			},
+			{
+				description: "should allow empty failure tolerance when already set",
+				shoot:       fixHAShoot("zone", false),
+				config:      fixHAConfig(""),
+			},
+			{
+				description:   "should refuse downgrading zone failure tolerance",
+				shoot:         fixHAShoot("zone", false),
+				config:        fixHAConfig("node"),
+				expectedError: "control plane failure tolerance cannot be changed from zone to node",
+			},
+			{
+				description:   "should refuse upgrading node failure tolerance",
+				shoot:         fixHAShoot("node", false),
+				config:        fixHAConfig("zone"),
+				expectedError: "control plane failure tolerance cannot be changed from node to zone",
+			},
+			{
+				description:   "should refuse setting failure tolerance on hibernated Runtime",
+				shoot:         fixHAShoot("", true),
+				config:        fixHAConfig("node"),
+				expectedError: "control plane failure tolerance cannot be set while the Runtime is hibernated",
+			},
+			{
+				description:   "should refuse zone failure tolerance on single-zone Seed",
+				shoot:         fixHAShoot("", false),
+				config:        fixHAConfig("zone"),
+				seed:          fixSeed("eu-central-1a"),
+				expectedError: "zone control plane failure tolerance requires a Seed with at least 3 zones, Seed aws-eu1 has 1",
+			},
+		} {
+			t.Run(testCase.description, func(t *testing.T) {
+				// given
+				cloudProfileClient := &mocks.CloudProfileClient{}
+				cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(cloudProfile, nil)
+				seedClient := &mocks.SeedClient{}
+				seedClient.On("Get", mock.Anything, "aws-eu1", metav1.GetOptions{}).Return(testCase.seed, nil)
+
+				checker := NewUpgradeChecker(cloudProfileClient, seedClient, &mocks.APIServerMetricsFetcher{})
+				checker.now = func() time.Time { return now }
+
+				// when
+				_, err := checker.CheckUpgrade(testCase.shoot, testCase.config, nil)
+
+				// then
+				if testCase.expectedError == "" {
+					require.NoError(t, err)
+					return
+				}
+				require.Error(t, err)
+				assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+				assert.Equal(t, testCase.expectedError, err.Error())
+			})
+		}
+
+		t.Run("should return error when failed to get Seed", func(t *testing.T) {
+			// given
+			seedClient := &mocks.SeedClient{}
+			seedClient.On("Get", mock.Anything, "aws-eu1", metav1.GetOptions{}).Return(nil, errors.New("error"))
+
+			checker := NewUpgradeChecker(&mocks.CloudProfileClient{}, seedClient, &mocks.APIServerMetricsFetcher{})
+
+			// when
+			_, err := checker.CheckUpgrade(fixHAShoot("", false), fixHAConfig("zone"), nil)
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeInternal, err.Code())
+		})
+	})
+
+	t.Run("should return error when failed to get CloudProfile", func(t *testing.T) {
+		// given
+		cloudProfileClient := &mocks.CloudProfileClient{}
+		cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(nil, errors.New("error"))
+
+		checker := NewUpgradeChecker(cloudProfileClient, &mocks.SeedClient{}, &mocks.APIServerMetricsFetcher{})
+
+		// when
+		_, err := checker.CheckUpgrade(fixShoot("1.26.5", "934.8.0"), fixConfig("1.26.5", "934.8.0"), kubeconfig)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+}
@@ -0,0 +1,138 @@
+package gardener
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/errors"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VersionDriftReporter lists Runtimes whose Kubernetes or machine image versions are expired or expire soon
+// according to the CloudProfiles of their Shoots.
+type VersionDriftReporter struct {
+	readSession        dbsession.ReadSession
+	cloudProfileClient CloudProfileClient
+	log                logrus.FieldLogger
+	now                func() time.Time
+}
+
+func NewVersionDriftReporter(readSession dbsession.ReadSession, cloudProfileClient CloudProfileClient) VersionDriftReporter {
+	return VersionDriftReporter{
+		readSession:        readSession,
+		cloudProfileClient: cloudProfileClient,
+		log:                logrus.WithField("Component", "VersionDriftReporter"),
+		now:                time.Now,
+	}
+}
+
+// Report returns Runtimes with versions which are expired or expire within the given period, grouped by provider and region.
+// Empty provider or region matches all Runtimes.
+func (r VersionDriftReporter) Report(provider, region string, expiringWithin time.Duration) ([]model.VersionDriftGroup, apperrors.AppError) {
+	configs, dberr := r.readSession.ListGardenerConfigs(provider, region)
+	if dberr != nil {
+		return nil, apperrors.Internal("failed to list Gardener configs: %s", dberr.Error())
+	}
+
+	now := r.now()
+	deadline := now.Add(expiringWithin)
+	cloudProfiles := map[string]*gardener_types.CloudProfile{}
+
+	type groupKey struct{ provider, region string }
+	groups := map[groupKey][]model.RuntimeVersionDrift{}
+
+	for _, config := range configs {
+		cloudProfileName := config.GardenerProviderConfig.CloudProfileName()
+
+		cloudProfile, found := cloudProfiles[cloudProfileName]
+		if !found {
+			var err error
+			cloudProfile, err = r.cloudProfileClient.Get(context.Background(), cloudProfileName, v1.GetOptions{})
+			if err != nil {
+				if !errors.IsNotFound(err) {
+					return nil, apperrors.Internal("failed to get CloudProfile %s: %s", cloudProfileName, err.Error())
+				}
+				r.log.Warnf("CloudProfile %s of Runtime %s not found", cloudProfileName, config.ClusterID)
+				cloudProfile = nil
+			}
+			cloudProfiles[cloudProfileName] = cloudProfile
+		}
+		if cloudProfile == nil {
+			continue
+		}
+
+		drift := runtimeVersionDrift(cloudProfile, config, now, deadline)
+		if drift.KubernetesVersion == nil && len(drift.MachineImages) == 0 {
+			continue
+		}
+
+		key := groupKey{provider: config.Provider, region: config.Region}
+		groups[key] = append(groups[key], drift)
+	}
+
+	result := make([]model.VersionDriftGroup, 0, len(groups))
+	for key, runtimes := range groups {
+		result = append(result, model.VersionDriftGroup{Provider: key.provider, Region: key.region, Runtimes: runtimes})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Provider != result[j].Provider {
+			return result[i].Provider < result[j].Provider
+		}
+		return result[i].Region < result[j].Region
+	})
+
+	return result, nil
+}
+
+func runtimeVersionDrift(cloudProfile *gardener_types.CloudProfile, config model.GardenerConfig, now, deadline time.Time) model.RuntimeVersionDrift {
+	drift := model.RuntimeVersionDrift{
+		RuntimeID: config.ClusterID,
+		ShootName: config.Name,
+	}
+
+	version, found := findExpirableVersion(cloudProfile.Spec.Kubernetes.Versions, config.KubernetesVersion)
+	drift.KubernetesVersion = expiringVersion("", config.KubernetesVersion, version, found, now, deadline)
+
+	images := []struct{ name, version *string }{{config.MachineImage, config.MachineImageVersion}}
+	for _, pool := range config.AdditionalWorkerPools {
+		images = append(images, struct{ name, version *string }{pool.MachineImage, pool.MachineImageVersion})
+	}
+
+	checked := map[string]bool{}
+	for _, image := range images {
+		if image.name == nil || image.version == nil || checked[*image.name+":"+*image.version] {
+			continue
+		}
+		checked[*image.name+":"+*image.version] = true
+
+		version, found := findMachineImageVersion(cloudProfile.Spec.MachineImages, *image.name, *image.version)
+		if expiring := expiringVersion(*image.name, *image.version, version, found, now, deadline); expiring != nil {
+			drift.MachineImages = append(drift.MachineImages, *expiring)
+		}
+	}
+
+	return drift
+}
+
+// expiringVersion returns nil if the version does not expire within the period. Versions no longer offered by the CloudProfile are reported as expired.
+func expiringVersion(name, versionName string, version gardener_types.ExpirableVersion, offered bool, now, deadline time.Time) *model.ExpiringVersion {
+	if !offered {
+		return &model.ExpiringVersion{Name: name, Version: versionName, Expired: true}
+	}
+	if version.ExpirationDate == nil || version.ExpirationDate.Time.After(deadline) {
+		return nil
+	}
+
+	return &model.ExpiringVersion{
+		Name:           name,
+		Version:        versionName,
+		ExpirationDate: &version.ExpirationDate.Time,
+		Expired:        !version.ExpirationDate.Time.After(now),
+	}
+}
@@ -0,0 +1,178 @@
+package gardener
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestVersionDriftReporter_Report(t *testing.T) {
+	now := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	expired := metav1.NewTime(now.Add(-24 * time.Hour))
+	expiringSoon := metav1.NewTime(now.Add(10 * 24 * time.Hour))
+	expiringLater := metav1.NewTime(now.Add(90 * 24 * time.Hour))
+
+	cloudProfile := &gardener_Types.CloudProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "aws"},
+		Spec: gardener_Types.CloudProfileSpec{
+			Kubernetes: gardener_Types.KubernetesSettings{
+				Versions: []gardener_Types.ExpirableVersion{
+					{Version: "1.27.4"},
+					{Version: "1.26.7", ExpirationDate: &expiringLater},
+					{Version: "1.25.10", ExpirationDate: &expiringSoon},
+					{Version: "1.24.12", ExpirationDate: &expired},
+				},
+			},
+			MachineImages: []gardener_Types.MachineImage{
+				{
+					Name: "gardenlinux",
+					Versions: []gardener_Types.MachineImageVersion{
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.8.0"}},
+						{ExpirableVersion: gardener_Types.ExpirableVersion{Version: "934.7.0", ExpirationDate: &expiringSoon}},
+					},
+				},
+			},
+		},
+	}
+
+	awsProviderConfig, err := model.NewAWSGardenerConfig(&gqlschema.AWSProviderConfigInput{VpcCidr: "10.250.0.0/16"})
+	require.NoError(t, err)
+
+	fixConfig := func(runtimeID, region, kubernetesVersion, imageVersion string, pools ...model.WorkerPool) model.GardenerConfig {
+		return model.GardenerConfig{
+			ClusterID:              runtimeID,
+			Name:                   "shoot-" + runtimeID,
+			Provider:               "aws",
+			Region:                 region,
+			KubernetesVersion:      kubernetesVersion,
+			MachineImage:           util.StringPtr("gardenlinux"),
+			MachineImageVersion:    util.StringPtr(imageVersion),
+			GardenerProviderConfig: awsProviderConfig,
+			AdditionalWorkerPools:  pools,
+		}
+	}
+
+	t.Run("should report expired and expiring versions grouped by provider and region", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		cloudProfileClient := &mocks.CloudProfileClient{}
+
+		readSession.On("ListGardenerConfigs", "aws", "").Return([]model.GardenerConfig{
+			fixConfig("runtime-1", "eu-west-1", "1.27.4", "934.8.0"),
+			fixConfig("runtime-2", "eu-west-1", "1.24.12", "934.8.0"),
+			fixConfig("runtime-3", "eu-central-1", "1.26.7", "934.8.0",
+				model.WorkerPool{Name: "pool-1", MachineImage: util.StringPtr("gardenlinux"), MachineImageVersion: util.StringPtr("934.7.0")}),
+			fixConfig("runtime-4", "eu-central-1", "1.25.10", "318.9.0"),
+		}, nil)
+		cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(cloudProfile, nil).Once()
+
+		reporter := NewVersionDriftReporter(readSession, cloudProfileClient)
+		reporter.now = func() time.Time { return now }
+
+		// when
+		groups, err := reporter.Report("aws", "", 30*24*time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.VersionDriftGroup{
+			{
+				Provider: "aws",
+				Region:   "eu-central-1",
+				Runtimes: []model.RuntimeVersionDrift{
+					{
+						RuntimeID:     "runtime-3",
+						ShootName:     "shoot-runtime-3",
+						MachineImages: []model.ExpiringVersion{{Name: "gardenlinux", Version: "934.7.0", ExpirationDate: &expiringSoon.Time}},
+					},
+					{
+						RuntimeID:         "runtime-4",
+						ShootName:         "shoot-runtime-4",
+						KubernetesVersion: &model.ExpiringVersion{Version: "1.25.10", ExpirationDate: &expiringSoon.Time},
+						MachineImages:     []model.ExpiringVersion{{Name: "gardenlinux", Version: "318.9.0", Expired: true}},
+					},
+				},
+			},
+			{
+				Provider: "aws",
+				Region:   "eu-west-1",
+				Runtimes: []model.RuntimeVersionDrift{
+					{
+						RuntimeID:         "runtime-2",
+						ShootName:         "shoot-runtime-2",
+						KubernetesVersion: &model.ExpiringVersion{Version: "1.24.12", ExpirationDate: &expired.Time, Expired: true},
+					},
+				},
+			},
+		}, groups)
+		cloudProfileClient.AssertExpectations(t)
+	})
+
+	t.Run("should skip Runtimes whose CloudProfile does not exist", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		cloudProfileClient := &mocks.CloudProfileClient{}
+
+		readSession.On("ListGardenerConfigs", "", "").Return([]model.GardenerConfig{
+			fixConfig("runtime-1", "eu-west-1", "1.24.12", "934.8.0"),
+		}, nil)
+		cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(nil, k8sErrors.NewNotFound(schema.GroupResource{}, "aws"))
+
+		reporter := NewVersionDriftReporter(readSession, cloudProfileClient)
+
+		// when
+		groups, err := reporter.Report("", "", 0)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, groups)
+	})
+
+	t.Run("should return error when failed to get CloudProfile", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		cloudProfileClient := &mocks.CloudProfileClient{}
+
+		readSession.On("ListGardenerConfigs", "", "").Return([]model.GardenerConfig{
+			fixConfig("runtime-1", "eu-west-1", "1.24.12", "934.8.0"),
+		}, nil)
+		cloudProfileClient.On("Get", mock.Anything, "aws", metav1.GetOptions{}).Return(nil, errors.New("error"))
+
+		reporter := NewVersionDriftReporter(readSession, cloudProfileClient)
+
+		// when
+		_, err := reporter.Report("", "", 0)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeInternal, err.Code())
+	})
+
+	t.Run("should return error when failed to list Gardener configs", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListGardenerConfigs", "", "").Return(nil, dberrors.Internal("error"))
+
+		reporter := NewVersionDriftReporter(readSession, &mocks.CloudProfileClient{})
+
+		// when
+		_, err := reporter.Report("", "", 0)
+
+		// then
+		require.Error(t, err)
+	})
+}
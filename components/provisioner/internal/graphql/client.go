@@ -6,6 +6,8 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/third_party/machinebox/graphql"
 	"github.com/sirupsen/logrus"
 )
@@ -18,7 +20,7 @@ type ClientConstructor func(certificate *tls.Certificate, graphqlEndpoint string
 
 //go:generate mockery --name=Client
 type Client interface {
-	Do(req *graphql.Request, res interface{}) error
+	Do(ctx context.Context, req *graphql.Request, res interface{}) error
 }
 
 type client struct {
@@ -29,9 +31,9 @@ type client struct {
 
 func NewGraphQLClient(graphqlEndpoint string, enableLogging bool, insecureSkipVerify bool) Client {
 	httpClient := &http.Client{
-		Transport: &http.Transport{
+		Transport: metrics.InstrumentRoundTripper(metrics.DirectorClient, tracing.NewTransport(&http.Transport{
 			TLSClientConfig: &tls.Config{InsecureSkipVerify: insecureSkipVerify},
-		},
+		})),
 	}
 
 	gqlClient := graphql.NewClient(graphqlEndpoint, graphql.WithHTTPClient(httpClient))
@@ -47,8 +49,8 @@ func NewGraphQLClient(graphqlEndpoint string, enableLogging bool, insecureSkipVe
 	return client
 }
 
-func (c *client) Do(req *graphql.Request, res interface{}) error {
-	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+func (c *client) Do(ctx context.Context, req *graphql.Request, res interface{}) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	c.clearLogs()
@@ -1,6 +1,7 @@
 package graphql
 
 import (
+	"context"
 	"errors"
 	"testing"
 
@@ -17,7 +18,7 @@ type QueryAssertClient struct {
 
 type ModifyResponseFunc []func(t *testing.T, r interface{})
 
-func (c *QueryAssertClient) Do(req *graphql.Request, res interface{}) error {
+func (c *QueryAssertClient) Do(_ context.Context, req *graphql.Request, res interface{}) error {
 	if len(c.expectedRequests) == 0 {
 		return errors.New("no more requests were expected")
 	}
@@ -0,0 +1,39 @@
+package healthz
+
+import (
+	"context"
+
+	"github.com/gocraft/dbr/v2"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/gardener"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/oauth"
+)
+
+func NewDatabaseCheck(connection *dbr.Connection) Check {
+	return func(ctx context.Context) error {
+		if err := connection.PingContext(ctx); err != nil {
+			return errors.Wrap(err, "failed to ping database")
+		}
+		return nil
+	}
+}
+
+func NewGardenerCheck(shootClient gardener.ShootClient) Check {
+	return func(ctx context.Context) error {
+		if _, err := shootClient.List(ctx, metav1.ListOptions{Limit: 1}); err != nil {
+			return errors.Wrap(err, "failed to list shoots")
+		}
+		return nil
+	}
+}
+
+func NewDirectorCheck(oauthClient oauth.Client) Check {
+	return func(_ context.Context) error {
+		if _, err := oauthClient.GetAuthorizationToken(); err != nil {
+			return errors.Wrap(err, "failed to fetch Director OAuth token")
+		}
+		return nil
+	}
+}
@@ -0,0 +1,150 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	StatusOK     = "ok"
+	StatusFailed = "failed"
+)
+
+// Check verifies that a single dependency of the Provisioner is reachable.
+type Check func(ctx context.Context) error
+
+type ReadinessConfig struct {
+	CheckTimeout time.Duration `envconfig:"default=2s"`
+	CacheTTL     time.Duration `envconfig:"default=15s"`
+}
+
+type CheckResult struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type ReadinessReport struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+// ReadinessHandler runs the registered checks and reports whether the Provisioner is ready to serve requests.
+// Results are cached for the configured time, so frequent probes do not put load on the dependencies.
+type ReadinessHandler struct {
+	log    *logrus.Logger
+	config ReadinessConfig
+	checks map[string]Check
+
+	mutex     sync.Mutex
+	report    ReadinessReport
+	checkedAt time.Time
+	now       func() time.Time
+}
+
+func NewReadinessHandler(log *logrus.Logger, config ReadinessConfig, checks map[string]Check) *ReadinessHandler {
+	return &ReadinessHandler{
+		log:    log,
+		config: config,
+		checks: checks,
+		now:    time.Now,
+	}
+}
+
+func (h *ReadinessHandler) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	report := h.Report(request.Context())
+
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	err := json.NewEncoder(writer).Encode(report)
+	if err != nil {
+		h.log.Errorf(errors.Wrapf(err, "while writing to response body").Error())
+	}
+}
+
+// Report returns the cached readiness report, running the checks again if the cached one has expired.
+// The report is not cached if the context was cancelled while the checks were running, as the failures are then caused by the caller.
+func (h *ReadinessHandler) Report(ctx context.Context) ReadinessReport {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	if !h.checkedAt.IsZero() && h.now().Sub(h.checkedAt) < h.config.CacheTTL {
+		return h.report
+	}
+
+	report := h.runChecks(ctx)
+	if ctx.Err() != nil {
+		return report
+	}
+
+	h.report = report
+	h.checkedAt = h.now()
+
+	return h.report
+}
+
+func (h *ReadinessHandler) runChecks(ctx context.Context) ReadinessReport {
+	report := ReadinessReport{
+		Status: StatusOK,
+		Checks: make(map[string]CheckResult, len(h.checks)),
+	}
+
+	names := make([]string, 0, len(h.checks))
+	for name := range h.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	errs := make([]error, len(names))
+	wg := sync.WaitGroup{}
+	for i, name := range names {
+		wg.Add(1)
+		go func(i int, check Check) {
+			defer wg.Done()
+			errs[i] = runWithTimeout(ctx, h.config.CheckTimeout, check)
+		}(i, h.checks[name])
+	}
+	wg.Wait()
+
+	for i, name := range names {
+		if errs[i] != nil {
+			h.log.Warnf("Readiness check %s failed: %s", name, errs[i].Error())
+			report.Status = StatusFailed
+			report.Checks[name] = CheckResult{Status: StatusFailed, Error: errs[i].Error()}
+			continue
+		}
+		report.Checks[name] = CheckResult{Status: StatusOK}
+	}
+
+	return report
+}
+
+func runWithTimeout(ctx context.Context, timeout time.Duration, check Check) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	// Some clients do not accept context, so the check is abandoned rather than cancelled when the timeout is reached
+	result := make(chan error, 1)
+	go func() {
+		result <- check(ctx)
+	}()
+
+	select {
+	case err := <-result:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("check did not finish within %s", timeout)
+	}
+}
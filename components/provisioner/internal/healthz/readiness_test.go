@@ -0,0 +1,177 @@
+package healthz
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/oauth"
+	oauthmocks "github.com/kyma-project/control-plane/components/provisioner/internal/oauth/mocks"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	config := ReadinessConfig{
+		CheckTimeout: 100 * time.Millisecond,
+		CacheTTL:     time.Minute,
+	}
+
+	passing := func(_ context.Context) error { return nil }
+	failing := func(_ context.Context) error { return errors.New("connection refused") }
+
+	serve := func(t *testing.T, handler *ReadinessHandler) (int, ReadinessReport) {
+		req, err := http.NewRequest("GET", "/readyz", nil)
+		require.NoError(t, err)
+
+		rr := httptest.NewRecorder()
+		handler.ServeHTTP(rr, req)
+
+		var report ReadinessReport
+		require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+
+		return rr.Code, report
+	}
+
+	t.Run("should return 200 when all checks pass", func(t *testing.T) {
+		// given
+		handler := NewReadinessHandler(logrus.StandardLogger(), config, map[string]Check{
+			"database": passing,
+			"gardener": passing,
+		})
+
+		// when
+		code, report := serve(t, handler)
+
+		// then
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, ReadinessReport{
+			Status: StatusOK,
+			Checks: map[string]CheckResult{
+				"database": {Status: StatusOK},
+				"gardener": {Status: StatusOK},
+			},
+		}, report)
+	})
+
+	t.Run("should return 503 with the failing check when one of the checks fails", func(t *testing.T) {
+		// given
+		handler := NewReadinessHandler(logrus.StandardLogger(), config, map[string]Check{
+			"database": passing,
+			"gardener": failing,
+		})
+
+		// when
+		code, report := serve(t, handler)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, StatusFailed, report.Status)
+		assert.Equal(t, CheckResult{Status: StatusOK}, report.Checks["database"])
+		assert.Equal(t, CheckResult{Status: StatusFailed, Error: "connection refused"}, report.Checks["gardener"])
+	})
+
+	t.Run("should fail the check which does not finish within the timeout", func(t *testing.T) {
+		// given
+		blocking := func(_ context.Context) error {
+			time.Sleep(time.Second)
+			return nil
+		}
+		handler := NewReadinessHandler(logrus.StandardLogger(), config, map[string]Check{
+			"director": blocking,
+		})
+
+		// when
+		code, report := serve(t, handler)
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, code)
+		assert.Equal(t, CheckResult{Status: StatusFailed, Error: "check did not finish within 100ms"}, report.Checks["director"])
+	})
+
+	t.Run("should cache the results until they expire", func(t *testing.T) {
+		// given
+		calls := 0
+		counting := func(_ context.Context) error {
+			calls++
+			return nil
+		}
+		handler := NewReadinessHandler(logrus.StandardLogger(), config, map[string]Check{
+			"database": counting,
+		})
+		now := time.Now()
+		handler.now = func() time.Time { return now }
+
+		// when
+		handler.Report(context.Background())
+		now = now.Add(30 * time.Second)
+		handler.Report(context.Background())
+
+		// then
+		assert.Equal(t, 1, calls)
+
+		// when
+		now = now.Add(time.Minute)
+		handler.Report(context.Background())
+
+		// then
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should not cache the results of checks cancelled by the caller", func(t *testing.T) {
+		// given
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		cancelling := func(_ context.Context) error {
+			calls++
+			if calls == 1 {
+				cancel()
+				return context.Canceled
+			}
+			return nil
+		}
+		handler := NewReadinessHandler(logrus.StandardLogger(), config, map[string]Check{
+			"database": cancelling,
+		})
+
+		// when
+		cancelled := handler.Report(ctx)
+		report := handler.Report(context.Background())
+
+		// then
+		assert.Equal(t, StatusFailed, cancelled.Status)
+		assert.Equal(t, StatusOK, report.Status)
+		assert.Equal(t, 2, calls)
+	})
+}
+
+func TestNewDirectorCheck(t *testing.T) {
+	t.Run("should pass when token is fetched", func(t *testing.T) {
+		// given
+		oauthClient := &oauthmocks.Client{}
+		oauthClient.On("GetAuthorizationToken").Return(oauth.Token{AccessToken: "token"}, nil)
+
+		// then
+		require.NoError(t, NewDirectorCheck(oauthClient)(context.Background()))
+	})
+
+	t.Run("should fail when token cannot be fetched", func(t *testing.T) {
+		// given
+		oauthClient := &oauthmocks.Client{}
+		oauthClient.On("GetAuthorizationToken").Return(oauth.Token{}, apperrors.External("unauthorized"))
+
+		// when
+		err := NewDirectorCheck(oauthClient)(context.Background())
+
+		// then
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "unauthorized")
+	})
+}
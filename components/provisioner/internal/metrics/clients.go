@@ -0,0 +1,31 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	GardenerClient = "gardener"
+	DirectorClient = "director"
+)
+
+var clientRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: prometheusNamespace,
+	Subsystem: prometheusSubsystem,
+	Name:      "client_request_duration_seconds",
+	Help:      "The duration of requests sent to external services by client, HTTP method and response code",
+	Buckets:   prometheus.DefBuckets,
+}, []string{"client", "method", "code"})
+
+// InstrumentRoundTripper records the duration of the requests sent with the given round tripper.
+// Requests which fail without a response are not recorded.
+func InstrumentRoundTripper(client string, next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return promhttp.InstrumentRoundTripperDuration(clientRequestDuration.MustCurryWith(prometheus.Labels{"client": client}), next)
+}
@@ -20,6 +20,7 @@ type InProgressOperationsCollector struct {
 
 	provisioningDesc   *prometheus.Desc
 	deprovisioningDesc *prometheus.Desc
+	upgradeShootDesc   *prometheus.Desc
 
 	log logrus.FieldLogger
 }
@@ -38,6 +39,11 @@ func NewInProgressOperationsCollector(statsGetter OperationsStatsGetter) *InProg
 			"The number of deprovisioning without uninstallation operations in progress",
 			[]string{},
 			nil),
+		upgradeShootDesc: prometheus.NewDesc(
+			buildFQName(model.UpgradeShoot),
+			"The number of Shoot upgrade operations in progress",
+			[]string{},
+			nil),
 
 		log: logrus.WithField("collector", "in-progress-operations"),
 	}
@@ -46,6 +52,7 @@ func NewInProgressOperationsCollector(statsGetter OperationsStatsGetter) *InProg
 func (c *InProgressOperationsCollector) Describe(ch chan<- *prometheus.Desc) {
 	ch <- c.provisioningDesc
 	ch <- c.deprovisioningDesc
+	ch <- c.upgradeShootDesc
 }
 
 func (c *InProgressOperationsCollector) Collect(ch chan<- prometheus.Metric) {
@@ -64,6 +71,10 @@ func (c *InProgressOperationsCollector) Collect(ch chan<- prometheus.Metric) {
 		c.deprovisioningDesc,
 		inProgressOpsCounts.Count[model.DeprovisionNoInstall],
 	)
+	c.newMeasure(ch,
+		c.upgradeShootDesc,
+		inProgressOpsCounts.Count[model.UpgradeShoot],
+	)
 }
 
 func (c *InProgressOperationsCollector) newMeasure(ch chan<- prometheus.Metric, desc *prometheus.Desc, value int, labelValues ...string) {
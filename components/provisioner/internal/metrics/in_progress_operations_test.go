@@ -19,6 +19,7 @@ func Test_InProgressOperationsCollector_Collect(t *testing.T) {
 			model.Deprovision:          5,
 			model.DeprovisionNoInstall: 3,
 			model.Upgrade:              2,
+			model.UpgradeShoot:         4,
 		},
 	}
 
@@ -39,6 +40,10 @@ func Test_InProgressOperationsCollector_Collect(t *testing.T) {
 	deprovisionMetric := <-receiver
 	assertGaugeValue(t, deprovisionMetric, float64(3))
 	assert.Contains(t, deprovisionMetric.Desc().String(), "kcp_provisioner_in_progress_deprovision_no_install_operations_total")
+
+	upgradeShootMetric := <-receiver
+	assertGaugeValue(t, upgradeShootMetric, float64(4))
+	assert.Contains(t, upgradeShootMetric.Desc().String(), "kcp_provisioner_in_progress_upgrade_shoot_operations_total")
 }
 
 func Test_InProgressOperationsCollector_Describe(t *testing.T) {
@@ -54,6 +59,9 @@ func Test_InProgressOperationsCollector_Describe(t *testing.T) {
 
 	deprovisionDesc := <-receiver
 	assert.Contains(t, deprovisionDesc.String(), "kcp_provisioner_in_progress_deprovision_no_install_operations_total")
+
+	upgradeShootDesc := <-receiver
+	assert.Contains(t, upgradeShootDesc.String(), "kcp_provisioner_in_progress_upgrade_shoot_operations_total")
 }
 
 func assertGaugeValue(t *testing.T, metric prometheus.Metric, expected float64) {
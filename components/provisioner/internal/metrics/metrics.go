@@ -7,10 +7,21 @@ const (
 	prometheusSubsystem = "provisioner"
 )
 
-func Register(opsStatsGetter OperationsStatsGetter) error {
-	err := prometheus.Register(NewInProgressOperationsCollector(opsStatsGetter))
-	if err != nil {
-		return err
+func Register(opsStatsGetter OperationsStatsGetter, queues map[string]QueueStatsGetter) error {
+	collectors := []prometheus.Collector{
+		NewInProgressOperationsCollector(opsStatsGetter),
+		NewQueuesCollector(queues),
+		operationsFinished,
+		operationDuration,
+		stageDuration,
+		clientRequestDuration,
+	}
+
+	for _, collector := range collectors {
+		err := prometheus.Register(collector)
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
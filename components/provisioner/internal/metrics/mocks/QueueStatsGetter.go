@@ -0,0 +1,52 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// QueueStatsGetter is an autogenerated mock type for the QueueStatsGetter type
+type QueueStatsGetter struct {
+	mock.Mock
+}
+
+// BusyWorkers provides a mock function with given fields:
+func (_m *QueueStatsGetter) BusyWorkers() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Len provides a mock function with given fields:
+func (_m *QueueStatsGetter) Len() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// NewQueueStatsGetter creates a new instance of QueueStatsGetter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewQueueStatsGetter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *QueueStatsGetter {
+	mock := &QueueStatsGetter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+package metrics
+
+import (
+	"strings"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	operationsFinished = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "operations_finished_total",
+		Help:      "The number of finished operations by type, state, and the reason and component of the last error",
+	}, []string{"type", "state", "reason", "component"})
+
+	operationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "operation_duration_seconds",
+		Help:      "The duration of finished operations from their start to the end by type and state",
+		Buckets:   []float64{60, 180, 300, 600, 900, 1200, 1800, 2700, 3600, 5400, 7200},
+	}, []string{"type", "state"})
+
+	stageDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "operation_stage_duration_seconds",
+		Help:      "The duration of completed operation stages by operation type and stage",
+		Buckets:   []float64{5, 15, 30, 60, 120, 300, 600, 900, 1800, 3600},
+	}, []string{"type", "stage"})
+)
+
+// OperationFinished records the operation which reached the final state. The last error is empty for succeeded operations.
+func OperationFinished(operation model.Operation, state model.OperationState, lastError model.LastError, finishedAt time.Time) {
+	operationType := label(string(operation.Type))
+	operationState := label(string(state))
+
+	operationsFinished.WithLabelValues(operationType, operationState, lastError.Reason, lastError.Component).Inc()
+	operationDuration.WithLabelValues(operationType, operationState).Observe(finishedAt.Sub(operation.StartTimestamp).Seconds())
+}
+
+// StageFinished records the time the operation spent in the completed stage
+func StageFinished(operationType model.OperationType, stage model.OperationStage, duration time.Duration) {
+	stageDuration.WithLabelValues(label(string(operationType)), string(stage)).Observe(duration.Seconds())
+}
+
+func label(value string) string {
+	return strings.ToLower(value)
+}
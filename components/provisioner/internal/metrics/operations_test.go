@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_OperationFinished(t *testing.T) {
+	// given
+	startTime := time.Now().Add(-10 * time.Minute)
+	operation := model.Operation{ID: "operation-id", Type: model.Provision, StartTimestamp: startTime}
+	lastError := model.LastError{Reason: "ERR_INFRA_QUOTA_EXCEEDED", Component: "gardener"}
+
+	// when
+	OperationFinished(operation, model.Failed, lastError, startTime.Add(10*time.Minute))
+	OperationFinished(operation, model.Succeeded, model.LastError{}, startTime.Add(20*time.Minute))
+
+	// then
+	assert.Equal(t, float64(1), testutil.ToFloat64(operationsFinished.WithLabelValues("provision", "failed", "ERR_INFRA_QUOTA_EXCEEDED", "gardener")))
+	assert.Equal(t, float64(1), testutil.ToFloat64(operationsFinished.WithLabelValues("provision", "succeeded", "", "")))
+	assert.Equal(t, 2, testutil.CollectAndCount(operationDuration, "kcp_provisioner_operation_duration_seconds"))
+}
+
+func Test_StageFinished(t *testing.T) {
+	// when
+	StageFinished(model.UpgradeShoot, model.WaitingForShootUpgrade, 5*time.Minute)
+
+	// then
+	assert.Equal(t, 1, testutil.CollectAndCount(stageDuration, "kcp_provisioner_operation_stage_duration_seconds"))
+}
@@ -0,0 +1,56 @@
+package metrics
+
+import (
+	"sort"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+//go:generate mockery --name=QueueStatsGetter
+type QueueStatsGetter interface {
+	Len() int
+	BusyWorkers() int
+}
+
+type QueuesCollector struct {
+	queues map[string]QueueStatsGetter
+
+	depthDesc       *prometheus.Desc
+	busyWorkersDesc *prometheus.Desc
+}
+
+func NewQueuesCollector(queues map[string]QueueStatsGetter) *QueuesCollector {
+	return &QueuesCollector{
+		queues: queues,
+
+		depthDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "queue_depth"),
+			"The number of operations waiting in the queue to be processed",
+			[]string{"queue"},
+			nil),
+		busyWorkersDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "queue_busy_workers"),
+			"The number of queue workers processing operations",
+			[]string{"queue"},
+			nil),
+	}
+}
+
+func (c *QueuesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.depthDesc
+	ch <- c.busyWorkersDesc
+}
+
+func (c *QueuesCollector) Collect(ch chan<- prometheus.Metric) {
+	names := make([]string, 0, len(c.queues))
+	for name := range c.queues {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		queue := c.queues[name]
+		ch <- prometheus.MustNewConstMetric(c.depthDesc, prometheus.GaugeValue, float64(queue.Len()), name)
+		ch <- prometheus.MustNewConstMetric(c.busyWorkersDesc, prometheus.GaugeValue, float64(queue.BusyWorkers()), name)
+	}
+}
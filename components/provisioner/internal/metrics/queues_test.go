@@ -0,0 +1,30 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_QueuesCollector_Collect(t *testing.T) {
+	provisioningQueue := &mocks.QueueStatsGetter{}
+	provisioningQueue.On("Len").Return(7)
+	provisioningQueue.On("BusyWorkers").Return(5)
+
+	collector := NewQueuesCollector(map[string]QueueStatsGetter{"provisioning": provisioningQueue})
+
+	receiver := make(chan prometheus.Metric, 5)
+	defer close(receiver)
+
+	collector.Collect(receiver)
+
+	depthMetric := <-receiver
+	assertGaugeValue(t, depthMetric, float64(7))
+	assert.Contains(t, depthMetric.Desc().String(), "kcp_provisioner_queue_depth")
+
+	busyWorkersMetric := <-receiver
+	assertGaugeValue(t, busyWorkersMetric, float64(5))
+	assert.Contains(t, busyWorkersMetric.Desc().String(), "kcp_provisioner_queue_busy_workers")
+}
@@ -0,0 +1,160 @@
+package model
+
+import (
+	"encoding/json"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/alicloud"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+type AlicloudGardenerConfig struct {
+	ProviderSpecificConfig
+	input *gqlschema.AlicloudProviderConfigInput `db:"-"`
+}
+
+func init() {
+	registerProvider(providerRegistration{
+		discriminator: "alicloudZones",
+		fromInput: func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+			if input.AlicloudConfig == nil {
+				return nil, nil
+			}
+			return NewAlicloudGardenerConfig(input.AlicloudConfig)
+		},
+		fromJSON: func(jsonData string) (GardenerProviderConfig, error) {
+			var input gqlschema.AlicloudProviderConfigInput
+			if err := util.DecodeJson(jsonData, &input); err != nil {
+				return nil, err
+			}
+			return &AlicloudGardenerConfig{input: &input, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+		},
+	})
+}
+
+func NewAlicloudGardenerConfig(input *gqlschema.AlicloudProviderConfigInput) (*AlicloudGardenerConfig, apperrors.AppError) {
+	if len(input.AlicloudZones) == 0 {
+		return &AlicloudGardenerConfig{}, apperrors.BadRequest("Alicloud config does not contain zones")
+	}
+
+	config, err := json.Marshal(input)
+	if err != nil {
+		return &AlicloudGardenerConfig{}, apperrors.Internal("failed to marshal Alicloud Gardener config")
+	}
+
+	return &AlicloudGardenerConfig{
+		ProviderSpecificConfig: ProviderSpecificConfig(config),
+		input:                  input,
+	}, nil
+}
+
+func (c AlicloudGardenerConfig) NodeCIDR(GardenerConfig) string {
+	return c.input.VpcCidr
+}
+
+func (c AlicloudGardenerConfig) Networks() ProviderNetworks {
+	zones := make([]ProviderNetwork, 0, len(c.input.AlicloudZones))
+	for _, zone := range c.input.AlicloudZones {
+		zones = append(zones, ProviderNetwork{Name: "workerCidr of Alicloud zone " + zone.Name, CIDR: zone.WorkerCidr})
+	}
+
+	return ProviderNetworks{
+		VPC:         ProviderNetwork{Name: "vpcCidr", CIDR: c.input.VpcCidr},
+		ZoneWorkers: zones,
+		ZoneSubnets: zones,
+	}
+}
+
+func (c AlicloudGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig {
+	zones := make([]*gqlschema.AlicloudZone, 0, len(c.input.AlicloudZones))
+
+	for _, inputZone := range c.input.AlicloudZones {
+		zones = append(zones, &gqlschema.AlicloudZone{
+			Name:       inputZone.Name,
+			WorkerCidr: inputZone.WorkerCidr,
+		})
+	}
+
+	return gqlschema.AlicloudProviderConfig{
+		VpcCidr:       c.input.VpcCidr,
+		AlicloudZones: zones,
+	}
+}
+
+func (c AlicloudGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError {
+	if shoot.Spec.Provider.InfrastructureConfig == nil || shoot.Spec.Provider.InfrastructureConfig.Raw == nil {
+		return apperrors.Internal("shoot %s does not contain infrastructure config", shoot.Name)
+	}
+
+	infra := alicloud.InfrastructureConfig{}
+	err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
+	if err != nil {
+		return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
+	}
+
+	if c.input.VpcCidr != util.UnwrapStr(infra.Networks.VPC.CIDR) {
+		return apperrors.BadRequest("cannot change shoot VPC CIDR from %s to %s", util.UnwrapStr(infra.Networks.VPC.CIDR), c.input.VpcCidr)
+	}
+
+	for _, inputZone := range c.input.AlicloudZones {
+		zoneFound := false
+		for _, zone := range infra.Networks.Zones {
+			if inputZone.Name == zone.Name {
+				zoneFound = true
+				if inputZone.WorkerCidr != zone.Workers {
+					return apperrors.BadRequest("cannot change shoot network zone workers CIDR from %s to %s", zone.Workers, inputZone.WorkerCidr)
+				}
+			}
+		}
+
+		if !zoneFound {
+			return apperrors.BadRequest("extension of shoot network zones is not supported")
+		}
+	}
+
+	return nil
+}
+
+func (c AlicloudGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
+	return updateShootConfig(gardenerConfig, shoot)
+}
+
+func (c AlicloudGardenerConfig) CloudProfileName() string {
+	return "alicloud"
+}
+
+func (c AlicloudGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
+	shoot.Spec.CloudProfileName = c.CloudProfileName()
+
+	zoneNames := make([]string, 0, len(c.input.AlicloudZones))
+	for _, zone := range c.input.AlicloudZones {
+		zoneNames = append(zoneNames, zone.Name)
+	}
+
+	workers := getWorkers(gardenerConfig, zoneNames)
+
+	alicloudInfra := NewAlicloudInfrastructure(c)
+	jsonData, err := json.Marshal(alicloudInfra)
+	if err != nil {
+		return apperrors.Internal("error encoding infrastructure config: %s", err.Error())
+	}
+
+	alicloudControlPlane := NewAlicloudControlPlane()
+	jsonCPData, err := json.Marshal(alicloudControlPlane)
+	if err != nil {
+		return apperrors.Internal("error encoding control plane config: %s", err.Error())
+	}
+
+	shoot.Spec.Provider = gardener_types.Provider{
+		Type:                 "alicloud",
+		ControlPlaneConfig:   &apimachineryRuntime.RawExtension{Raw: jsonCPData},
+		InfrastructureConfig: &apimachineryRuntime.RawExtension{Raw: jsonData},
+		Workers:              workers,
+	}
+
+	return nil
+}
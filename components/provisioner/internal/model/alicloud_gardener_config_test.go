@@ -0,0 +1,112 @@
+package model
+
+import (
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAlicloudGardenerConfig(t *testing.T) {
+	alicloudProviderConfig, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+	require.NoError(t, err)
+
+	config := fixGardenerConfig("alicloud", alicloudProviderConfig)
+
+	shoot, appErr := config.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+	require.NoError(t, appErr)
+
+	t.Run("should convert to Shoot template with Alicloud provider", func(t *testing.T) {
+		// then
+		assert.Equal(t, "alicloud", shoot.Spec.CloudProfileName)
+		assert.Equal(t, util.StringPtr("10.10.11.11/255"), shoot.Spec.Networking.Nodes)
+		assert.Equal(t, "alicloud", shoot.Spec.Provider.Type)
+		assert.JSONEq(t,
+			`{"kind":"InfrastructureConfig","apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","networks":{"vpc":{"cidr":"10.10.11.11/255"},"zones":[{"name":"eu-central-1a","workers":"10.10.11.12/255"},{"name":"eu-central-1b","workers":"10.10.11.13/255"}]}}`,
+			string(shoot.Spec.Provider.InfrastructureConfig.Raw))
+		assert.JSONEq(t,
+			`{"kind":"ControlPlaneConfig","apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1"}`,
+			string(shoot.Spec.Provider.ControlPlaneConfig.Raw))
+		assert.Equal(t, []gardener_types.Worker{fixWorker([]string{"eu-central-1a", "eu-central-1b"})}, shoot.Spec.Provider.Workers)
+	})
+
+	t.Run("should accept unchanged networks", func(t *testing.T) {
+		// when
+		appErr := alicloudProviderConfig.ValidateShootConfigChange(shoot.DeepCopy())
+
+		// then
+		require.NoError(t, appErr)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		modifyInput func(input *gqlschema.AlicloudProviderConfigInput)
+	}{
+		{description: "should reject VPC CIDR change",
+			modifyInput: func(input *gqlschema.AlicloudProviderConfigInput) { input.VpcCidr = "10.10.12.11/255" }},
+		{description: "should reject zone workers CIDR change",
+			modifyInput: func(input *gqlschema.AlicloudProviderConfigInput) {
+				input.AlicloudZones[0].WorkerCidr = "10.10.12.12/255"
+			}},
+		{description: "should reject new zone",
+			modifyInput: func(input *gqlschema.AlicloudProviderConfigInput) {
+				input.AlicloudZones = append(input.AlicloudZones, &gqlschema.AlicloudZoneInput{Name: "eu-central-1c", WorkerCidr: "10.10.11.14/255"})
+			}},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			input := fixAlicloudGardenerInput()
+			testCase.modifyInput(input)
+
+			changedProviderConfig, err := NewAlicloudGardenerConfig(input)
+			require.NoError(t, err)
+
+			// when
+			appErr := changedProviderConfig.ValidateShootConfigChange(shoot.DeepCopy())
+
+			// then
+			require.Error(t, appErr)
+			util.CheckErrorType(t, appErr, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("should return error when Shoot has no infrastructure config", func(t *testing.T) {
+		// given
+		shootWithoutInfrastructure := shoot.DeepCopy()
+		shootWithoutInfrastructure.Spec.Provider.InfrastructureConfig = nil
+
+		// when
+		appErr := alicloudProviderConfig.ValidateShootConfigChange(shootWithoutInfrastructure)
+
+		// then
+		require.Error(t, appErr)
+		util.CheckErrorType(t, appErr, apperrors.CodeInternal)
+	})
+
+	t.Run("should reject config without zones", func(t *testing.T) {
+		// given
+		input := fixAlicloudGardenerInput()
+		input.AlicloudZones = nil
+
+		// when
+		_, appErr := NewAlicloudGardenerConfig(input)
+
+		// then
+		require.Error(t, appErr)
+		util.CheckErrorType(t, appErr, apperrors.CodeBadRequest)
+	})
+}
+
+func fixAlicloudGardenerInput() *gqlschema.AlicloudProviderConfigInput {
+	return &gqlschema.AlicloudProviderConfigInput{
+		VpcCidr: "10.10.11.11/255",
+		AlicloudZones: []*gqlschema.AlicloudZoneInput{
+			{Name: "eu-central-1a", WorkerCidr: "10.10.11.12/255"},
+			{Name: "eu-central-1b", WorkerCidr: "10.10.11.13/255"},
+		},
+	}
+}
@@ -7,6 +7,7 @@ import (
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/openstack"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -24,8 +25,24 @@ const (
 	EuAccessAnnotation                   = "support.gardener.cloud/eu-access-for-cluster-nodes"
 	ShootNetworkingFilterExtensionType   = "shoot-networking-filter"
 	ShootNetworkingFilterDisabledDefault = true
+	ShootDNSServiceExtensionType         = "shoot-dns-service"
+	ShootCertServiceExtensionType        = "shoot-cert-service"
+	ShootAuditlogServiceExtensionType    = "shoot-auditlog-service"
 )
 
+// managedExtensionTypes are the Shoot extensions configured by the provisioner itself, they cannot be set as generic extensions
+var managedExtensionTypes = map[string]bool{
+	ShootNetworkingFilterExtensionType: true,
+	ShootDNSServiceExtensionType:       true,
+	ShootCertServiceExtensionType:      true,
+	ShootAuditlogServiceExtensionType:  true,
+}
+
+// IsManagedExtensionType returns true if the Shoot extension of the given type is configured by the provisioner
+func IsManagedExtensionType(extensionType string) bool {
+	return managedExtensionTypes[extensionType]
+}
+
 var networkingType = "calico"
 
 type OIDCConfig struct {
@@ -82,6 +99,29 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool
 	ControlPlaneFailureTolerance        *string
 	EuAccess                            bool
+	HibernationSchedules                []HibernationSchedule `db:"-"`
+	AdditionalWorkerPools               []WorkerPool          `db:"-"`
+	WorkerLabels                        map[string]string     `db:"-"`
+	WorkerTaints                        []Taint               `db:"-"`
+	KubeletConfig                       *KubeletConfig        `db:"-"`
+	CRIName                             *string               `db:"cri_name"`
+	Extensions                          []Extension           `db:"-"`
+	// RemovedExtensionTypes are the types of the extensions previously set through the API which the upgrade removes from the Shoot
+	RemovedExtensionTypes []string `db:"-" json:"-"`
+}
+
+// Extension is a Gardener extension enabled on the Shoot in addition to the extensions managed by the provisioner.
+type Extension struct {
+	Type           string  `json:"type"`
+	Disabled       *bool   `json:"disabled,omitempty"`
+	ProviderConfig *string `json:"providerConfig,omitempty"`
+}
+
+// HibernationSchedule defines when the cluster is hibernated and woken up, using cron expressions evaluated in the given location.
+type HibernationSchedule struct {
+	Start    *string `json:"start,omitempty"`
+	End      *string `json:"end,omitempty"`
+	Location *string `json:"location,omitempty"`
 }
 
 type ExtensionProviderConfig struct {
@@ -205,12 +245,13 @@ func (c GardenerConfig) ToShootTemplate(namespace string, accountId string, subA
 				},
 			},
 			DNS: gardenerDnsConfig(dnsInputConfig),
-			Extensions: []gardener_types.Extension{
-				{Type: "shoot-dns-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonDNSConfig}},
-				{Type: "shoot-cert-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonCertConfig}},
+			Extensions: append([]gardener_types.Extension{
+				{Type: ShootDNSServiceExtensionType, ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonDNSConfig}},
+				{Type: ShootCertServiceExtensionType, ProviderConfig: &apimachineryRuntime.RawExtension{Raw: jsonCertConfig}},
 				{Type: ShootNetworkingFilterExtensionType, Disabled: util.DefaultBoolIfNil(c.ShootNetworkingFilterDisabled, util.BoolPtr(ShootNetworkingFilterDisabledDefault))},
-			},
+			}, gardenerExtensions(c.Extensions)...),
 			ControlPlane: controlPlane,
+			Hibernation:  gardenerHibernation(c.HibernationSchedules),
 		},
 	}
 
@@ -263,47 +304,106 @@ func gardenerDnsConfig(dnsConfig *DNSConfig) *gardener_types.DNS {
 	return nil
 }
 
-type ProviderSpecificConfig string
+func gardenerExtensions(extensions []Extension) []gardener_types.Extension {
+	gardenerExtensions := make([]gardener_types.Extension, 0, len(extensions))
+	for _, extension := range extensions {
+		gardenerExtension := gardener_types.Extension{
+			Type:     extension.Type,
+			Disabled: extension.Disabled,
+		}
+		if extension.ProviderConfig != nil {
+			gardenerExtension.ProviderConfig = &apimachineryRuntime.RawExtension{Raw: []byte(*extension.ProviderConfig)}
+		}
+		gardenerExtensions = append(gardenerExtensions, gardenerExtension)
+	}
 
-func (c ProviderSpecificConfig) RawJSON() string {
-	return string(c)
+	return gardenerExtensions
 }
 
-type GardenerProviderConfig interface {
-	RawJSON() string
-	NodeCIDR(gardenerConfig GardenerConfig) string
-	AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig
-	ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError
-	EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError
-	ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError
+// mergeExtensions replaces the Shoot extensions of the same type as the upgraded ones and appends the others.
+// Managed extensions and the extensions not present in the upgrade are left untouched.
+func mergeExtensions(shootExtensions []gardener_types.Extension, extensions []Extension) []gardener_types.Extension {
+	merged := append([]gardener_types.Extension{}, shootExtensions...)
+
+	for _, extension := range gardenerExtensions(extensions) {
+		if IsManagedExtensionType(extension.Type) {
+			continue
+		}
+
+		replaced := false
+		for i := range merged {
+			if merged[i].Type == extension.Type {
+				merged[i] = extension
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			merged = append(merged, extension)
+		}
+	}
+
+	return merged
 }
 
-func NewGardenerProviderConfigFromJSON(jsonData string) (GardenerProviderConfig, apperrors.AppError) { //TODO: change to detect Provider correctly
-	var gcpProviderConfig gqlschema.GCPProviderConfigInput
-	err := util.DecodeJson(jsonData, &gcpProviderConfig)
-	if err == nil {
-		return &GCPGardenerConfig{input: &gcpProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+// removeExtensions removes the Shoot extensions of the given types, except for the managed ones.
+func removeExtensions(shootExtensions []gardener_types.Extension, types []string) []gardener_types.Extension {
+	if len(types) == 0 {
+		return shootExtensions
 	}
 
-	var azureProviderConfig gqlschema.AzureProviderConfigInput
-	err = util.DecodeJson(jsonData, &azureProviderConfig)
-	if err == nil {
-		return &AzureGardenerConfig{input: &azureProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+	removed := make(map[string]bool, len(types))
+	for _, extensionType := range types {
+		removed[extensionType] = !IsManagedExtensionType(extensionType)
 	}
 
-	var awsProviderConfig gqlschema.AWSProviderConfigInput
-	err = util.DecodeJson(jsonData, &awsProviderConfig)
-	if err == nil {
-		return &AWSGardenerConfig{input: &awsProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+	remaining := make([]gardener_types.Extension, 0, len(shootExtensions))
+	for _, extension := range shootExtensions {
+		if !removed[extension.Type] {
+			remaining = append(remaining, extension)
+		}
+	}
+
+	return remaining
+}
+
+func gardenerHibernation(schedules []HibernationSchedule) *gardener_types.Hibernation {
+	if len(schedules) == 0 {
+		return nil
 	}
 
-	var openStackProviderConfig gqlschema.OpenStackProviderConfigInput
-	err = util.DecodeJson(jsonData, &openStackProviderConfig)
-	if err == nil {
-		return &OpenStackGardenerConfig{input: &openStackProviderConfig, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+	return &gardener_types.Hibernation{
+		Schedules: gardenerHibernationSchedules(schedules),
 	}
+}
+
+func gardenerHibernationSchedules(schedules []HibernationSchedule) []gardener_types.HibernationSchedule {
+	hibernationSchedules := make([]gardener_types.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		hibernationSchedules = append(hibernationSchedules, gardener_types.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+
+	return hibernationSchedules
+}
+
+type ProviderSpecificConfig string
+
+func (c ProviderSpecificConfig) RawJSON() string {
+	return string(c)
+}
 
-	return nil, apperrors.BadRequest("json data does not match any of Gardener providers")
+type GardenerProviderConfig interface {
+	RawJSON() string
+	NodeCIDR(gardenerConfig GardenerConfig) string
+	CloudProfileName() string
+	AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig
+	ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError
+	EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError
+	ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError
 }
 
 type GCPGardenerConfig struct {
@@ -311,6 +411,25 @@ type GCPGardenerConfig struct {
 	input *gqlschema.GCPProviderConfigInput `db:"-"`
 }
 
+func init() {
+	registerProvider(providerRegistration{
+		fallback: true,
+		fromInput: func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+			if input.GcpConfig == nil {
+				return nil, nil
+			}
+			return NewGCPGardenerConfig(input.GcpConfig)
+		},
+		fromJSON: func(jsonData string) (GardenerProviderConfig, error) {
+			var input gqlschema.GCPProviderConfigInput
+			if err := util.DecodeJson(jsonData, &input); err != nil {
+				return nil, err
+			}
+			return &GCPGardenerConfig{input: &input, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+		},
+	})
+}
+
 func NewGCPGardenerConfig(input *gqlschema.GCPProviderConfigInput) (*GCPGardenerConfig, apperrors.AppError) {
 	config, err := json.Marshal(input)
 	if err != nil {
@@ -339,10 +458,14 @@ func (c GCPGardenerConfig) ValidateShootConfigChange(*gardener_types.Shoot) appe
 	return nil
 }
 
+func (c GCPGardenerConfig) CloudProfileName() string {
+	return "gcp"
+}
+
 func (c GCPGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	shoot.Spec.CloudProfileName = "gcp"
+	shoot.Spec.CloudProfileName = c.CloudProfileName()
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
 
 	gcpInfra := NewGCPInfrastructure(gardenerConfig.WorkerCidr)
 	jsonData, err := json.Marshal(gcpInfra)
@@ -372,6 +495,25 @@ type AzureGardenerConfig struct {
 	input *gqlschema.AzureProviderConfigInput `db:"-"`
 }
 
+func init() {
+	registerProvider(providerRegistration{
+		discriminator: "vnetCidr",
+		fromInput: func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+			if input.AzureConfig == nil {
+				return nil, nil
+			}
+			return NewAzureGardenerConfig(input.AzureConfig)
+		},
+		fromJSON: func(jsonData string) (GardenerProviderConfig, error) {
+			var input gqlschema.AzureProviderConfigInput
+			if err := util.DecodeJson(jsonData, &input); err != nil {
+				return nil, err
+			}
+			return &AzureGardenerConfig{input: &input, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+		},
+	})
+}
+
 func NewAzureGardenerConfig(input *gqlschema.AzureProviderConfigInput) (*AzureGardenerConfig, apperrors.AppError) {
 	config, err := json.Marshal(input)
 	if err != nil {
@@ -388,6 +530,21 @@ func (c AzureGardenerConfig) NodeCIDR(GardenerConfig) string {
 	return c.input.VnetCidr
 }
 
+// Networks describes the Azure networks, the workers CIDR of the cluster is required to be within the VNet.
+func (c AzureGardenerConfig) Networks() ProviderNetworks {
+	zones := make([]ProviderNetwork, 0, len(c.input.AzureZones))
+	for _, zone := range c.input.AzureZones {
+		zones = append(zones, ProviderNetwork{Name: fmt.Sprintf("cidr of Azure zone %d", zone.Name), CIDR: zone.Cidr})
+	}
+
+	return ProviderNetworks{
+		VPC:            ProviderNetwork{Name: "vnetCidr", CIDR: c.input.VnetCidr},
+		ZoneWorkers:    zones,
+		ZoneSubnets:    zones,
+		NodesWithinVPC: true,
+	}
+}
+
 func (c AzureGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig {
 	var zones []*gqlschema.AzureZone = nil
 	if len(c.input.AzureZones) > 0 {
@@ -490,14 +647,18 @@ func (c AzureGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoo
 	return nil
 }
 
+func (c AzureGardenerConfig) CloudProfileName() string {
+	return "az"
+}
+
 func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	shoot.Spec.CloudProfileName = "az"
+	shoot.Spec.CloudProfileName = c.CloudProfileName()
 
 	zoneNames := c.input.Zones
 	if len(c.input.AzureZones) > 0 {
 		zoneNames = getAzureZonesNames(c.input.AzureZones)
 	}
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	azInfra := NewAzureInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(azInfra)
@@ -521,6 +682,25 @@ func (c AzureGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, sh
 	return nil
 }
 
+func init() {
+	registerProvider(providerRegistration{
+		discriminator: "awsZones",
+		fromInput: func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+			if input.AwsConfig == nil {
+				return nil, nil
+			}
+			return NewAWSGardenerConfig(input.AwsConfig)
+		},
+		fromJSON: func(jsonData string) (GardenerProviderConfig, error) {
+			var input gqlschema.AWSProviderConfigInput
+			if err := util.DecodeJson(jsonData, &input); err != nil {
+				return nil, err
+			}
+			return &AWSGardenerConfig{input: &input, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+		},
+	})
+}
+
 func NewAWSGardenerConfig(input *gqlschema.AWSProviderConfigInput) (*AWSGardenerConfig, apperrors.AppError) {
 	config, err := json.Marshal(input)
 	if err != nil {
@@ -537,6 +717,29 @@ func (c AWSGardenerConfig) NodeCIDR(GardenerConfig) string {
 	return c.input.VpcCidr
 }
 
+func (c AWSGardenerConfig) Networks() ProviderNetworks {
+	var workers []ProviderNetwork
+	var subnets []ProviderNetwork
+	for _, zone := range c.input.AwsZones {
+		if zone == nil {
+			continue
+		}
+		worker := ProviderNetwork{Name: "workerCidr of AWS zone " + zone.Name, CIDR: zone.WorkerCidr}
+		workers = append(workers, worker)
+		subnets = append(subnets,
+			ProviderNetwork{Name: "publicCidr of AWS zone " + zone.Name, CIDR: zone.PublicCidr},
+			ProviderNetwork{Name: "internalCidr of AWS zone " + zone.Name, CIDR: zone.InternalCidr},
+			worker,
+		)
+	}
+
+	return ProviderNetworks{
+		VPC:         ProviderNetwork{Name: "vpcCidr", CIDR: c.input.VpcCidr},
+		ZoneWorkers: workers,
+		ZoneSubnets: subnets,
+	}
+}
+
 func (c AWSGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig {
 	zones := make([]*gqlschema.AWSZone, 0)
 
@@ -591,12 +794,16 @@ func (c AWSGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot
 	return updateShootConfig(gardenerConfig, shoot)
 }
 
+func (c AWSGardenerConfig) CloudProfileName() string {
+	return "aws"
+}
+
 func (c AWSGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	shoot.Spec.CloudProfileName = "aws"
+	shoot.Spec.CloudProfileName = c.CloudProfileName()
 
 	zoneNames := getAWSZonesNames(c.input.AwsZones)
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zoneNames)}
+	workers := getWorkers(gardenerConfig, zoneNames)
 
 	awsInfra := NewAWSInfrastructure(c)
 	jsonData, err := json.Marshal(awsInfra)
@@ -625,6 +832,25 @@ type OpenStackGardenerConfig struct {
 	input *gqlschema.OpenStackProviderConfigInput `db:"-"`
 }
 
+func init() {
+	registerProvider(providerRegistration{
+		discriminator: "floatingPoolName",
+		fromInput: func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+			if input.OpenStackConfig == nil {
+				return nil, nil
+			}
+			return NewOpenStackGardenerConfig(input.OpenStackConfig)
+		},
+		fromJSON: func(jsonData string) (GardenerProviderConfig, error) {
+			var input gqlschema.OpenStackProviderConfigInput
+			if err := util.DecodeJson(jsonData, &input); err != nil {
+				return nil, err
+			}
+			return &OpenStackGardenerConfig{input: &input, ProviderSpecificConfig: ProviderSpecificConfig(jsonData)}, nil
+		},
+	})
+}
+
 func NewOpenStackGardenerConfig(input *gqlschema.OpenStackProviderConfigInput) (*OpenStackGardenerConfig, apperrors.AppError) {
 	config, err := json.Marshal(input)
 	if err != nil {
@@ -642,34 +868,128 @@ func (c OpenStackGardenerConfig) NodeCIDR(gardenerConfig GardenerConfig) string
 }
 
 func (c OpenStackGardenerConfig) AsProviderSpecificConfig() gqlschema.ProviderSpecificConfig {
+	var loadBalancerClasses []*gqlschema.OpenStackLoadBalancerClass
+	for _, inputClass := range c.input.LoadBalancerClasses {
+		loadBalancerClasses = append(loadBalancerClasses, &gqlschema.OpenStackLoadBalancerClass{
+			Name:              inputClass.Name,
+			FloatingSubnetID:  inputClass.FloatingSubnetID,
+			FloatingNetworkID: inputClass.FloatingNetworkID,
+			SubnetID:          inputClass.SubnetID,
+		})
+	}
+
 	return gqlschema.OpenStackProviderConfig{
-		Zones:                c.input.Zones,
-		FloatingPoolName:     c.input.FloatingPoolName,
-		CloudProfileName:     c.input.CloudProfileName,
-		LoadBalancerProvider: c.input.LoadBalancerProvider,
+		Zones:                  c.input.Zones,
+		FloatingPoolName:       c.input.FloatingPoolName,
+		CloudProfileName:       c.input.CloudProfileName,
+		LoadBalancerProvider:   c.input.LoadBalancerProvider,
+		FloatingPoolSubnetName: c.input.FloatingPoolSubnetName,
+		RouterID:               c.input.RouterID,
+		NetworkID:              c.input.NetworkID,
+		SubnetID:               c.input.SubnetID,
+		ServerGroupPolicy:      c.input.ServerGroupPolicy,
+		LoadBalancerClasses:    loadBalancerClasses,
 	}
 }
 
-func (c OpenStackGardenerConfig) ValidateShootConfigChange(*gardener_types.Shoot) apperrors.AppError {
+func (c OpenStackGardenerConfig) ValidateShootConfigChange(shoot *gardener_types.Shoot) apperrors.AppError {
+	// Networking of OpenStack clusters cannot be changed after the cluster is created
+	infra := openstack.InfrastructureConfig{}
+	if shoot.Spec.Provider.InfrastructureConfig != nil {
+		err := json.Unmarshal(shoot.Spec.Provider.InfrastructureConfig.Raw, &infra)
+		if err != nil {
+			return apperrors.Internal("error decoding infrastructure config: %s", err.Error())
+		}
+	}
+
+	if c.input.FloatingPoolName != infra.FloatingPoolName {
+		return apperrors.BadRequest("cannot change floating pool name from %s to %s", infra.FloatingPoolName, c.input.FloatingPoolName)
+	}
+	if util.UnwrapStr(c.input.FloatingPoolSubnetName) != util.UnwrapStr(infra.FloatingPoolSubnetName) {
+		return apperrors.BadRequest("cannot change floating pool subnet name from %s to %s", util.UnwrapStr(infra.FloatingPoolSubnetName), util.UnwrapStr(c.input.FloatingPoolSubnetName))
+	}
+
+	routerID := ""
+	if infra.Networks.Router != nil {
+		routerID = infra.Networks.Router.ID
+	}
+	if util.UnwrapStr(c.input.RouterID) != routerID {
+		return apperrors.BadRequest("cannot change router ID from %s to %s", routerID, util.UnwrapStr(c.input.RouterID))
+	}
+	if util.UnwrapStr(c.input.NetworkID) != util.UnwrapStr(infra.Networks.ID) {
+		return apperrors.BadRequest("cannot change network ID from %s to %s", util.UnwrapStr(infra.Networks.ID), util.UnwrapStr(c.input.NetworkID))
+	}
+	if util.UnwrapStr(c.input.SubnetID) != util.UnwrapStr(infra.Networks.SubnetID) {
+		return apperrors.BadRequest("cannot change subnet ID from %s to %s", util.UnwrapStr(infra.Networks.SubnetID), util.UnwrapStr(c.input.SubnetID))
+	}
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerConfig := openstack.WorkerConfig{}
+		if worker.ProviderConfig != nil {
+			err := json.Unmarshal(worker.ProviderConfig.Raw, &workerConfig)
+			if err != nil {
+				return apperrors.Internal("error decoding worker config of worker %s: %s", worker.Name, err.Error())
+			}
+		}
+
+		serverGroupPolicy := ""
+		if workerConfig.ServerGroup != nil {
+			serverGroupPolicy = workerConfig.ServerGroup.Policy
+		}
+		if util.UnwrapStr(c.input.ServerGroupPolicy) != serverGroupPolicy {
+			return apperrors.BadRequest("cannot change server group policy of worker %s from %s to %s", worker.Name, serverGroupPolicy, util.UnwrapStr(c.input.ServerGroupPolicy))
+		}
+	}
+
 	return nil
 }
 
 func (c OpenStackGardenerConfig) EditShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	return updateShootConfig(gardenerConfig, shoot)
+	err := updateShootConfig(gardenerConfig, shoot)
+	if err != nil {
+		return err
+	}
+
+	if c.input.LoadBalancerClasses != nil {
+		controlPlane := openstack.ControlPlaneConfig{}
+		if shoot.Spec.Provider.ControlPlaneConfig != nil {
+			err := json.Unmarshal(shoot.Spec.Provider.ControlPlaneConfig.Raw, &controlPlane)
+			if err != nil {
+				return apperrors.Internal("error decoding control plane config: %s", err.Error())
+			}
+		}
+		controlPlane.LoadBalancerClasses = createOpenStackLoadBalancerClasses(c.input.LoadBalancerClasses)
+		jsonData, err := json.Marshal(controlPlane)
+		if err != nil {
+			return apperrors.Internal("error encoding control plane config: %s", err.Error())
+		}
+		shoot.Spec.Provider.ControlPlaneConfig = &apimachineryRuntime.RawExtension{Raw: jsonData}
+	}
+
+	// Server group of the workers cannot be changed, so only workers added by the upgrade are configured
+	return c.configureNewWorkers(shoot.Spec.Provider.Workers)
+}
+
+func (c OpenStackGardenerConfig) CloudProfileName() string {
+	return c.input.CloudProfileName
 }
 
 func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig, shoot *gardener_types.Shoot) apperrors.AppError {
-	shoot.Spec.CloudProfileName = c.input.CloudProfileName
+	shoot.Spec.CloudProfileName = c.CloudProfileName()
 
-	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, c.input.Zones)}
+	workers := getWorkers(gardenerConfig, c.input.Zones)
+	appErr := c.configureNewWorkers(workers)
+	if appErr != nil {
+		return appErr
+	}
 
-	openStackInfra := NewOpenStackInfrastructure(c.input.FloatingPoolName, gardenerConfig.WorkerCidr)
+	openStackInfra := NewOpenStackInfrastructure(gardenerConfig.WorkerCidr, c)
 	jsonData, err := json.Marshal(openStackInfra)
 	if err != nil {
 		return apperrors.Internal("error encoding infrastructure config: %s", err.Error())
 	}
 
-	openstackControlPlane := NewOpenStackControlPlane(c.input.LoadBalancerProvider)
+	openstackControlPlane := NewOpenStackControlPlane(c)
 	jsonCPData, err := json.Marshal(openstackControlPlane)
 	if err != nil {
 		return apperrors.Internal("error encoding control plane config: %s", err.Error())
@@ -685,15 +1005,42 @@ func (c OpenStackGardenerConfig) ExtendShootConfig(gardenerConfig GardenerConfig
 	return nil
 }
 
+// configureNewWorkers sets the server group of the workers without provider config
+func (c OpenStackGardenerConfig) configureNewWorkers(workers []gardener_types.Worker) apperrors.AppError {
+	if util.IsNilOrEmpty(c.input.ServerGroupPolicy) {
+		return nil
+	}
+
+	jsonData, err := json.Marshal(NewOpenStackWorkerConfig(*c.input.ServerGroupPolicy))
+	if err != nil {
+		return apperrors.Internal("error encoding worker config: %s", err.Error())
+	}
+
+	for i := range workers {
+		if workers[i].ProviderConfig == nil {
+			workers[i].ProviderConfig = &apimachineryRuntime.RawExtension{Raw: jsonData}
+		}
+	}
+	return nil
+}
+
+// getWorkers returns the primary worker followed by the workers of the additional worker pools.
+func getWorkers(gardenerConfig GardenerConfig, zones []string) []gardener_types.Worker {
+	workers := []gardener_types.Worker{getWorkerConfig(gardenerConfig, zones)}
+	return append(workers, additionalWorkers(gardenerConfig.AdditionalWorkerPools, zones)...)
+}
+
 func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_types.Worker {
 	worker := gardener_types.Worker{
-		Name:           "cpu-worker-0",
+		Name:           PrimaryWorkerPoolName,
 		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxSurge)),
 		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(gardenerConfig.MaxUnavailable)),
 		Machine:        getMachineConfig(gardenerConfig),
 		Maximum:        int32(gardenerConfig.AutoScalerMax),
 		Minimum:        int32(gardenerConfig.AutoScalerMin),
 		Zones:          zones,
+		Labels:         gardenerConfig.WorkerLabels,
+		Taints:         gardenerTaints(gardenerConfig.WorkerTaints),
 	}
 
 	if gardenerConfig.DiskType != nil && gardenerConfig.VolumeSizeGB != nil {
@@ -703,6 +1050,9 @@ func getWorkerConfig(gardenerConfig GardenerConfig, zones []string) gardener_typ
 		}
 	}
 
+	applyKubeletConfig(gardenerConfig.KubeletConfig, &worker)
+	applyCRI(gardenerConfig.CRIName, &worker)
+
 	return worker
 }
 
@@ -732,7 +1082,7 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Provider.Workers[0].Volume.VolumeSize = fmt.Sprintf("%dGi", *upgradeConfig.VolumeSizeGB)
 	}
 
-	// We support only single working group during provisioning
+	// The primary worker group is always the first one, additional worker groups are synchronized below
 	shoot.Spec.Provider.Workers[0].MaxSurge = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxSurge))
 	shoot.Spec.Provider.Workers[0].MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(upgradeConfig.MaxUnavailable))
 	shoot.Spec.Provider.Workers[0].Machine.Type = upgradeConfig.MachineType
@@ -744,6 +1094,14 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 	if util.NotNilOrEmpty(upgradeConfig.MachineImageVersion) {
 		shoot.Spec.Provider.Workers[0].Machine.Image.Version = upgradeConfig.MachineImageVersion
 	}
+	if upgradeConfig.WorkerLabels != nil {
+		shoot.Spec.Provider.Workers[0].Labels = upgradeConfig.WorkerLabels
+	}
+	if upgradeConfig.WorkerTaints != nil {
+		shoot.Spec.Provider.Workers[0].Taints = gardenerTaints(upgradeConfig.WorkerTaints)
+	}
+	applyKubeletConfig(upgradeConfig.KubeletConfig, &shoot.Spec.Provider.Workers[0])
+	applyCRI(upgradeConfig.CRIName, &shoot.Spec.Provider.Workers[0])
 	if upgradeConfig.OIDCConfig != nil {
 		if shoot.Spec.Kubernetes.KubeAPIServer == nil {
 			shoot.Spec.Kubernetes.KubeAPIServer = &gardener_types.KubeAPIServerConfig{}
@@ -757,6 +1115,16 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 			UsernamePrefix: &upgradeConfig.OIDCConfig.UsernamePrefix,
 		}
 	}
+	if util.NotNilOrEmpty(upgradeConfig.ControlPlaneFailureTolerance) {
+		if shoot.Spec.ControlPlane == nil {
+			shoot.Spec.ControlPlane = &gardener_types.ControlPlane{}
+		}
+		shoot.Spec.ControlPlane.HighAvailability = &gardener_types.HighAvailability{
+			FailureTolerance: gardener_types.FailureTolerance{
+				Type: gardener_types.FailureToleranceType(*upgradeConfig.ControlPlaneFailureTolerance),
+			},
+		}
+	}
 	if util.NotNilOrEmpty(upgradeConfig.ExposureClassName) {
 		shoot.Spec.ExposureClassName = upgradeConfig.ExposureClassName
 	}
@@ -775,6 +1143,21 @@ func updateShootConfig(upgradeConfig GardenerConfig, shoot *gardener_types.Shoot
 		shoot.Spec.Extensions = upgradedExtensions
 	}
 
+	if upgradeConfig.Extensions != nil {
+		shoot.Spec.Extensions = mergeExtensions(removeExtensions(shoot.Spec.Extensions, upgradeConfig.RemovedExtensionTypes), upgradeConfig.Extensions)
+	}
+
+	if upgradeConfig.HibernationSchedules != nil {
+		if shoot.Spec.Hibernation == nil {
+			shoot.Spec.Hibernation = &gardener_types.Hibernation{}
+		}
+		shoot.Spec.Hibernation.Schedules = gardenerHibernationSchedules(upgradeConfig.HibernationSchedules)
+	}
+
+	if upgradeConfig.AdditionalWorkerPools != nil {
+		updateAdditionalWorkers(upgradeConfig.AdditionalWorkerPools, shoot)
+	}
+
 	// Needed for upgrade to Kubernetes 1.25
 	shoot.Spec.Kubernetes.AllowPrivilegedContainers = nil
 
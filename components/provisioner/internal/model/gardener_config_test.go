@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -25,6 +26,8 @@ func Test_NewGardenerConfigFromJSON(t *testing.T) {
 	azureZoneSubnetsConfigJSON := `{"vnetCidr":"10.10.11.11/255", "azureZones":[{"name":1,"cidr":"10.10.11.12/255"}, {"name":2,"cidr":"10.10.11.13/255"}], "enableNatGateway":true, "idleConnectionTimeoutMinutes":4}`
 	awsConfigJSON := `{"vpcCidr":"10.10.11.11/255","awsZones":[{"name":"zone","publicCidr":"10.10.11.12/255","internalCidr":"10.10.11.13/255","workerCidr":"10.10.11.11/255"}]}
 `
+	openStackConfigJSON := `{"zones":["eu-de-1a"],"floatingPoolName":"fip","cloudProfileName":"openstack","loadBalancerProvider":"f5"}`
+	alicloudConfigJSON := `{"vpcCidr":"10.10.11.11/255","alicloudZones":[{"name":"eu-central-1a","workerCidr":"10.10.11.12/255"}]}`
 
 	for _, testCase := range []struct {
 		description                    string
@@ -124,6 +127,40 @@ func Test_NewGardenerConfigFromJSON(t *testing.T) {
 				VpcCidr: util.StringPtr("10.10.11.11/255"),
 			},
 		},
+		{
+			description: "should create OpenStack Gardener config",
+			jsonData:    openStackConfigJSON,
+			expectedConfig: &OpenStackGardenerConfig{
+				ProviderSpecificConfig: ProviderSpecificConfig(openStackConfigJSON),
+				input: &gqlschema.OpenStackProviderConfigInput{
+					Zones:                []string{"eu-de-1a"},
+					FloatingPoolName:     "fip",
+					CloudProfileName:     "openstack",
+					LoadBalancerProvider: "f5",
+				},
+			},
+			expectedProviderSpecificConfig: gqlschema.OpenStackProviderConfig{
+				Zones:                []string{"eu-de-1a"},
+				FloatingPoolName:     "fip",
+				CloudProfileName:     "openstack",
+				LoadBalancerProvider: "f5",
+			},
+		},
+		{
+			description: "should create Alicloud Gardener config",
+			jsonData:    alicloudConfigJSON,
+			expectedConfig: &AlicloudGardenerConfig{
+				ProviderSpecificConfig: ProviderSpecificConfig(alicloudConfigJSON),
+				input: &gqlschema.AlicloudProviderConfigInput{
+					VpcCidr:       "10.10.11.11/255",
+					AlicloudZones: []*gqlschema.AlicloudZoneInput{{Name: "eu-central-1a", WorkerCidr: "10.10.11.12/255"}},
+				},
+			},
+			expectedProviderSpecificConfig: gqlschema.AlicloudProviderConfig{
+				VpcCidr:       "10.10.11.11/255",
+				AlicloudZones: []*gqlschema.AlicloudZone{{Name: "eu-central-1a", WorkerCidr: "10.10.11.12/255"}},
+			},
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// when
@@ -142,6 +179,25 @@ func Test_NewGardenerConfigFromJSON(t *testing.T) {
 	}
 }
 
+func Test_NewGardenerConfigFromJSON_Errors(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		jsonData    string
+	}{
+		{description: "should return error when json data is invalid", jsonData: `{"zones":`},
+		{description: "should return error when json data contains fields of different providers", jsonData: `{"vpcCidr":"10.10.11.11/255","awsZones":[],"alicloudZones":[]}`},
+		{description: "should return error when json data contains unknown fields", jsonData: `{"zones":["zone"],"region":"eu"}`},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			_, err := NewGardenerProviderConfigFromJSON(testCase.jsonData)
+
+			// then
+			require.Error(t, err)
+		})
+	}
+}
+
 func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 
 	zones := []string{"fix-zone-1", "fix-zone-2"}
@@ -592,6 +648,34 @@ func TestGardenerConfig_ToShootTemplate(t *testing.T) {
 	}
 }
 
+func TestGardenerConfig_ToShootTemplate_Extensions(t *testing.T) {
+	// given
+	gcpGardenerProvider, err := NewGCPGardenerConfig(fixGCPGardenerInput([]string{"fix-zone-1"}))
+	require.NoError(t, err)
+
+	config := fixGardenerConfig("gcp", gcpGardenerProvider)
+	config.Extensions = []Extension{
+		{Type: "shoot-oidc-service"},
+		{Type: "shoot-lakom-service", Disabled: util.BoolPtr(false), ProviderConfig: util.StringPtr(`{"kind":"LakomConfig"}`)},
+	}
+
+	// when
+	template, err := config.ToShootTemplate("gardener-namespace", "account", "sub-account", oidcConfig(), dnsConfig())
+
+	// then
+	require.NoError(t, err)
+	require.Len(t, template.Spec.Extensions, 5)
+	assert.Equal(t, ShootDNSServiceExtensionType, template.Spec.Extensions[0].Type)
+	assert.Equal(t, ShootCertServiceExtensionType, template.Spec.Extensions[1].Type)
+	assert.Equal(t, ShootNetworkingFilterExtensionType, template.Spec.Extensions[2].Type)
+	assert.Equal(t, gardener_types.Extension{Type: "shoot-oidc-service"}, template.Spec.Extensions[3])
+	assert.Equal(t, gardener_types.Extension{
+		Type:           "shoot-lakom-service",
+		Disabled:       util.BoolPtr(false),
+		ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"LakomConfig"}`)},
+	}, template.Spec.Extensions[4])
+}
+
 func TestEditShootConfig(t *testing.T) {
 	zones := []string{"fix-zone-1", "fix-zone-2"}
 
@@ -615,6 +699,7 @@ func TestEditShootConfig(t *testing.T) {
 				WithMaxUnavailable(1).
 				ToWorker()).
 		WithPSPAdmissionPluginDisabled().
+		WithControlPlaneFailureTolerance("zone").
 		ToShoot()
 
 	awsProviderConfig, err := NewAWSGardenerConfig(fixAWSGardenerInput())
@@ -705,6 +790,114 @@ func TestEditShootConfig(t *testing.T) {
 				return shoot
 			}(expectedShoot),
 		},
+		{description: "should update hibernation schedules",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{
+					{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 07 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: initialShoot.DeepCopy(),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Schedules: []gardener_types.HibernationSchedule{
+						{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 07 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+					},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove hibernation schedules",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.HibernationSchedules = []HibernationSchedule{}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled:   util.BoolPtr(false),
+					Schedules: []gardener_types.HibernationSchedule{{Start: util.StringPtr("00 20 * * *")}},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Hibernation = &gardener_types.Hibernation{
+					Enabled:   util.BoolPtr(false),
+					Schedules: []gardener_types.HibernationSchedule{},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should merge extensions without changing managed extensions",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.Extensions = []Extension{
+					{Type: "shoot-oidc-service", Disabled: util.BoolPtr(true)},
+					{Type: "shoot-lakom-service", ProviderConfig: util.StringPtr(`{"kind":"LakomConfig"}`)},
+					{Type: ShootDNSServiceExtensionType, Disabled: util.BoolPtr(true)},
+				}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = []gardener_types.Extension{
+					{Type: ShootDNSServiceExtensionType},
+					{Type: "shoot-oidc-service"},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = []gardener_types.Extension{
+					{Type: ShootDNSServiceExtensionType},
+					{Type: "shoot-oidc-service", Disabled: util.BoolPtr(true)},
+					{Type: "shoot-lakom-service", ProviderConfig: &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"LakomConfig"}`)}},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should remove extensions no longer set without removing managed extensions",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.Extensions = []Extension{{Type: "shoot-oidc-service"}}
+				config.RemovedExtensionTypes = []string{"shoot-lakom-service", ShootDNSServiceExtensionType}
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = []gardener_types.Extension{
+					{Type: ShootDNSServiceExtensionType},
+					{Type: "shoot-oidc-service"},
+					{Type: "shoot-lakom-service"},
+				}
+				return shoot
+			}(initialShoot),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.Extensions = []gardener_types.Extension{
+					{Type: ShootDNSServiceExtensionType},
+					{Type: "shoot-oidc-service"},
+				}
+				return shoot
+			}(expectedShoot),
+		},
+		{description: "should not change control plane when failure tolerance is empty",
+			provider: "gcp",
+			upgradeConfig: func(config GardenerConfig) GardenerConfig {
+				config.ControlPlaneFailureTolerance = nil
+				return config
+			}(fixGardenerConfig("gcp", gcpProviderConfig)),
+			initialShoot: initialShoot.DeepCopy(),
+			expectedShoot: func(s *gardener_types.Shoot) *gardener_types.Shoot {
+				shoot := s.DeepCopy()
+				shoot.Spec.ControlPlane = nil
+				return shoot
+			}(expectedShoot),
+		},
 	} {
 		t.Run(testCase.description, func(t *testing.T) {
 			// given
@@ -742,6 +935,109 @@ func TestEditShootConfig(t *testing.T) {
 	}
 }
 
+func TestOpenStackGardenerConfig(t *testing.T) {
+	zones := []string{"fix-zone-1"}
+
+	openStackProviderConfig, err := NewOpenStackGardenerConfig(fixOpenStackGardenerInput(zones))
+	require.NoError(t, err)
+
+	config := fixGardenerConfig("openstack", openStackProviderConfig)
+	config.AdditionalWorkerPools = []WorkerPool{fixWorkerPool("gpu-pool", "gpu-machine")}
+
+	shoot, appErr := config.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+	require.NoError(t, appErr)
+
+	t.Run("should convert networking, server group and load balancer classes to Shoot template", func(t *testing.T) {
+		// then
+		assert.Equal(t, "openstack", shoot.Spec.CloudProfileName)
+		assert.JSONEq(t,
+			`{"kind":"InfrastructureConfig","apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","floatingPoolName":"fip","floatingPoolSubnetName":"fip-subnet","networks":{"router":{"id":"router-id"},"workers":"10.10.10.10/255","id":"network-id","subnetId":"subnet-id","worker":""}}`,
+			string(shoot.Spec.Provider.InfrastructureConfig.Raw))
+		assert.JSONEq(t,
+			`{"kind":"ControlPlaneConfig","apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","loadBalancerProvider":"f5","loadBalancerClasses":[{"name":"internal","subnetID":"lb-subnet-id"}]}`,
+			string(shoot.Spec.Provider.ControlPlaneConfig.Raw))
+		require.Len(t, shoot.Spec.Provider.Workers, 2)
+		for _, worker := range shoot.Spec.Provider.Workers {
+			require.NotNil(t, worker.ProviderConfig)
+			assert.JSONEq(t,
+				`{"kind":"WorkerConfig","apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","serverGroup":{"policy":"soft-anti-affinity"}}`,
+				string(worker.ProviderConfig.Raw))
+		}
+	})
+
+	t.Run("should accept unchanged networking and server group", func(t *testing.T) {
+		// when
+		appErr := openStackProviderConfig.ValidateShootConfigChange(shoot.DeepCopy())
+
+		// then
+		require.NoError(t, appErr)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		modifyInput func(input *gqlschema.OpenStackProviderConfigInput)
+	}{
+		{description: "should reject floating pool change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) { input.FloatingPoolName = "other-fip" }},
+		{description: "should reject floating pool subnet change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) { input.FloatingPoolSubnetName = nil }},
+		{description: "should reject router change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) { input.RouterID = util.StringPtr("other-router") }},
+		{description: "should reject network change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) { input.NetworkID = util.StringPtr("other-network") }},
+		{description: "should reject subnet change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) { input.SubnetID = util.StringPtr("other-subnet") }},
+		{description: "should reject server group policy change",
+			modifyInput: func(input *gqlschema.OpenStackProviderConfigInput) {
+				input.ServerGroupPolicy = util.StringPtr("anti-affinity")
+			}},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			input := fixOpenStackGardenerInput(zones)
+			testCase.modifyInput(input)
+
+			changedProviderConfig, err := NewOpenStackGardenerConfig(input)
+			require.NoError(t, err)
+
+			// when
+			appErr := changedProviderConfig.ValidateShootConfigChange(shoot.DeepCopy())
+
+			// then
+			require.Error(t, appErr)
+			util.CheckErrorType(t, appErr, apperrors.CodeBadRequest)
+		})
+	}
+
+	t.Run("should update load balancer classes and configure server group of new workers", func(t *testing.T) {
+		// given
+		input := fixOpenStackGardenerInput(zones)
+		input.LoadBalancerClasses = []*gqlschema.OpenStackLoadBalancerClassInput{
+			{Name: "external", FloatingNetworkID: util.StringPtr("fip-network-id")},
+		}
+
+		changedProviderConfig, err := NewOpenStackGardenerConfig(input)
+		require.NoError(t, err)
+
+		changedConfig := fixGardenerConfig("openstack", changedProviderConfig)
+		changedConfig.AdditionalWorkerPools = []WorkerPool{fixWorkerPool("gpu-pool", "gpu-machine"), fixWorkerPool("new-pool", "machine")}
+
+		editedShoot := shoot.DeepCopy()
+
+		// when
+		appErr := changedProviderConfig.EditShootConfig(changedConfig, editedShoot)
+
+		// then
+		require.NoError(t, appErr)
+		assert.JSONEq(t,
+			`{"kind":"ControlPlaneConfig","apiVersion":"openstack.provider.extensions.gardener.cloud/v1alpha1","loadBalancerProvider":"f5","loadBalancerClasses":[{"name":"external","floatingNetworkID":"fip-network-id"}]}`,
+			string(editedShoot.Spec.Provider.ControlPlaneConfig.Raw))
+		assert.Equal(t, shoot.Spec.Provider.InfrastructureConfig, editedShoot.Spec.Provider.InfrastructureConfig)
+		require.Len(t, editedShoot.Spec.Provider.Workers, 3)
+		assert.Equal(t, shoot.Spec.Provider.Workers[0].ProviderConfig, editedShoot.Spec.Provider.Workers[2].ProviderConfig)
+	})
+}
+
 func fixGardenerConfig(provider string, providerCfg GardenerProviderConfig) GardenerConfig {
 	return GardenerConfig{
 		ID:                                  "",
@@ -796,6 +1092,23 @@ func fixGCPGardenerInput(zones []string) *gqlschema.GCPProviderConfigInput {
 	return &gqlschema.GCPProviderConfigInput{Zones: zones}
 }
 
+func fixOpenStackGardenerInput(zones []string) *gqlschema.OpenStackProviderConfigInput {
+	return &gqlschema.OpenStackProviderConfigInput{
+		Zones:                  zones,
+		FloatingPoolName:       "fip",
+		CloudProfileName:       "openstack",
+		LoadBalancerProvider:   "f5",
+		FloatingPoolSubnetName: util.StringPtr("fip-subnet"),
+		RouterID:               util.StringPtr("router-id"),
+		NetworkID:              util.StringPtr("network-id"),
+		SubnetID:               util.StringPtr("subnet-id"),
+		ServerGroupPolicy:      util.StringPtr("soft-anti-affinity"),
+		LoadBalancerClasses: []*gqlschema.OpenStackLoadBalancerClassInput{
+			{Name: "internal", SubnetID: util.StringPtr("lb-subnet-id")},
+		},
+	}
+}
+
 func fixAzureGardenerInput(zones []string, enableNAT *bool) *gqlschema.AzureProviderConfigInput {
 	return &gqlschema.AzureProviderConfigInput{VnetCidr: "10.10.11.11/255", Zones: zones, EnableNatGateway: enableNAT, IdleConnectionTimeoutMinutes: util.IntPtr(4)}
 }
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/alicloud"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/aws"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/azure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model/infrastructure/gcp"
@@ -13,11 +14,13 @@ import (
 const (
 	infrastructureConfigKind = "InfrastructureConfig"
 	controlPlaneConfigKind   = "ControlPlaneConfig"
+	workerConfigKind         = "WorkerConfig"
 
 	gcpAPIVersion       = "gcp.provider.extensions.gardener.cloud/v1alpha1"
 	azureAPIVersion     = "azure.provider.extensions.gardener.cloud/v1alpha1"
 	awsAPIVersion       = "aws.provider.extensions.gardener.cloud/v1alpha1"
 	openStackApiVersion = "openstack.provider.extensions.gardener.cloud/v1alpha1"
+	alicloudAPIVersion  = "alicloud.provider.extensions.gardener.cloud/v1alpha1"
 
 	defaultConnectionTimeOutMinutes = 4
 )
@@ -144,25 +147,100 @@ func NewAWSControlPlane() *aws.ControlPlaneConfig {
 	}
 }
 
-func NewOpenStackInfrastructure(floatingPoolName, workerCIDR string) *openstack.InfrastructureConfig {
-	return &openstack.InfrastructureConfig{
+func NewOpenStackInfrastructure(workerCIDR string, openStackConfig OpenStackGardenerConfig) *openstack.InfrastructureConfig {
+	infrastructureConfig := &openstack.InfrastructureConfig{
 		TypeMeta: v1.TypeMeta{
 			Kind:       infrastructureConfigKind,
 			APIVersion: openStackApiVersion,
 		},
-		FloatingPoolName: floatingPoolName,
+		FloatingPoolName:       openStackConfig.input.FloatingPoolName,
+		FloatingPoolSubnetName: openStackConfig.input.FloatingPoolSubnetName,
 		Networks: openstack.Networks{
-			Workers: workerCIDR,
+			Workers:  workerCIDR,
+			ID:       openStackConfig.input.NetworkID,
+			SubnetID: openStackConfig.input.SubnetID,
 		},
 	}
+
+	if util.NotNilOrEmpty(openStackConfig.input.RouterID) {
+		infrastructureConfig.Networks.Router = &openstack.Router{ID: *openStackConfig.input.RouterID}
+	}
+
+	return infrastructureConfig
 }
 
-func NewOpenStackControlPlane(loadBalancerProvider string) *openstack.ControlPlaneConfig {
+func NewOpenStackControlPlane(openStackConfig OpenStackGardenerConfig) *openstack.ControlPlaneConfig {
 	return &openstack.ControlPlaneConfig{
 		TypeMeta: v1.TypeMeta{
 			Kind:       controlPlaneConfigKind,
 			APIVersion: openStackApiVersion,
 		},
-		LoadBalancerProvider: loadBalancerProvider,
+		LoadBalancerProvider: openStackConfig.input.LoadBalancerProvider,
+		LoadBalancerClasses:  createOpenStackLoadBalancerClasses(openStackConfig.input.LoadBalancerClasses),
+	}
+}
+
+func createOpenStackLoadBalancerClasses(inputClasses []*gqlschema.OpenStackLoadBalancerClassInput) []openstack.LoadBalancerClass {
+	if len(inputClasses) == 0 {
+		return nil
+	}
+
+	classes := make([]openstack.LoadBalancerClass, 0, len(inputClasses))
+	for _, inputClass := range inputClasses {
+		classes = append(classes, openstack.LoadBalancerClass{
+			Name:              inputClass.Name,
+			FloatingSubnetID:  inputClass.FloatingSubnetID,
+			FloatingNetworkID: inputClass.FloatingNetworkID,
+			SubnetID:          inputClass.SubnetID,
+		})
+	}
+	return classes
+}
+
+func NewOpenStackWorkerConfig(serverGroupPolicy string) *openstack.WorkerConfig {
+	return &openstack.WorkerConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       workerConfigKind,
+			APIVersion: openStackApiVersion,
+		},
+		ServerGroup: &openstack.ServerGroup{
+			Policy: serverGroupPolicy,
+		},
+	}
+}
+
+func NewAlicloudInfrastructure(alicloudConfig AlicloudGardenerConfig) *alicloud.InfrastructureConfig {
+	return &alicloud.InfrastructureConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       infrastructureConfigKind,
+			APIVersion: alicloudAPIVersion,
+		},
+		Networks: alicloud.Networks{
+			Zones: createAlicloudZones(alicloudConfig.input.AlicloudZones),
+			VPC: alicloud.VPC{
+				CIDR: util.StringPtr(alicloudConfig.input.VpcCidr),
+			},
+		},
+	}
+}
+
+func createAlicloudZones(inputZones []*gqlschema.AlicloudZoneInput) []alicloud.Zone {
+	zones := make([]alicloud.Zone, 0, len(inputZones))
+
+	for _, inputZone := range inputZones {
+		zones = append(zones, alicloud.Zone{
+			Name:    inputZone.Name,
+			Workers: inputZone.WorkerCidr,
+		})
+	}
+	return zones
+}
+
+func NewAlicloudControlPlane() *alicloud.ControlPlaneConfig {
+	return &alicloud.ControlPlaneConfig{
+		TypeMeta: v1.TypeMeta{
+			Kind:       controlPlaneConfigKind,
+			APIVersion: alicloudAPIVersion,
+		},
 	}
 }
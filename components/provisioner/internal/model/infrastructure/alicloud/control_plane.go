@@ -0,0 +1,21 @@
+package alicloud
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// This types are copied from https://github.com/gardener/gardener-extension-provider-alicloud/blob/master/pkg/apis/alicloud/v1alpha1/types_controlplane.go
+
+// ControlPlaneConfig contains configuration settings for the control plane.
+type ControlPlaneConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// CloudControllerManager contains configuration settings for the cloud-controller-manager.
+	// +optional
+	CloudControllerManager *CloudControllerManagerConfig `json:"cloudControllerManager,omitempty"`
+}
+
+// CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
+type CloudControllerManagerConfig struct {
+	// FeatureGates contains information about enabled feature gates.
+	// +optional
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+}
@@ -0,0 +1,41 @@
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// This types are copied from https://github.com/gardener/gardener-extension-provider-alicloud/blob/master/pkg/apis/alicloud/v1alpha1/types_infrastructure.go
+
+// InfrastructureConfig infrastructure configuration resource
+type InfrastructureConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Networks is the network configuration (VPC, subnets, etc.)
+	Networks Networks `json:"networks"`
+}
+
+// Networks holds information about the Kubernetes and infrastructure networks.
+type Networks struct {
+	// VPC indicates whether to use an existing VPC or create a new one.
+	VPC VPC `json:"vpc"`
+	// Zones belonging to the same region
+	Zones []Zone `json:"zones"`
+}
+
+// Zone is a zone with a name and worker CIDR.
+type Zone struct {
+	// Name is the name of a zone.
+	Name string `json:"name"`
+	// Workers is the CIDR range used for the workers subnet.
+	Workers string `json:"workers"`
+}
+
+// VPC contains information about the VPC and some related resources.
+type VPC struct {
+	// ID is the VPC id.
+	// +optional
+	ID *string `json:"id,omitempty"`
+	// CIDR is the VPC CIDR.
+	// +optional
+	CIDR *string `json:"cidr,omitempty"`
+}
@@ -0,0 +1,18 @@
+package openstack
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// ServerGroup contains configuration data for the worker pool's server group.
+	// +optional
+	ServerGroup *ServerGroup `json:"serverGroup,omitempty"`
+}
+
+// ServerGroup contains configuration data for setting up a server group.
+type ServerGroup struct {
+	// Policy describes the kind of affinity policy for instances of the server group.
+	Policy string `json:"policy"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import "time"
+
+// RuntimeFilter narrows down the listed Runtimes. Empty fields are not applied.
+// State, OperationTypes and LastErrorReason are matched against the last operation of the Runtime.
+type RuntimeFilter struct {
+	Tenant          string
+	SubAccountID    string
+	Provider        string
+	Region          string
+	State           OperationState
+	OperationTypes  []OperationType
+	CreatedAfter    *time.Time
+	CreatedBefore   *time.Time
+	LastErrorReason string
+	IncludeDeleted  bool
+}
+
+// OperationFilter narrows down the listed operations. Empty fields are not applied.
+type OperationFilter struct {
+	RuntimeID       string
+	Tenant          string
+	SubAccountID    string
+	Provider        string
+	Region          string
+	State           OperationState
+	Types           []OperationType
+	StartedAfter    *time.Time
+	StartedBefore   *time.Time
+	LastErrorReason string
+}
+
+// PageCursor identifies the last item of the previous page. Items are listed from the newest one,
+// so the next page starts with the items created before the cursor.
+type PageCursor struct {
+	Timestamp time.Time `json:"timestamp"`
+	ID        string    `json:"id"`
+}
+
+type Page struct {
+	Limit int
+	After *PageCursor
+}
+
+type RuntimeSummary struct {
+	ID                string
+	Tenant            string
+	SubAccountID      *string
+	CreationTimestamp time.Time
+	Deleted           bool
+	ShootName         string `db:"name"`
+	Provider          string
+	Region            string
+	KubernetesVersion string
+	LastOperation     *Operation `db:"-"`
+}
@@ -0,0 +1,97 @@
+package model
+
+import (
+	"encoding/json"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+// providerRegistration binds a GardenerProviderConfig implementation to its GraphQL input and to the JSON it is stored as.
+type providerRegistration struct {
+	// discriminator is the JSON field present only in the stored configs of the provider.
+	discriminator string
+	// fallback marks the provider used for the stored configs not matching any discriminator.
+	fallback bool
+	// fromInput returns nil if the input does not contain the config of the provider.
+	fromInput func(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError)
+	fromJSON  func(jsonData string) (GardenerProviderConfig, error)
+}
+
+// providerRegistry lists the supported Gardener providers.
+// Every provider registers itself from its own file, next to its GardenerProviderConfig implementation,
+// so apart from its field in the GraphQL ProviderSpecificInput a new provider does not require changes outside of its file.
+var providerRegistry []providerRegistration
+
+// registerProvider is meant to be called from the init function of the provider file.
+func registerProvider(registration providerRegistration) {
+	providerRegistry = append(providerRegistry, registration)
+}
+
+// ProviderNetwork is a named network range of the provider config.
+type ProviderNetwork struct {
+	Name string
+	CIDR string
+}
+
+// ProviderNetworks describes the networks of the provider config validated against the networks of the cluster.
+type ProviderNetworks struct {
+	VPC ProviderNetwork
+	// ZoneWorkers are the ranges the nodes of every zone are created in.
+	ZoneWorkers []ProviderNetwork
+	// ZoneSubnets are all ranges of the zones, they must be within the VPC and must not overlap.
+	ZoneSubnets []ProviderNetwork
+	// NodesWithinVPC requires the workers CIDR of the cluster to be within the VPC.
+	NodesWithinVPC bool
+}
+
+// NetworkingProviderConfig is implemented by the provider configs defining their own networks.
+type NetworkingProviderConfig interface {
+	Networks() ProviderNetworks
+}
+
+func NewGardenerProviderConfigFromInput(input *gqlschema.ProviderSpecificInput) (GardenerProviderConfig, apperrors.AppError) {
+	for _, provider := range providerRegistry {
+		providerConfig, err := provider.fromInput(input)
+		if err != nil {
+			return nil, err
+		}
+		if providerConfig != nil {
+			return providerConfig, nil
+		}
+	}
+
+	return nil, apperrors.BadRequest("provider config not specified")
+}
+
+// NewGardenerProviderConfigFromJSON detects the provider of the stored config by the discriminator fields.
+func NewGardenerProviderConfigFromJSON(jsonData string) (GardenerProviderConfig, apperrors.AppError) {
+	var fields map[string]json.RawMessage
+	err := json.Unmarshal([]byte(jsonData), &fields)
+	if err != nil {
+		return nil, apperrors.BadRequest("json data does not match any of Gardener providers: %s", err.Error())
+	}
+
+	var matchingProvider *providerRegistration
+	for i, provider := range providerRegistry {
+		if provider.discriminator == "" {
+			if provider.fallback && matchingProvider == nil {
+				matchingProvider = &providerRegistry[i]
+			}
+			continue
+		}
+		if _, found := fields[provider.discriminator]; found {
+			matchingProvider = &providerRegistry[i]
+			break
+		}
+	}
+	if matchingProvider == nil {
+		return nil, apperrors.BadRequest("json data does not match any of Gardener providers")
+	}
+
+	providerConfig, err := matchingProvider.fromJSON(jsonData)
+	if err != nil {
+		return nil, apperrors.BadRequest("json data does not match any of Gardener providers: %s", err.Error())
+	}
+	return providerConfig, nil
+}
@@ -0,0 +1,59 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGardenerProviderConfigFromInput(t *testing.T) {
+	t.Run("should create provider config of the provider set in input", func(t *testing.T) {
+		// given
+		expectedProviderConfig, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+		require.NoError(t, err)
+
+		// when
+		providerConfig, err := NewGardenerProviderConfigFromInput(&gqlschema.ProviderSpecificInput{AlicloudConfig: fixAlicloudGardenerInput()})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedProviderConfig, providerConfig)
+	})
+
+	t.Run("should return error when no provider is set in input", func(t *testing.T) {
+		// when
+		_, err := NewGardenerProviderConfigFromInput(&gqlschema.ProviderSpecificInput{})
+
+		// then
+		require.Error(t, err)
+		util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+	})
+}
+
+func TestNewGardenerProviderConfigFromJSON(t *testing.T) {
+	t.Run("should detect provider by discriminator field", func(t *testing.T) {
+		// given
+		expectedProviderConfig, err := NewAlicloudGardenerConfig(fixAlicloudGardenerInput())
+		require.NoError(t, err)
+
+		// when
+		providerConfig, err := NewGardenerProviderConfigFromJSON(expectedProviderConfig.RawJSON())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, expectedProviderConfig.AsProviderSpecificConfig(), providerConfig.AsProviderSpecificConfig())
+	})
+
+	t.Run("should fall back to GCP when no discriminator field is present", func(t *testing.T) {
+		// when
+		providerConfig, err := NewGardenerProviderConfigFromJSON(`{"zones":["europe-west1-b"]}`)
+
+		// then
+		require.NoError(t, err)
+		assert.IsType(t, &GCPGardenerConfig{}, providerConfig)
+	})
+}
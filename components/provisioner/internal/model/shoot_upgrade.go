@@ -0,0 +1,10 @@
+package model
+
+// ShootUpgrade keeps the Gardener configuration of the cluster from before the Shoot upgrade operation,
+// so that the Shoot can be rolled back when the upgrade fails.
+type ShootUpgrade struct {
+	Id          string
+	OperationId string
+
+	PreUpgradeGardenerConfig GardenerConfig
+}
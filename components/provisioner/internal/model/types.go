@@ -10,6 +10,7 @@ const (
 	InProgress OperationState = "IN_PROGRESS"
 	Succeeded  OperationState = "SUCCEEDED"
 	Failed     OperationState = "FAILED"
+	Cancelled  OperationState = "CANCELLED"
 )
 
 type OperationType string
@@ -23,6 +24,7 @@ const (
 	DeprovisionNoInstall OperationType = "DEPROVISION_NO_INSTALL"
 	ReconnectRuntime     OperationType = "RECONNECT_RUNTIME"
 	Hibernate            OperationType = "HIBERNATE"
+	WakeUp               OperationType = "WAKE_UP"
 )
 
 type OperationStage string
@@ -48,6 +50,7 @@ const (
 	WaitingForShootNewVersion OperationStage = "WaitingForShootNewVersion"
 
 	WaitForHibernation OperationStage = "WaitForHibernation"
+	WaitForWakeUp      OperationStage = "WaitForWakeUp"
 
 	FinishedStage OperationStage = "Finished"
 )
@@ -85,6 +88,25 @@ type Operation struct {
 	Stage          OperationStage
 	LastTransition *time.Time
 	LastError
+	CancelRequested bool
+	// ResourcesReverted is set when the failure handler removed or rolled back the resources of the failed operation, so it cannot be retried
+	ResourcesReverted bool
+	// TraceContext holds the trace context of the request which started the operation, so its processing continues the trace
+	TraceContext string
+	StageHistory []OperationStageHistoryEntry
+	// Warnings are the results of the pre-flight checks of the Shoot upgrade
+	Warnings []UpgradeWarning
+}
+
+type OperationStageHistoryEntry struct {
+	ID             string
+	OperationID    string
+	Stage          OperationStage
+	Message        string
+	EventTimestamp time.Time
+	LastError
+	// Retries is the number of times the stage was run again after recoverable failures, the last of them is kept in LastError
+	Retries int
 }
 
 type RuntimeAgentConnectionStatus int
@@ -99,13 +121,29 @@ type RuntimeStatus struct {
 	LastOperationStatus     Operation
 	RuntimeConnectionStatus RuntimeAgentConnectionStatus
 	RuntimeConfiguration    Cluster
-	HibernationStatus       HibernationStatus
+	// HibernationStatus is not set when the Shoot of the Runtime cannot be fetched
+	HibernationStatus *HibernationStatus
 }
 
 type OperationsCount struct {
 	Count map[OperationType]int
 }
 
+// ReEncryptionProgress holds the numbers of records which are not encrypted with the active encryption key yet
+type ReEncryptionProgress struct {
+	Kubeconfigs    int
+	Administrators int
+}
+
+// ReEncryptionBatch is the result of re-encrypting a batch of records with the active encryption key
+type ReEncryptionBatch struct {
+	// LastID is the ID of the last processed record, the next batch starts after it
+	LastID      string
+	Processed   int
+	ReEncrypted int
+	Failed      int
+}
+
 type HibernationStatus struct {
 	Hibernated          bool
 	HibernationPossible bool
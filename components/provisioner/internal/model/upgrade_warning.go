@@ -0,0 +1,18 @@
+package model
+
+type UpgradeWarningCode string
+
+const (
+	KubernetesVersionDeprecated   UpgradeWarningCode = "KUBERNETES_VERSION_DEPRECATED"
+	KubernetesVersionExpiring     UpgradeWarningCode = "KUBERNETES_VERSION_EXPIRING"
+	MachineImageVersionDeprecated UpgradeWarningCode = "MACHINE_IMAGE_VERSION_DEPRECATED"
+	MachineImageVersionExpiring   UpgradeWarningCode = "MACHINE_IMAGE_VERSION_EXPIRING"
+	RemovedAPIInUse               UpgradeWarningCode = "REMOVED_API_IN_USE"
+	RemovedAPICheckSkipped        UpgradeWarningCode = "REMOVED_API_CHECK_SKIPPED"
+)
+
+// UpgradeWarning describes a problem found by the pre-flight checks of a Shoot upgrade which does not block the upgrade.
+type UpgradeWarning struct {
+	Code    UpgradeWarningCode `json:"code"`
+	Message string             `json:"message"`
+}
@@ -0,0 +1,26 @@
+package model
+
+import "time"
+
+// ExpiringVersion is a Kubernetes or machine image version which is expired or has an expiration date.
+type ExpiringVersion struct {
+	// Name of the machine image, empty for Kubernetes versions
+	Name    string
+	Version string
+	// ExpirationDate is nil if the version is no longer offered by the CloudProfile
+	ExpirationDate *time.Time
+	Expired        bool
+}
+
+type RuntimeVersionDrift struct {
+	RuntimeID         string
+	ShootName         string
+	KubernetesVersion *ExpiringVersion
+	MachineImages     []ExpiringVersion
+}
+
+type VersionDriftGroup struct {
+	Provider string
+	Region   string
+	Runtimes []RuntimeVersionDrift
+}
@@ -0,0 +1,233 @@
+package model
+
+import (
+	"fmt"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+)
+
+const PrimaryWorkerPoolName = "cpu-worker-0"
+
+// WorkerPool describes a worker pool created next to the primary one defined directly in the GardenerConfig.
+type WorkerPool struct {
+	Name                string            `json:"name"`
+	MachineType         string            `json:"machineType"`
+	MachineImage        *string           `json:"machineImage,omitempty"`
+	MachineImageVersion *string           `json:"machineImageVersion,omitempty"`
+	DiskType            *string           `json:"diskType,omitempty"`
+	VolumeSizeGB        *int              `json:"volumeSizeGB,omitempty"`
+	AutoScalerMin       int               `json:"autoScalerMin"`
+	AutoScalerMax       int               `json:"autoScalerMax"`
+	MaxSurge            int               `json:"maxSurge"`
+	MaxUnavailable      int               `json:"maxUnavailable"`
+	Zones               []string          `json:"zones,omitempty" db:"-"`
+	Labels              map[string]string `json:"labels,omitempty" db:"-"`
+	Taints              []Taint           `json:"taints,omitempty" db:"-"`
+	KubeletConfig       *KubeletConfig    `json:"kubeletConfig,omitempty" db:"-"`
+	CRIName             *string           `json:"criName,omitempty" db:"cri_name"`
+}
+
+type Taint struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// KubeletConfig holds the kubelet settings managed by the provisioner, the remaining kubelet settings of the Shoot are left untouched.
+type KubeletConfig struct {
+	MaxPods                 *int                `json:"maxPods,omitempty"`
+	EvictionHard            *EvictionThresholds `json:"evictionHard,omitempty"`
+	EvictionSoft            *EvictionThresholds `json:"evictionSoft,omitempty"`
+	EvictionSoftGracePeriod *EvictionThresholds `json:"evictionSoftGracePeriod,omitempty"`
+}
+
+// EvictionThresholds holds either eviction thresholds, such as "100Mi" or "5%", or the grace periods of the soft thresholds, such as "1m30s".
+type EvictionThresholds struct {
+	MemoryAvailable   *string `json:"memoryAvailable,omitempty"`
+	ImageFSAvailable  *string `json:"imageFSAvailable,omitempty"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree,omitempty"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable,omitempty"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree,omitempty"`
+}
+
+// additionalWorkers creates Shoot workers for the worker pools, pools without zones use the zones of the primary worker.
+func additionalWorkers(pools []WorkerPool, primaryZones []string) []gardener_types.Worker {
+	workers := make([]gardener_types.Worker, 0, len(pools))
+	for _, pool := range pools {
+		worker := gardener_types.Worker{Name: pool.Name}
+		pool.applyTo(&worker, primaryZones)
+		workers = append(workers, worker)
+	}
+	return workers
+}
+
+// updateAdditionalWorkers replaces all but the primary Shoot worker with the worker pools.
+// Workers already present in the Shoot are matched by name, so the settings not managed by the provisioner are preserved.
+func updateAdditionalWorkers(pools []WorkerPool, shoot *gardener_types.Shoot) {
+	primary := shoot.Spec.Provider.Workers[0]
+
+	existingWorkers := make(map[string]gardener_types.Worker)
+	for _, worker := range shoot.Spec.Provider.Workers[1:] {
+		existingWorkers[worker.Name] = worker
+	}
+
+	workers := []gardener_types.Worker{primary}
+	for _, pool := range pools {
+		worker, found := existingWorkers[pool.Name]
+		if !found {
+			worker = gardener_types.Worker{Name: pool.Name}
+		}
+		pool.applyTo(&worker, primary.Zones)
+		workers = append(workers, worker)
+	}
+
+	shoot.Spec.Provider.Workers = workers
+}
+
+func (p WorkerPool) applyTo(worker *gardener_types.Worker, primaryZones []string) {
+	worker.Machine.Type = p.MachineType
+	if util.NotNilOrEmpty(p.MachineImage) {
+		worker.Machine.Image = &gardener_types.ShootMachineImage{
+			Name:    *p.MachineImage,
+			Version: p.MachineImageVersion,
+		}
+	}
+
+	if p.DiskType != nil && p.VolumeSizeGB != nil {
+		worker.Volume = &gardener_types.Volume{
+			Type:       p.DiskType,
+			VolumeSize: fmt.Sprintf("%dGi", *p.VolumeSizeGB),
+		}
+	}
+
+	worker.Minimum = int32(p.AutoScalerMin)
+	worker.Maximum = int32(p.AutoScalerMax)
+	worker.MaxSurge = util.IntOrStringPtr(intstr.FromInt(p.MaxSurge))
+	worker.MaxUnavailable = util.IntOrStringPtr(intstr.FromInt(p.MaxUnavailable))
+
+	if len(p.Zones) > 0 {
+		worker.Zones = p.Zones
+	} else if len(worker.Zones) == 0 {
+		worker.Zones = primaryZones
+	}
+
+	worker.Labels = p.Labels
+	worker.Taints = gardenerTaints(p.Taints)
+	applyKubeletConfig(p.KubeletConfig, worker)
+	applyCRI(p.CRIName, worker)
+}
+
+// applyKubeletConfig sets only the kubelet settings which are configured, the other settings of the worker are kept.
+func applyKubeletConfig(config *KubeletConfig, worker *gardener_types.Worker) {
+	if config == nil {
+		return
+	}
+
+	if worker.Kubernetes == nil {
+		worker.Kubernetes = &gardener_types.WorkerKubernetes{}
+	}
+	if worker.Kubernetes.Kubelet == nil {
+		worker.Kubernetes.Kubelet = &gardener_types.KubeletConfig{}
+	}
+
+	kubelet := worker.Kubernetes.Kubelet
+	if config.MaxPods != nil {
+		kubelet.MaxPods = util.Int32Ptr(int32(*config.MaxPods))
+	}
+	if config.EvictionHard != nil {
+		kubelet.EvictionHard = config.EvictionHard.applyEviction(kubelet.EvictionHard)
+	}
+	if config.EvictionSoft != nil {
+		kubelet.EvictionSoft = config.EvictionSoft.applyEviction(kubelet.EvictionSoft)
+	}
+	if config.EvictionSoftGracePeriod != nil {
+		kubelet.EvictionSoftGracePeriod = config.EvictionSoftGracePeriod.applyEvictionGracePeriod(kubelet.EvictionSoftGracePeriod)
+	}
+}
+
+func applyCRI(criName *string, worker *gardener_types.Worker) {
+	if util.IsNilOrEmpty(criName) {
+		return
+	}
+
+	if worker.CRI == nil {
+		worker.CRI = &gardener_types.CRI{}
+	}
+	worker.CRI.Name = gardener_types.CRIName(*criName)
+}
+
+// applyEviction sets the configured thresholds on the eviction settings of the worker, keeping the other thresholds.
+func (t *EvictionThresholds) applyEviction(eviction *gardener_types.KubeletConfigEviction) *gardener_types.KubeletConfigEviction {
+	if eviction == nil {
+		eviction = &gardener_types.KubeletConfigEviction{}
+	}
+
+	setThresholdIfNotNil(&eviction.MemoryAvailable, t.MemoryAvailable)
+	setThresholdIfNotNil(&eviction.ImageFSAvailable, t.ImageFSAvailable)
+	setThresholdIfNotNil(&eviction.ImageFSInodesFree, t.ImageFSInodesFree)
+	setThresholdIfNotNil(&eviction.NodeFSAvailable, t.NodeFSAvailable)
+	setThresholdIfNotNil(&eviction.NodeFSInodesFree, t.NodeFSInodesFree)
+
+	return eviction
+}
+
+// applyEvictionGracePeriod skips durations that cannot be parsed, they are rejected while validating the input.
+func (t *EvictionThresholds) applyEvictionGracePeriod(gracePeriod *gardener_types.KubeletConfigEvictionSoftGracePeriod) *gardener_types.KubeletConfigEvictionSoftGracePeriod {
+	if gracePeriod == nil {
+		gracePeriod = &gardener_types.KubeletConfigEvictionSoftGracePeriod{}
+	}
+
+	setDurationIfNotNil(&gracePeriod.MemoryAvailable, gardenerDuration(t.MemoryAvailable))
+	setDurationIfNotNil(&gracePeriod.ImageFSAvailable, gardenerDuration(t.ImageFSAvailable))
+	setDurationIfNotNil(&gracePeriod.ImageFSInodesFree, gardenerDuration(t.ImageFSInodesFree))
+	setDurationIfNotNil(&gracePeriod.NodeFSAvailable, gardenerDuration(t.NodeFSAvailable))
+	setDurationIfNotNil(&gracePeriod.NodeFSInodesFree, gardenerDuration(t.NodeFSInodesFree))
+
+	return gracePeriod
+}
+
+func setThresholdIfNotNil(target **string, value *string) {
+	if value != nil {
+		*target = value
+	}
+}
+
+func setDurationIfNotNil(target **v1.Duration, value *v1.Duration) {
+	if value != nil {
+		*target = value
+	}
+}
+
+func gardenerDuration(duration *string) *v1.Duration {
+	if duration == nil {
+		return nil
+	}
+
+	parsed, err := time.ParseDuration(*duration)
+	if err != nil {
+		return nil
+	}
+	return &v1.Duration{Duration: parsed}
+}
+
+func gardenerTaints(taints []Taint) []corev1.Taint {
+	if len(taints) == 0 {
+		return nil
+	}
+
+	gardenerTaints := make([]corev1.Taint, 0, len(taints))
+	for _, taint := range taints {
+		gardenerTaints = append(gardenerTaints, corev1.Taint{
+			Key:    taint.Key,
+			Value:  taint.Value,
+			Effect: corev1.TaintEffect(taint.Effect),
+		})
+	}
+	return gardenerTaints
+}
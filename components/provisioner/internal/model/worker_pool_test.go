@@ -0,0 +1,308 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+)
+
+func TestGardenerConfig_ToShootTemplate_AdditionalWorkerPools(t *testing.T) {
+	// given
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	config := fixGardenerConfig("gcp", gcpProviderConfig)
+	config.AdditionalWorkerPools = []WorkerPool{
+		fixWorkerPool("gpu-pool", "gpu-machine"),
+		func(pool WorkerPool) WorkerPool {
+			pool.Zones = []string{"fix-zone-2"}
+			pool.MachineImage = nil
+			pool.DiskType = nil
+			pool.VolumeSizeGB = nil
+			pool.Labels = nil
+			pool.Taints = nil
+			return pool
+		}(fixWorkerPool("zonal-pool", "machine")),
+	}
+
+	// when
+	shoot, appErr := config.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+	// then
+	require.NoError(t, appErr)
+	assert.Equal(t, []gardener_types.Worker{
+		fixWorker(zones),
+		fixAdditionalWorker("gpu-pool", "gpu-machine", zones),
+		{
+			Name:           "zonal-pool",
+			Machine:        gardener_types.Machine{Type: "machine"},
+			Minimum:        1,
+			Maximum:        4,
+			MaxSurge:       util.IntOrStringPtr(intstr.FromInt(1)),
+			MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(0)),
+			Zones:          []string{"fix-zone-2"},
+		},
+	}, shoot.Spec.Provider.Workers)
+}
+
+func TestEditShootConfig_AdditionalWorkerPools(t *testing.T) {
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	workerProviderConfig := &apimachineryRuntime.RawExtension{Raw: []byte(`{"kind":"WorkerConfig"}`)}
+
+	initialShoot := testkit.NewTestShoot("shoot").
+		WithAutoUpdate(false, false).
+		WithWorkers(
+			testkit.NewTestWorker(PrimaryWorkerPoolName).WithZones(zones...).ToWorker(),
+			func(worker gardener_types.Worker) gardener_types.Worker {
+				worker.ProviderConfig = workerProviderConfig
+				return worker
+			}(fixAdditionalWorker("gpu-pool", "small-gpu-machine", zones)),
+			fixAdditionalWorker("removed-pool", "machine", zones),
+		).
+		ToShoot()
+
+	t.Run("should add, change and remove additional worker pools", func(t *testing.T) {
+		// given
+		shoot := initialShoot.DeepCopy()
+
+		config := fixGardenerConfig("gcp", gcpProviderConfig)
+		config.AdditionalWorkerPools = []WorkerPool{
+			fixWorkerPool("gpu-pool", "gpu-machine"),
+			fixWorkerPool("new-pool", "machine"),
+		}
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(config, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		require.Len(t, shoot.Spec.Provider.Workers, 3)
+		assert.Equal(t, PrimaryWorkerPoolName, shoot.Spec.Provider.Workers[0].Name)
+		assert.Equal(t, func(worker gardener_types.Worker) gardener_types.Worker {
+			worker.ProviderConfig = workerProviderConfig
+			return worker
+		}(fixAdditionalWorker("gpu-pool", "gpu-machine", zones)), shoot.Spec.Provider.Workers[1])
+		assert.Equal(t, fixAdditionalWorker("new-pool", "machine", zones), shoot.Spec.Provider.Workers[2])
+	})
+
+	t.Run("should remove all additional worker pools", func(t *testing.T) {
+		// given
+		shoot := initialShoot.DeepCopy()
+
+		config := fixGardenerConfig("gcp", gcpProviderConfig)
+		config.AdditionalWorkerPools = []WorkerPool{}
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(config, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		require.Len(t, shoot.Spec.Provider.Workers, 1)
+		assert.Equal(t, PrimaryWorkerPoolName, shoot.Spec.Provider.Workers[0].Name)
+	})
+
+	t.Run("should keep additional worker pools when they are not configured", func(t *testing.T) {
+		// given
+		shoot := initialShoot.DeepCopy()
+
+		config := fixGardenerConfig("gcp", gcpProviderConfig)
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(config, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, initialShoot.Spec.Provider.Workers[1:], shoot.Spec.Provider.Workers[1:])
+	})
+}
+
+func fixWorkerPool(name, machineType string) WorkerPool {
+	return WorkerPool{
+		Name:           name,
+		MachineType:    machineType,
+		MachineImage:   util.StringPtr("gardenlinux"),
+		DiskType:       util.StringPtr("SSD"),
+		VolumeSizeGB:   util.IntPtr(50),
+		AutoScalerMin:  1,
+		AutoScalerMax:  4,
+		MaxSurge:       1,
+		MaxUnavailable: 0,
+		Labels:         map[string]string{"workload": "gpu"},
+		Taints:         []Taint{{Key: "nvidia.com/gpu", Value: "present", Effect: "NoSchedule"}},
+	}
+}
+
+func fixAdditionalWorker(name, machineType string, zones []string) gardener_types.Worker {
+	return gardener_types.Worker{
+		Name: name,
+		Machine: gardener_types.Machine{
+			Type:  machineType,
+			Image: &gardener_types.ShootMachineImage{Name: "gardenlinux"},
+		},
+		Volume: &gardener_types.Volume{
+			Type:       util.StringPtr("SSD"),
+			VolumeSize: "50Gi",
+		},
+		Minimum:        1,
+		Maximum:        4,
+		MaxSurge:       util.IntOrStringPtr(intstr.FromInt(1)),
+		MaxUnavailable: util.IntOrStringPtr(intstr.FromInt(0)),
+		Zones:          zones,
+		Labels:         map[string]string{"workload": "gpu"},
+		Taints:         []corev1.Taint{{Key: "nvidia.com/gpu", Value: "present", Effect: corev1.TaintEffectNoSchedule}},
+	}
+}
+
+func TestGardenerConfig_WorkerSettings(t *testing.T) {
+	zones := []string{"fix-zone-1", "fix-zone-2"}
+
+	gcpProviderConfig, err := NewGCPGardenerConfig(fixGCPGardenerInput(zones))
+	require.NoError(t, err)
+
+	config := fixGardenerConfig("gcp", gcpProviderConfig)
+	config.WorkerLabels = map[string]string{"workload": "general"}
+	config.WorkerTaints = []Taint{{Key: "dedicated", Value: "kyma", Effect: "NoExecute"}}
+	config.KubeletConfig = fixKubeletConfig()
+	config.CRIName = util.StringPtr("containerd")
+
+	t.Run("should configure primary worker in Shoot template", func(t *testing.T) {
+		// when
+		shoot, appErr := config.ToShootTemplate("gardener-namespace", "account", "sub-account", nil, nil)
+
+		// then
+		require.NoError(t, appErr)
+		require.Len(t, shoot.Spec.Provider.Workers, 1)
+		worker := shoot.Spec.Provider.Workers[0]
+		assert.Equal(t, map[string]string{"workload": "general"}, worker.Labels)
+		assert.Equal(t, []corev1.Taint{{Key: "dedicated", Value: "kyma", Effect: corev1.TaintEffectNoExecute}}, worker.Taints)
+		assert.Equal(t, &gardener_types.CRI{Name: gardener_types.CRINameContainerD}, worker.CRI)
+		assert.Equal(t, fixGardenerKubeletConfig(), worker.Kubernetes.Kubelet)
+	})
+
+	t.Run("should update primary worker and keep kubelet settings not managed by provisioner", func(t *testing.T) {
+		// given
+		primaryWorker := testkit.NewTestWorker(PrimaryWorkerPoolName).WithZones(zones...).ToWorker()
+		primaryWorker.Labels = map[string]string{"manual": "label"}
+		primaryWorker.Kubernetes = &gardener_types.WorkerKubernetes{
+			Kubelet: &gardener_types.KubeletConfig{
+				MaxPods:                   util.Int32Ptr(50),
+				SerializeImagePulls:       util.BoolPtr(false),
+				EvictionMaxPodGracePeriod: util.Int32Ptr(60),
+			},
+		}
+		shoot := testkit.NewTestShoot("shoot").
+			WithAutoUpdate(false, false).
+			WithWorkers(primaryWorker).
+			ToShoot()
+
+		expectedKubelet := fixGardenerKubeletConfig()
+		expectedKubelet.SerializeImagePulls = util.BoolPtr(false)
+		expectedKubelet.EvictionMaxPodGracePeriod = util.Int32Ptr(60)
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(config, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		worker := shoot.Spec.Provider.Workers[0]
+		assert.Equal(t, map[string]string{"workload": "general"}, worker.Labels)
+		assert.Equal(t, []corev1.Taint{{Key: "dedicated", Value: "kyma", Effect: corev1.TaintEffectNoExecute}}, worker.Taints)
+		assert.Equal(t, &gardener_types.CRI{Name: gardener_types.CRINameContainerD}, worker.CRI)
+		assert.Equal(t, expectedKubelet, worker.Kubernetes.Kubelet)
+	})
+
+	t.Run("should update only configured kubelet settings", func(t *testing.T) {
+		// given
+		primaryWorker := testkit.NewTestWorker(PrimaryWorkerPoolName).WithZones(zones...).ToWorker()
+		primaryWorker.Kubernetes = &gardener_types.WorkerKubernetes{
+			Kubelet: &gardener_types.KubeletConfig{
+				MaxPods: util.Int32Ptr(50),
+				EvictionHard: &gardener_types.KubeletConfigEviction{
+					MemoryAvailable: util.StringPtr("1Gi"),
+					NodeFSAvailable: util.StringPtr("10%"),
+				},
+				EvictionSoft: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.StringPtr("2Gi")},
+			},
+		}
+		shoot := testkit.NewTestShoot("shoot").
+			WithAutoUpdate(false, false).
+			WithWorkers(primaryWorker).
+			ToShoot()
+
+		partialConfig := fixGardenerConfig("gcp", gcpProviderConfig)
+		partialConfig.KubeletConfig = &KubeletConfig{
+			EvictionHard: &EvictionThresholds{MemoryAvailable: util.StringPtr("100Mi")},
+		}
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(partialConfig, shoot)
+
+		// then
+		require.NoError(t, appErr)
+		assert.Equal(t, &gardener_types.KubeletConfig{
+			MaxPods: util.Int32Ptr(50),
+			EvictionHard: &gardener_types.KubeletConfigEviction{
+				MemoryAvailable: util.StringPtr("100Mi"),
+				NodeFSAvailable: util.StringPtr("10%"),
+			},
+			EvictionSoft: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.StringPtr("2Gi")},
+		}, shoot.Spec.Provider.Workers[0].Kubernetes.Kubelet)
+	})
+
+	t.Run("should keep worker settings of Shoot when they are not configured", func(t *testing.T) {
+		// given
+		primaryWorker := testkit.NewTestWorker(PrimaryWorkerPoolName).WithZones(zones...).ToWorker()
+		primaryWorker.Labels = map[string]string{"manual": "label"}
+		primaryWorker.CRI = &gardener_types.CRI{Name: gardener_types.CRINameDocker}
+		shoot := testkit.NewTestShoot("shoot").
+			WithAutoUpdate(false, false).
+			WithWorkers(primaryWorker).
+			ToShoot()
+
+		// when
+		appErr := gcpProviderConfig.EditShootConfig(fixGardenerConfig("gcp", gcpProviderConfig), shoot)
+
+		// then
+		require.NoError(t, appErr)
+		worker := shoot.Spec.Provider.Workers[0]
+		assert.Equal(t, map[string]string{"manual": "label"}, worker.Labels)
+		assert.Equal(t, &gardener_types.CRI{Name: gardener_types.CRINameDocker}, worker.CRI)
+		assert.Nil(t, worker.Kubernetes)
+	})
+}
+
+func fixKubeletConfig() *KubeletConfig {
+	return &KubeletConfig{
+		MaxPods:                 util.IntPtr(110),
+		EvictionHard:            &EvictionThresholds{MemoryAvailable: util.StringPtr("100Mi")},
+		EvictionSoft:            &EvictionThresholds{MemoryAvailable: util.StringPtr("200Mi")},
+		EvictionSoftGracePeriod: &EvictionThresholds{MemoryAvailable: util.StringPtr("1m30s")},
+	}
+}
+
+func fixGardenerKubeletConfig() *gardener_types.KubeletConfig {
+	return &gardener_types.KubeletConfig{
+		MaxPods:      util.Int32Ptr(110),
+		EvictionHard: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.StringPtr("100Mi")},
+		EvictionSoft: &gardener_types.KubeletConfigEviction{MemoryAvailable: util.StringPtr("200Mi")},
+		EvictionSoftGracePeriod: &gardener_types.KubeletConfigEvictionSoftGracePeriod{
+			MemoryAvailable: &v1.Duration{Duration: 90 * time.Second},
+		},
+	}
+}
@@ -0,0 +1,144 @@
+package operations
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// BackoffPolicy describes delays between consecutive attempts of running a step which failed with a recoverable error.
+type BackoffPolicy struct {
+	Initial time.Duration `envconfig:"default=2s"`
+	Max     time.Duration `envconfig:"default=1m"`
+	Factor  float64       `envconfig:"default=2"`
+	Jitter  float64       `envconfig:"default=0.1"`
+}
+
+// Delay returns the delay before the given attempt, starting from 1.
+func (p BackoffPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	initial := p.Initial
+	if initial <= 0 {
+		initial = defaultDelay
+	}
+
+	factor := p.Factor
+	if factor < 1 {
+		factor = 1
+	}
+
+	delay := time.Duration(float64(initial) * math.Pow(factor, float64(attempt-1)))
+	if p.Max > 0 && (delay > p.Max || delay <= 0) {
+		delay = p.Max
+	}
+
+	if p.Jitter > 0 {
+		delay = wait.Jitter(delay, p.Jitter)
+	}
+
+	return delay
+}
+
+// MaxDelay returns the longest delay the policy can return, including the jitter.
+func (p BackoffPolicy) MaxDelay() time.Duration {
+	if p.Jitter <= 0 {
+		return p.Max
+	}
+	return time.Duration(float64(p.Max) * (1 + p.Jitter))
+}
+
+// Validate checks that the delays are bounded and shorter than the operation lease.
+// The lease is not renewed while the operation waits for the next attempt, so a longer delay
+// lets another instance reclaim the operation.
+func (p BackoffPolicy) Validate(leaseDuration time.Duration) error {
+	if p.Max <= 0 {
+		return fmt.Errorf("maximum delay must be greater than zero")
+	}
+	if p.MaxDelay() >= leaseDuration {
+		return fmt.Errorf("maximum delay %s with jitter must be shorter than the operation lease duration %s", p.MaxDelay(), leaseDuration)
+	}
+	return nil
+}
+
+// BackoffStep is implemented by steps declaring their own backoff policy.
+// Steps which do not implement it are requeued with the default delay.
+type BackoffStep interface {
+	Step
+	Backoff() BackoffPolicy
+}
+
+type backoffStep struct {
+	Step
+	policy BackoffPolicy
+}
+
+// WithBackoff declares the backoff policy for the step.
+func WithBackoff(step Step, policy BackoffPolicy) BackoffStep {
+	return backoffStep{
+		Step:   step,
+		policy: policy,
+	}
+}
+
+func (s backoffStep) Backoff() BackoffPolicy {
+	return s.policy
+}
+
+var defaultBackoff = BackoffPolicy{
+	Initial: defaultDelay,
+	Max:     defaultDelay,
+	Factor:  1,
+}
+
+func backoffFor(step Step) BackoffPolicy {
+	if backoffStep, ok := step.(BackoffStep); ok {
+		return backoffStep.Backoff()
+	}
+
+	return defaultBackoff
+}
+
+type stepAttempts struct {
+	stage model.OperationStage
+	count int
+}
+
+// attemptsTracker counts consecutive failed attempts of running the current stage of an operation.
+type attemptsTracker struct {
+	mutex    sync.Mutex
+	attempts map[string]stepAttempts
+}
+
+func newAttemptsTracker() *attemptsTracker {
+	return &attemptsTracker{
+		attempts: map[string]stepAttempts{},
+	}
+}
+
+func (t *attemptsTracker) failed(operationID string, stage model.OperationStage) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	attempts := t.attempts[operationID]
+	if attempts.stage != stage {
+		attempts = stepAttempts{stage: stage}
+	}
+	attempts.count++
+	t.attempts[operationID] = attempts
+
+	return attempts.count
+}
+
+func (t *attemptsTracker) reset(operationID string) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	delete(t.attempts, operationID)
+}
@@ -0,0 +1,102 @@
+package operations
+
+import (
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoffPolicy_Delay(t *testing.T) {
+	for _, testCase := range []struct {
+		description string
+		policy      BackoffPolicy
+		attempt     int
+		expected    time.Duration
+	}{
+		{
+			description: "should return initial delay for first attempt",
+			policy:      BackoffPolicy{Initial: 2 * time.Second, Max: time.Minute, Factor: 2},
+			attempt:     1,
+			expected:    2 * time.Second,
+		},
+		{
+			description: "should grow delay exponentially",
+			policy:      BackoffPolicy{Initial: 2 * time.Second, Max: time.Minute, Factor: 2},
+			attempt:     4,
+			expected:    16 * time.Second,
+		},
+		{
+			description: "should cap delay at max",
+			policy:      BackoffPolicy{Initial: 2 * time.Second, Max: time.Minute, Factor: 2},
+			attempt:     100,
+			expected:    time.Minute,
+		},
+		{
+			description: "should use default delay when initial delay not set",
+			policy:      BackoffPolicy{},
+			attempt:     3,
+			expected:    defaultDelay,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// when
+			delay := testCase.policy.Delay(testCase.attempt)
+
+			// then
+			assert.Equal(t, testCase.expected, delay)
+		})
+	}
+
+	t.Run("should add jitter to delay", func(t *testing.T) {
+		// given
+		policy := BackoffPolicy{Initial: 10 * time.Second, Max: time.Minute, Factor: 2, Jitter: 0.5}
+
+		// when
+		delay := policy.Delay(1)
+
+		// then
+		assert.GreaterOrEqual(t, delay, 10*time.Second)
+		assert.LessOrEqual(t, delay, 15*time.Second)
+	})
+}
+
+func TestBackoffPolicy_Validate(t *testing.T) {
+	t.Run("should accept delays shorter than the lease", func(t *testing.T) {
+		policy := BackoffPolicy{Initial: 2 * time.Second, Max: time.Minute, Factor: 2, Jitter: 0.1}
+
+		assert.NoError(t, policy.Validate(2*time.Minute))
+	})
+
+	t.Run("should reject maximum delay which with jitter outlasts the lease", func(t *testing.T) {
+		policy := BackoffPolicy{Initial: 2 * time.Second, Max: 2 * time.Minute, Factor: 2, Jitter: 0.1}
+
+		assert.Equal(t, 132*time.Second, policy.MaxDelay())
+		assert.Error(t, policy.Validate(2*time.Minute))
+	})
+
+	t.Run("should reject unbounded delay", func(t *testing.T) {
+		policy := BackoffPolicy{Initial: 2 * time.Second, Factor: 2}
+
+		assert.Error(t, policy.Validate(2*time.Minute))
+	})
+}
+
+func TestAttemptsTracker(t *testing.T) {
+	// given
+	tracker := newAttemptsTracker()
+
+	// when
+	first := tracker.failed(operationId, model.WaitingForClusterCreation)
+	second := tracker.failed(operationId, model.WaitingForClusterCreation)
+	afterStageChange := tracker.failed(operationId, model.CreatingBindingsForOperators)
+	tracker.reset(operationId)
+	afterReset := tracker.failed(operationId, model.CreatingBindingsForOperators)
+
+	// then
+	assert.Equal(t, 1, first)
+	assert.Equal(t, 2, second)
+	assert.Equal(t, 1, afterStageChange)
+	assert.Equal(t, 1, afterReset)
+}
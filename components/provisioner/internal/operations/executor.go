@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -10,9 +11,14 @@ import (
 	retry "github.com/avast/retry-go"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/director"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/metrics"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 const (
@@ -22,12 +28,20 @@ const (
 
 var ErrKubeconfigNil = errors.New("cluster kubeconfig is nil")
 
+// ErrOperationLeaseLost is returned when the operation lease was taken over by another provisioner instance.
+var ErrOperationLeaseLost = errors.New("operation lease taken over by another instance")
+
+// ErrOperationCancelled is returned when the operation cancellation was requested while processing it.
+var ErrOperationCancelled = errors.New("operation cancelled")
+
 func NewExecutor(
 	session dbsession.ReadWriteSession,
 	operation model.OperationType,
 	stages map[model.OperationStage]Step,
 	failureHandler FailureHandler,
-	directorClient director.DirectorClient) *Executor {
+	directorClient director.DirectorClient,
+	leaseOwner string,
+	leaseDuration time.Duration) *Executor {
 
 	return &Executor{
 		dbSession:      session,
@@ -36,6 +50,10 @@ func NewExecutor(
 		failureHandler: failureHandler,
 		log:            logrus.WithFields(logrus.Fields{"Component": "Executor", "OperationType": operation}),
 		directorClient: directorClient,
+		leaseOwner:     leaseOwner,
+		leaseDuration:  leaseDuration,
+		uuidGenerator:  uuid.NewUUIDGenerator(),
+		attempts:       newAttemptsTracker(),
 	}
 }
 
@@ -45,6 +63,10 @@ type Executor struct {
 	operation      model.OperationType
 	failureHandler FailureHandler
 	directorClient director.DirectorClient
+	leaseOwner     string
+	leaseDuration  time.Duration
+	uuidGenerator  uuid.UUIDGenerator
+	attempts       *attemptsTracker
 
 	log logrus.FieldLogger
 }
@@ -76,20 +98,68 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	log = log.WithField("ShootName", cluster.ClusterConfig.Name)
 
 	if operation.Type == e.operation {
-		requeue, delay, err := e.process(operation, cluster, log)
-		e.updateOperationLastError(log, operation.ID, err)
+		acquired, leaseErr := e.dbSession.AcquireOperationLease(operation.ID, e.leaseOwner, time.Now(), e.leaseDuration)
+		if leaseErr != nil {
+			log.Errorf("error acquiring operation lease: %s", leaseErr.Error())
+			return ProcessingResult{Requeue: true, Delay: defaultDelay}
+		}
+		if !acquired {
+			log.Infof("Operation is processed by another provisioner instance")
+			return ProcessingResult{Requeue: false}
+		}
+
+		if operation.CancelRequested {
+			e.cancelOperation(log, operation)
+			return ProcessingResult{Requeue: false}
+		}
+
+		ctx := tracing.Extract(context.Background(), operation.TraceContext)
+
+		requeue, delay, err := e.process(ctx, &operation, cluster, log)
+		if err == nil || !isRecoverable(err) {
+			e.attempts.reset(operation.ID)
+		}
+		if errors.Is(err, ErrOperationLeaseLost) {
+			log.Warnf("Stopped processing operation: %s", err.Error())
+			return ProcessingResult{Requeue: false}
+		}
+		if errors.Is(err, ErrOperationCancelled) {
+			e.cancelOperation(log, operation)
+			return ProcessingResult{Requeue: false}
+		}
+
+		lastErr := e.updateOperationLastError(log, operation.ID, err)
 		if err != nil {
 			nonRecoverable := NonRecoverableError{}
 			if errors.As(err, &nonRecoverable) {
 				log.Errorf("unrecoverable error occurred while processing operation: %s", err.Error())
-				e.handleOperationFailure(operation, cluster, log)
-				e.updateOperationStatus(log, operation.ID, nonRecoverable.Error(), model.Failed, time.Now())
-				e.setRuntimeStatusCondition(log, cluster.ID, cluster.Tenant)
+				// Recoverable errors are counted as retries of the stage, so that waiting stages do not grow the history
+				e.insertStageHistoryEntry(log, model.OperationStageHistoryEntry{
+					OperationID:    operation.ID,
+					Stage:          operation.Stage,
+					Message:        "Stage failed",
+					EventTimestamp: time.Now(),
+					LastError:      lastErr,
+				})
+				e.handleOperationFailure(ctx, operation, cluster, log)
+				e.finishOperation(log, operation, nonRecoverable.Error(), model.Failed, lastErr)
+				e.setRuntimeStatusCondition(ctx, log, cluster.ID, cluster.Tenant)
+				e.releaseOperationLease(log, operation.ID)
 
 				return ProcessingResult{Requeue: false}
 			}
 
-			return ProcessingResult{Requeue: true, Delay: defaultDelay}
+			e.recordStageRetry(log, operation.ID, operation.Stage, lastErr)
+
+			attempt := e.attempts.failed(operation.ID, operation.Stage)
+			retryDelay := backoffFor(e.stages[operation.Stage]).Delay(attempt)
+			log.Infof("Retrying stage %s in %s, attempt %d", operation.Stage, retryDelay, attempt)
+
+			return ProcessingResult{Requeue: true, Delay: retryDelay}
+		}
+
+		if !requeue {
+			e.releaseOperationLease(log, operation.ID)
 		}
 
 		return ProcessingResult{Requeue: requeue, Delay: delay}
@@ -101,8 +171,7 @@ func (e *Executor) Execute(operationID string) ProcessingResult {
 	}
 }
 
-func (e *Executor) process(operation model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
-
+func (e *Executor) process(ctx context.Context, operation *model.Operation, cluster model.Cluster, logger logrus.FieldLogger) (bool, time.Duration, error) {
 	step, found := e.stages[operation.Stage]
 	if !found {
 		msg := fmt.Sprintf("error: step %s not found in installation stages", operation.Stage)
@@ -113,12 +182,18 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		log := logger.WithField("Stage", step.Name())
 		log.Infof("Starting processing")
 
-		if e.timeoutReached(operation, step.TimeLimit()) {
+		if e.timeoutReached(*operation, step.TimeLimit()) {
 			log.Errorf("Timeout reached for operation")
 			return false, 0, NewNonRecoverableError(apperrors.Internal("error: timeout while processing operation").SetReason(apperrors.ErrProvisionerTimeout))
 		}
 
-		result, err := step.Run(cluster, operation, log)
+		stepCtx, span := tracing.StartSpan(ctx, fmt.Sprintf("Step %s", step.Name()),
+			attribute.String("operation.id", operation.ID),
+			attribute.String("operation.type", string(operation.Type)),
+			attribute.String("runtime.id", cluster.ID),
+		)
+		result, err := step.Run(stepCtx, cluster, *operation, log)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			if errors.Is(err, ErrKubeconfigNil) {
 				log.Warnf("Warning, the %s", err)
@@ -130,12 +205,15 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 
 		if result.Stage == model.FinishedStage {
 			log.Infof("Finished processing operation")
-			e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, time.Now())
+			transitionTime := time.Now()
+			metrics.StageFinished(operation.Type, step.Name(), transitionTime.Sub(stageStartTime(*operation)))
+			e.updateOperationStage(log, operation.ID, "Provisioning steps finished", model.FinishedStage, transitionTime)
 			break
 		}
 
 		if result.Stage != step.Name() {
 			transitionTime := time.Now()
+			metrics.StageFinished(operation.Type, step.Name(), transitionTime.Sub(stageStartTime(*operation)))
 			e.updateOperationStage(log, operation.ID, fmt.Sprintf("Operation in progress. Stage %s", result.Stage), result.Stage, transitionTime)
 			step = e.stages[result.Stage]
 			operation.Stage = result.Stage
@@ -146,33 +224,109 @@ func (e *Executor) process(operation model.Operation, cluster model.Cluster, log
 		if result.Delay > 0 {
 			return true, result.Delay, nil
 		}
+
+		if err := e.renewOperationLease(operation.ID); err != nil {
+			return false, 0, err
+		}
+
+		if err := e.verifyOperationNotCancelled(operation.ID); err != nil {
+			return false, 0, err
+		}
 	}
 
 	logger.Infof("Setting operation to succeeded")
-	e.updateOperationStatus(logger, operation.ID, "Operation succeeded", model.Succeeded, time.Now())
+	e.finishOperation(logger, *operation, "Operation succeeded", model.Succeeded, model.LastError{})
 
 	return false, 0, nil
 }
 
 func (e *Executor) timeoutReached(operation model.Operation, timeout time.Duration) bool {
 
-	lastTimestamp := operation.StartTimestamp
+	timePassed := time.Now().Sub(stageStartTime(operation))
+
+	return timePassed > timeout
+}
+
+func stageStartTime(operation model.Operation) time.Time {
 	if operation.LastTransition != nil {
-		lastTimestamp = *operation.LastTransition
+		return *operation.LastTransition
 	}
 
-	timePassed := time.Now().Sub(lastTimestamp)
+	return operation.StartTimestamp
+}
 
-	return timePassed > timeout
+func isRecoverable(err error) bool {
+	nonRecoverable := NonRecoverableError{}
+
+	return !errors.As(err, &nonRecoverable) && !errors.Is(err, ErrOperationLeaseLost) && !errors.Is(err, ErrOperationCancelled)
+}
+
+func (e *Executor) renewOperationLease(id string) error {
+	renewed, err := e.dbSession.AcquireOperationLease(id, e.leaseOwner, time.Now(), e.leaseDuration)
+	if err != nil {
+		return err
+	}
+	if !renewed {
+		return ErrOperationLeaseLost
+	}
+
+	return nil
 }
 
-func (e *Executor) handleOperationFailure(operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
+func (e *Executor) verifyOperationNotCancelled(id string) error {
+	operation, err := e.dbSession.GetOperation(id)
+	if err != nil {
+		return err
+	}
+	if operation.CancelRequested {
+		return ErrOperationCancelled
+	}
+
+	return nil
+}
+
+func (e *Executor) cancelOperation(log logrus.FieldLogger, operation model.Operation) {
+	log.Infof("Operation cancellation requested, stopping processing")
+	e.finishOperation(log, operation, "Operation cancelled", model.Cancelled, model.LastError{})
+	e.releaseOperationLease(log, operation.ID)
+}
+
+func (e *Executor) releaseOperationLease(log logrus.FieldLogger, id string) {
 	err := retry.Do(func() error {
-		return e.failureHandler.HandleFailure(operation, cluster)
+		return e.dbSession.ReleaseOperationLease(id, e.leaseOwner)
+	}, retry.Attempts(5))
+	if err != nil {
+		log.Infof("Cannot release operation lease: %s", err.Error())
+	}
+}
+
+func (e *Executor) handleOperationFailure(ctx context.Context, operation model.Operation, cluster model.Cluster, log logrus.FieldLogger) {
+	var reverted bool
+	err := retry.Do(func() error {
+		handled, err := e.failureHandler.HandleFailure(ctx, operation, cluster)
+		reverted = reverted || handled
+		return err
 	}, retry.Attempts(5))
 	if err != nil {
 		log.Errorf("error handling operation failure operation failure: %s", err.Error())
 	}
+
+	if !reverted {
+		return
+	}
+
+	err = retry.Do(func() error {
+		return e.dbSession.MarkOperationResourcesReverted(operation.ID)
+	}, retry.Attempts(5))
+	if err != nil {
+		log.Errorf("Cannot mark resources of operation as reverted: %s", err.Error())
+	}
+}
+
+func (e *Executor) finishOperation(log logrus.FieldLogger, operation model.Operation, message string, state model.OperationState, lastErr model.LastError) {
+	finishedAt := time.Now()
+	e.updateOperationStatus(log, operation.ID, message, state, finishedAt)
+	metrics.OperationFinished(operation, state, lastErr, finishedAt)
 }
 
 func (e *Executor) updateOperationStatus(log logrus.FieldLogger, id, message string, state model.OperationState, t time.Time) {
@@ -184,7 +338,7 @@ func (e *Executor) updateOperationStatus(log logrus.FieldLogger, id, message str
 	}
 }
 
-func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, runErr error) {
+func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, runErr error) model.LastError {
 	var lastErr model.LastError
 
 	if runErr != nil {
@@ -203,11 +357,13 @@ func (e *Executor) updateOperationLastError(log logrus.FieldLogger, id string, r
 	if err != nil {
 		log.Infof("Cannot set operation last error to %v: %s", lastErr, err.Error())
 	}
+
+	return lastErr
 }
 
-func (e *Executor) setRuntimeStatusCondition(log logrus.FieldLogger, id, tenant string) {
+func (e *Executor) setRuntimeStatusCondition(ctx context.Context, log logrus.FieldLogger, id, tenant string) {
 	err := retry.Do(func() error {
-		return e.directorClient.SetRuntimeStatusCondition(id, graphql.RuntimeStatusConditionFailed, tenant)
+		return e.directorClient.SetRuntimeStatusCondition(ctx, id, graphql.RuntimeStatusConditionFailed, tenant)
 	}, retry.Attempts(5), retry.Delay(backOffDirectorDelay), retry.DelayType(retry.BackOffDelay))
 	if err != nil {
 		log.Infof("Cannot set runtime %s status condition: %s", graphql.RuntimeStatusConditionFailed.String(), err.Error())
@@ -221,4 +377,44 @@ func (e *Executor) updateOperationStage(log logrus.FieldLogger, id, message stri
 	if err != nil {
 		log.Infof("Cannot modify operation stage to %s: %s", stage, err.Error())
 	}
+
+	e.insertStageHistoryEntry(log, model.OperationStageHistoryEntry{
+		OperationID:    id,
+		Stage:          stage,
+		Message:        message,
+		EventTimestamp: t,
+	})
+}
+
+func (e *Executor) recordStageRetry(log logrus.FieldLogger, id string, stage model.OperationStage, lastErr model.LastError) {
+	err := retry.Do(func() error {
+		dberr := e.dbSession.RecordStageRetry(id, stage, lastErr)
+		if dberr != nil && dberr.Code() == dberrors.CodeNotFound {
+			// Operations started before the stage history was recorded have no entry of the stage to count the retry in
+			return e.dbSession.InsertOperationStageHistoryEntry(model.OperationStageHistoryEntry{
+				ID:             e.uuidGenerator.New(),
+				OperationID:    id,
+				Stage:          stage,
+				Message:        "Stage retried",
+				EventTimestamp: time.Now(),
+				LastError:      lastErr,
+				Retries:        1,
+			})
+		}
+		return dberr
+	}, retry.Attempts(5))
+	if err != nil {
+		log.Infof("Cannot record retry of %s stage in operation history: %s", stage, err.Error())
+	}
+}
+
+func (e *Executor) insertStageHistoryEntry(log logrus.FieldLogger, entry model.OperationStageHistoryEntry) {
+	entry.ID = e.uuidGenerator.New()
+
+	err := retry.Do(func() error {
+		return e.dbSession.InsertOperationStageHistoryEntry(entry)
+	}, retry.Attempts(5))
+	if err != nil {
+		log.Infof("Cannot record %s stage in operation history: %s", entry.Stage, err.Error())
+	}
 }
@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"context"
 	"fmt"
 	"testing"
 	"time"
@@ -14,16 +15,23 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 const (
-	operationId = "operation-id"
-	clusterId   = "cluster-id"
+	operationId   = "operation-id"
+	clusterId     = "cluster-id"
+	leaseOwner    = "provisioner-0"
+	leaseDuration = 2 * time.Minute
 )
 
 func TestStagesExecutor_Execute(t *testing.T) {
@@ -48,10 +56,13 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
 		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
 			Return(nil)
 		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
 			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
 		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
 
 		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 10*time.Second, 10*time.Second)
@@ -62,7 +73,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
 
 		// when
 		result := executor.Execute(operationId)
@@ -70,15 +81,104 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		// then
 		assert.Equal(t, false, result.Requeue)
 		assert.True(t, mockStage.called)
+		dbSession.AssertCalled(t, "ReleaseOperationLease", operationId, leaseOwner)
 	})
 
-	t.Run("should requeue operation if error occurred", func(t *testing.T) {
+	t.Run("should continue the trace of the request which started the operation", func(t *testing.T) {
+		// given
+		_, err := tracing.Setup(tracing.Config{})
+		require.NoError(t, err)
+		exporter := tracetest.NewInMemoryExporter()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+		requestCtx, requestSpan := tracing.StartSpan(context.Background(), "request")
+		requestSpan.End()
+
+		tracedOperation := operation
+		tracedOperation.TraceContext = tracing.Inject(requestCtx)
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(tracedOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
+		dbSession.On("TransitionOperation", operationId, "Provisioning steps finished", model.FinishedStage, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation succeeded", model.Succeeded, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "", "", "").Return(nil)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second),
+		}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), &directorMocks.DirectorClient{}, leaseOwner, leaseDuration)
+
+		// when
+		executor.Execute(operationId)
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		stepSpan := spans[1]
+		assert.Equal(t, fmt.Sprintf("Step %s", model.WaitingForInstallation), stepSpan.Name)
+		assert.Equal(t, requestSpan.SpanContext().TraceID(), stepSpan.SpanContext.TraceID())
+		assert.Equal(t, requestSpan.SpanContext().SpanID(), stepSpan.Parent.SpanID())
+	})
+
+	t.Run("should record stage transitions and count recoverable failures as retries in stage history", func(t *testing.T) {
+		// given
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("TransitionOperation", operationId, fmt.Sprintf("Operation in progress. Stage %s", model.ConnectRuntimeAgent), model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.MatchedBy(func(entry model.OperationStageHistoryEntry) bool {
+			return entry.ID != "" && entry.OperationID == operationId && entry.Stage == model.ConnectRuntimeAgent && entry.ErrMessage == ""
+		})).Return(nil).Once()
+		dbSession.On("RecordStageRetry", operationId, model.ConnectRuntimeAgent, model.LastError{
+			ErrMessage: runErr.Error(),
+			Reason:     string(apperrors.ErrProvisionerInternal),
+			Component:  string(apperrors.ErrProvisioner),
+		}).Return(nil).Once()
+
+		firstStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 10*time.Second)
+		secondStage := NewErrorStep(model.ConnectRuntimeAgent, runErr, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: firstStage,
+			model.ConnectRuntimeAgent:    secondStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.True(t, result.Requeue)
+		assert.True(t, secondStage.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should requeue operation if error occurred and record the retry in new entry when stage has none", func(t *testing.T) {
 		// given
 		runErr := fmt.Errorf("error")
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
 		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("RecordStageRetry", operationId, model.WaitingForInstallation, mock.AnythingOfType("model.LastError")).Return(dberrors.NotFound("not found")).Once()
+		dbSession.On("InsertOperationStageHistoryEntry", mock.MatchedBy(func(entry model.OperationStageHistoryEntry) bool {
+			return entry.Stage == model.WaitingForInstallation && entry.Retries == 1 && entry.ErrMessage == runErr.Error()
+		})).Return(nil).Once()
 
 		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, time.Second*10)
 
@@ -88,7 +188,7 @@ func TestStagesExecutor_Execute(t *testing.T) {
 
 		directorClient := &directorMocks.DirectorClient{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
 
 		// when
 		result := executor.Execute(operationId)
@@ -96,6 +196,197 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		// then
 		assert.Equal(t, true, result.Requeue)
 		assert.True(t, mockStage.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "ReleaseOperationLease", operationId, leaseOwner)
+	})
+
+	t.Run("should not process operation leased by another instance", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(false, nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.False(t, mockStage.called)
+	})
+
+	t.Run("should requeue operation if failed to acquire lease", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(false, dberrors.Internal("error"))
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.True(t, result.Requeue)
+		assert.False(t, mockStage.called)
+	})
+
+	t.Run("should set operation as cancelled when cancellation was requested", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.CancelRequested = true
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation cancelled", model.Cancelled, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
+
+		mockStage := NewMockStep(model.WaitingForInstallation, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+		failureHandler := MockFailureHandler{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.False(t, mockStage.called)
+		assert.False(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should cancel operation at the next stage boundary", func(t *testing.T) {
+		// given
+		cancelledOperation := operation
+		cancelledOperation.CancelRequested = true
+
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil).Once()
+		dbSession.On("GetOperation", operationId).Return(cancelledOperation, nil).Once()
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
+		dbSession.On("TransitionOperation", operationId, fmt.Sprintf("Operation in progress. Stage %s", model.ConnectRuntimeAgent), model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "Operation cancelled", model.Cancelled, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
+
+		firstStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 10*time.Second)
+		secondStage := NewMockStep(model.ConnectRuntimeAgent, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: firstStage,
+			model.ConnectRuntimeAgent:    secondStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.True(t, firstStage.called)
+		assert.False(t, secondStage.called)
+		dbSession.AssertExpectations(t)
+		dbSession.AssertNotCalled(t, "UpdateOperationLastError", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should stop processing operation when lease was taken over", func(t *testing.T) {
+		// given
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil).Once()
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(false, nil).Once()
+		dbSession.On("TransitionOperation", operationId, fmt.Sprintf("Operation in progress. Stage %s", model.ConnectRuntimeAgent), model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
+			Return(nil)
+
+		firstStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 10*time.Second)
+		secondStage := NewMockStep(model.ConnectRuntimeAgent, model.FinishedStage, 0, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: firstStage,
+			model.ConnectRuntimeAgent:    secondStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.True(t, firstStage.called)
+		assert.False(t, secondStage.called)
+		dbSession.AssertNotCalled(t, "UpdateOperationLastError", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should requeue operation with growing delay according to step backoff policy", func(t *testing.T) {
+		// given
+		runErr := fmt.Errorf("error")
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("UpdateOperationLastError", operationId, runErr.Error(), string(apperrors.ErrProvisionerInternal), string(apperrors.ErrProvisioner)).Return(nil)
+		dbSession.On("RecordStageRetry", operationId, model.WaitingForInstallation, mock.AnythingOfType("model.LastError")).Return(nil)
+
+		mockStage := NewErrorStep(model.WaitingForInstallation, runErr, time.Second*10)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: WithBackoff(mockStage, BackoffPolicy{Initial: 5 * time.Second, Max: 12 * time.Second, Factor: 2}),
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, failure.NewNoopFailureHandler(), directorClient, leaseOwner, leaseDuration)
+
+		// when
+		firstResult := executor.Execute(operationId)
+		secondResult := executor.Execute(operationId)
+		thirdResult := executor.Execute(operationId)
+
+		// then
+		assert.True(t, firstResult.Requeue)
+		assert.Equal(t, 5*time.Second, firstResult.Delay)
+		assert.Equal(t, 10*time.Second, secondResult.Delay)
+		assert.Equal(t, 12*time.Second, thirdResult.Delay)
 	})
 
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred", func(t *testing.T) {
@@ -104,8 +395,13 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.MatchedBy(func(entry model.OperationStageHistoryEntry) bool {
+			return entry.OperationID == operationId && entry.Stage == operation.Stage && entry.Reason == "ERR_INFRA_QUOTA_EXCEEDED"
+		})).Return(nil).Once()
 		dbSession.On("UpdateOperationState", operationId, "something, gardener error", model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
 		dbSession.On("UpdateOperationLastError", operationId, "something, gardener error", "ERR_INFRA_QUOTA_EXCEEDED", string(apperrors.ErrGardener)).Return(nil)
 
 		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, 10*time.Second)
@@ -115,11 +411,11 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		}
 
 		directorClient := &directorMocks.DirectorClient{}
-		directorClient.On("SetRuntimeStatusCondition", clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
+		directorClient.On("SetRuntimeStatusCondition", mock.Anything, clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, leaseOwner, leaseDuration)
 
 		// when
 		result := executor.Execute(operationId)
@@ -128,6 +424,43 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		assert.Equal(t, false, result.Requeue)
 		assert.True(t, mockStage.called)
 		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
+	})
+
+	t.Run("should mark resources of operation as reverted when failure handler reverted them", func(t *testing.T) {
+		// given
+		runErr := NewNonRecoverableError(apperrors.Internal("error"))
+		dbSession := &mocks.ReadWriteSession{}
+		dbSession.On("GetOperation", operationId).Return(operation, nil)
+		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
+		dbSession.On("UpdateOperationState", operationId, "error", model.Failed, mock.AnythingOfType("time.Time")).
+			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
+		dbSession.On("UpdateOperationLastError", operationId, "error", mock.AnythingOfType("string"), mock.AnythingOfType("string")).Return(nil)
+		dbSession.On("MarkOperationResourcesReverted", operationId).Return(nil).Once()
+
+		mockStage := NewErrorStep(model.WaitingForClusterCreation, runErr, 10*time.Second)
+
+		installationStages := map[model.OperationStage]Step{
+			model.WaitingForInstallation: mockStage,
+		}
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("SetRuntimeStatusCondition", mock.Anything, clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
+
+		failureHandler := MockFailureHandler{reverted: true}
+
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, leaseOwner, leaseDuration)
+
+		// when
+		result := executor.Execute(operationId)
+
+		// then
+		assert.False(t, result.Requeue)
+		assert.True(t, failureHandler.called)
+		dbSession.AssertExpectations(t)
 	})
 
 	t.Run("should not requeue operation and run failure handler if NonRecoverable error occurred but failed to update Director", func(t *testing.T) {
@@ -136,8 +469,11 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
 		dbSession.On("UpdateOperationState", operationId, "kyma installation: error", model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
 		dbSession.On("UpdateOperationLastError", operationId, "kyma installation: error", "istio", string(apperrors.ErrKymaInstaller)).Return(nil)
 
 		mockStage := NewErrorStep(model.StartingInstallation, runErr, 10*time.Second)
@@ -147,11 +483,11 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		}
 
 		directorClient := &directorMocks.DirectorClient{}
-		directorClient.On("SetRuntimeStatusCondition", clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(apperrors.Internal("error"))
+		directorClient.On("SetRuntimeStatusCondition", mock.Anything, clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(apperrors.Internal("error"))
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, leaseOwner, leaseDuration)
 
 		// when
 		result := executor.Execute(operationId)
@@ -167,10 +503,13 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		dbSession := &mocks.ReadWriteSession{}
 		dbSession.On("GetOperation", operationId).Return(operation, nil)
 		dbSession.On("GetCluster", clusterId).Return(cluster, nil)
+		dbSession.On("AcquireOperationLease", operationId, leaseOwner, mock.AnythingOfType("time.Time"), leaseDuration).Return(true, nil)
+		dbSession.On("InsertOperationStageHistoryEntry", mock.AnythingOfType("model.OperationStageHistoryEntry")).Return(nil)
 		dbSession.On("TransitionOperation", operationId, "Operation in progress", model.ConnectRuntimeAgent, mock.AnythingOfType("time.Time")).
 			Return(nil)
 		dbSession.On("UpdateOperationState", operationId, "error: timeout while processing operation", model.Failed, mock.AnythingOfType("time.Time")).
 			Return(nil)
+		dbSession.On("ReleaseOperationLease", operationId, leaseOwner).Return(nil)
 		dbSession.On("UpdateOperationLastError", operationId, "error: timeout while processing operation", string(apperrors.ErrProvisionerTimeout), string(apperrors.ErrProvisioner)).Return(nil)
 
 		mockStage := NewMockStep(model.WaitingForInstallation, model.ConnectRuntimeAgent, 0, 0*time.Second)
@@ -180,11 +519,11 @@ func TestStagesExecutor_Execute(t *testing.T) {
 		}
 
 		directorClient := &directorMocks.DirectorClient{}
-		directorClient.On("SetRuntimeStatusCondition", clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
+		directorClient.On("SetRuntimeStatusCondition", mock.Anything, clusterId, graphql.RuntimeStatusConditionFailed, mock.AnythingOfType("string")).Return(nil)
 
 		failureHandler := MockFailureHandler{}
 
-		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient)
+		executor := NewExecutor(dbSession, model.Provision, installationStages, &failureHandler, directorClient, leaseOwner, leaseDuration)
 
 		// when
 		result := executor.Execute(operationId)
@@ -227,7 +566,7 @@ func (m mockStep) Name() model.OperationStage {
 	return m.name
 }
 
-func (m *mockStep) Run(cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error) {
+func (m *mockStep) Run(_ context.Context, cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error) {
 
 	m.called = true
 
@@ -246,12 +585,13 @@ func (m mockStep) TimeLimit() time.Duration {
 }
 
 type MockFailureHandler struct {
-	called bool
+	called   bool
+	reverted bool
 }
 
-func (m *MockFailureHandler) HandleFailure(operation model.Operation, cluster model.Cluster) error {
+func (m *MockFailureHandler) HandleFailure(_ context.Context, operation model.Operation, cluster model.Cluster) (bool, error) {
 	m.called = true
-	return nil
+	return m.reverted, nil
 }
 
 func TestConvertToAppError(t *testing.T) {
@@ -0,0 +1,48 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
+
+// ShootUpgrader is an autogenerated mock type for the ShootUpgrader type
+type ShootUpgrader struct {
+	mock.Mock
+}
+
+// UpgradeCluster provides a mock function with given fields: ctx, clusterID, upgradeConfig, operationID
+func (_m *ShootUpgrader) UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, upgradeConfig, operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig, string) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, upgradeConfig, operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// NewShootUpgrader creates a new instance of ShootUpgrader. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewShootUpgrader(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ShootUpgrader {
+	mock := &ShootUpgrader{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,6 +1,10 @@
 package failure
 
-import "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+import (
+	"context"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+)
 
 type NoopFailureHandler struct {
 }
@@ -9,6 +13,6 @@ func NewNoopFailureHandler() *NoopFailureHandler {
 	return &NoopFailureHandler{}
 }
 
-func (u NoopFailureHandler) HandleFailure(operation model.Operation, cluster model.Cluster) error {
-	return nil
+func (u NoopFailureHandler) HandleFailure(_ context.Context, operation model.Operation, cluster model.Cluster) (bool, error) {
+	return false, nil
 }
@@ -0,0 +1,111 @@
+package failure
+
+import (
+	"context"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/director"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const confirmDeletionAnnotation = "confirmation.gardener.cloud/deletion"
+
+//go:generate mockery --name=GardenerClient
+type GardenerClient interface {
+	Get(ctx context.Context, name string, options metav1.GetOptions) (*gardener_types.Shoot, error)
+	Update(ctx context.Context, shoot *gardener_types.Shoot, options metav1.UpdateOptions) (*gardener_types.Shoot, error)
+	Delete(ctx context.Context, name string, options metav1.DeleteOptions) error
+}
+
+// ProvisioningFailureHandler removes the resources created for the Runtime whose provisioning failed:
+// the Shoot cluster and the Runtime registered in Director.
+// When keepResources is set the resources are left untouched, so that the failure can be investigated.
+type ProvisioningFailureHandler struct {
+	gardenerClient GardenerClient
+	directorClient director.DirectorClient
+	keepResources  bool
+}
+
+func NewProvisioningFailureHandler(gardenerClient GardenerClient, directorClient director.DirectorClient, keepResources bool) *ProvisioningFailureHandler {
+	return &ProvisioningFailureHandler{
+		gardenerClient: gardenerClient,
+		directorClient: directorClient,
+		keepResources:  keepResources,
+	}
+}
+
+func (h ProvisioningFailureHandler) HandleFailure(ctx context.Context, operation model.Operation, cluster model.Cluster) (bool, error) {
+	log := logrus.WithFields(logrus.Fields{"OperationId": operation.ID, "RuntimeId": cluster.ID})
+
+	if h.keepResources {
+		log.Infof("Keeping resources of Runtime which failed to provision")
+		return false, nil
+	}
+
+	log.Infof("Removing resources of Runtime which failed to provision")
+
+	err := h.deleteShoot(ctx, cluster.ClusterConfig.Name)
+	if err != nil {
+		return false, errors.Wrap(err, "error deleting Shoot")
+	}
+
+	// The Shoot is being deleted, so the provisioning cannot be retried even if the Runtime is still registered in Director
+	err = h.unregisterRuntime(ctx, cluster)
+	if err != nil {
+		return true, errors.Wrap(err, "error deleting Runtime from Director")
+	}
+
+	return true, nil
+}
+
+func (h ProvisioningFailureHandler) deleteShoot(ctx context.Context, name string) error {
+	shoot, err := h.gardenerClient.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil
+		}
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+	}
+
+	if shoot.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if shoot.Annotations[confirmDeletionAnnotation] != "true" {
+		if shoot.Annotations == nil {
+			shoot.Annotations = map[string]string{}
+		}
+		shoot.Annotations[confirmDeletionAnnotation] = "true"
+
+		_, err = h.gardenerClient.Update(ctx, shoot, metav1.UpdateOptions{})
+		if err != nil {
+			return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+		}
+	}
+
+	err = h.gardenerClient.Delete(ctx, name, metav1.DeleteOptions{})
+	if err != nil && !k8serrors.IsNotFound(err) {
+		return util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
+	}
+
+	return nil
+}
+
+func (h ProvisioningFailureHandler) unregisterRuntime(ctx context.Context, cluster model.Cluster) error {
+	exists, err := h.directorClient.RuntimeExists(ctx, cluster.ID, cluster.Tenant)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return nil
+	}
+
+	return h.directorClient.DeleteRuntime(ctx, cluster.ID, cluster.Tenant)
+}
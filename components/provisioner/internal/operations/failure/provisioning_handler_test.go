@@ -0,0 +1,120 @@
+package failure
+
+import (
+	"context"
+	"testing"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	directorMocks "github.com/kyma-project/control-plane/components/provisioner/internal/director/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	gardenerMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	runtimeID   = "runtimeID"
+	tenant      = "tenant"
+	clusterName = "shoot"
+	operationID = "operationID"
+)
+
+func TestProvisioningFailureHandler_HandleFailure(t *testing.T) {
+
+	operation := model.Operation{ID: operationID, Type: model.Provision}
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	confirmedDeletion := func(shoot *gardener_types.Shoot) bool {
+		return shoot.Annotations[confirmDeletionAnnotation] == "true"
+	}
+
+	t.Run("should delete Shoot and unregister Runtime", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(&gardener_types.Shoot{ObjectMeta: metav1.ObjectMeta{Name: clusterName}}, nil)
+		gardenerClient.On("Update", context.Background(), mock.MatchedBy(confirmedDeletion), mock.Anything).Return(&gardener_types.Shoot{}, nil)
+		gardenerClient.On("Delete", context.Background(), clusterName, mock.Anything).Return(nil)
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+		directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
+
+		handler := NewProvisioningFailureHandler(gardenerClient, directorClient, false)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		gardenerClient.AssertExpectations(t)
+		directorClient.AssertExpectations(t)
+	})
+
+	t.Run("should skip removed resources", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, k8serrors.NewNotFound(schema.GroupResource{}, clusterName))
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(false, nil)
+
+		handler := NewProvisioningFailureHandler(gardenerClient, directorClient, false)
+
+		// when
+		_, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		gardenerClient.AssertExpectations(t)
+		directorClient.AssertExpectations(t)
+		directorClient.AssertNotCalled(t, "DeleteRuntime", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should keep resources when configured", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+		directorClient := &directorMocks.DirectorClient{}
+
+		handler := NewProvisioningFailureHandler(gardenerClient, directorClient, true)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, reverted)
+		gardenerClient.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything, mock.Anything)
+		directorClient.AssertNotCalled(t, "DeleteRuntime", mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error when failed to unregister Runtime", func(t *testing.T) {
+		// given
+		gardenerClient := &gardenerMocks.GardenerClient{}
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(nil, k8serrors.NewNotFound(schema.GroupResource{}, clusterName))
+
+		directorClient := &directorMocks.DirectorClient{}
+		directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+		directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(apperrors.Internal("error"))
+
+		handler := NewProvisioningFailureHandler(gardenerClient, directorClient, false)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.Error(t, err)
+		assert.True(t, reverted)
+	})
+}
@@ -0,0 +1,116 @@
+package failure
+
+import (
+	"context"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+//go:generate mockery --name=ShootUpgrader
+type ShootUpgrader interface {
+	UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig, operationID string) apperrors.AppError
+}
+
+// ShootUpgradeFailureHandler rolls the Shoot back to the Gardener config recorded before the failed upgrade.
+// The rolled back operation cannot be retried, as its upgrade config is no longer applied.
+type ShootUpgradeFailureHandler struct {
+	dbsFactory    dbsession.Factory
+	shootUpgrader ShootUpgrader
+}
+
+func NewShootUpgradeFailureHandler(dbsFactory dbsession.Factory, shootUpgrader ShootUpgrader) *ShootUpgradeFailureHandler {
+	return &ShootUpgradeFailureHandler{
+		dbsFactory:    dbsFactory,
+		shootUpgrader: shootUpgrader,
+	}
+}
+
+func (h ShootUpgradeFailureHandler) HandleFailure(ctx context.Context, operation model.Operation, cluster model.Cluster) (bool, error) {
+	log := logrus.WithFields(logrus.Fields{"OperationId": operation.ID, "RuntimeId": cluster.ID})
+
+	session := h.dbsFactory.NewReadWriteSession()
+
+	shootUpgrade, dberr := session.GetShootUpgrade(operation.ID)
+	if dberr != nil {
+		if dberr.Code() == dberrors.CodeNotFound {
+			log.Warnf("Gardener config from before the upgrade not found, Shoot will not be rolled back")
+			return false, nil
+		}
+		return false, errors.Wrap(dberr, "error getting Gardener config from before the upgrade")
+	}
+
+	previousConfig := rollbackConfig(shootUpgrade.PreUpgradeGardenerConfig, cluster.ClusterConfig)
+
+	log.Infof("Rolling back Shoot to the Gardener config from before the upgrade")
+
+	err := h.shootUpgrader.UpgradeCluster(ctx, cluster.ID, previousConfig, operation.ID)
+	if err != nil {
+		return false, errors.Wrap(err, "error rolling back Shoot")
+	}
+
+	dberr = session.UpdateGardenerClusterConfig(previousConfig)
+	if dberr != nil {
+		return true, errors.Wrap(dberr, "error rolling back Gardener config")
+	}
+
+	return true, nil
+}
+
+// rollbackConfig returns the Gardener config from before the upgrade with the versions which Gardener does not allow to downgrade:
+// the Kubernetes version and the machine images of the worker pools are kept as upgraded.
+// The worker pools and extensions added by the upgrade are removed.
+func rollbackConfig(preUpgradeConfig, upgradedConfig model.GardenerConfig) model.GardenerConfig {
+	config := preUpgradeConfig
+	config.KubernetesVersion = upgradedConfig.KubernetesVersion
+	config.MachineImage = upgradedConfig.MachineImage
+	config.MachineImageVersion = upgradedConfig.MachineImageVersion
+
+	upgradedPools := make(map[string]model.WorkerPool, len(upgradedConfig.AdditionalWorkerPools))
+	for _, pool := range upgradedConfig.AdditionalWorkerPools {
+		upgradedPools[pool.Name] = pool
+	}
+
+	if preUpgradeConfig.AdditionalWorkerPools != nil {
+		config.AdditionalWorkerPools = make([]model.WorkerPool, 0, len(preUpgradeConfig.AdditionalWorkerPools))
+		for _, pool := range preUpgradeConfig.AdditionalWorkerPools {
+			if upgraded, found := upgradedPools[pool.Name]; found {
+				pool.MachineImage = upgraded.MachineImage
+				pool.MachineImageVersion = upgraded.MachineImageVersion
+			}
+			config.AdditionalWorkerPools = append(config.AdditionalWorkerPools, pool)
+		}
+	} else if len(upgradedConfig.AdditionalWorkerPools) > 0 {
+		// Runtimes without additional worker pools have none recorded, an empty list removes the pools added by the upgrade
+		config.AdditionalWorkerPools = []model.WorkerPool{}
+	}
+
+	if upgradedConfig.Extensions != nil {
+		if config.Extensions == nil {
+			config.Extensions = []model.Extension{}
+		}
+		config.RemovedExtensionTypes = addedExtensionTypes(preUpgradeConfig.Extensions, upgradedConfig.Extensions)
+	}
+
+	return config
+}
+
+func addedExtensionTypes(preUpgradeExtensions, upgradedExtensions []model.Extension) []string {
+	existing := make(map[string]bool, len(preUpgradeExtensions))
+	for _, extension := range preUpgradeExtensions {
+		existing[extension.Type] = true
+	}
+
+	var added []string
+	for _, extension := range upgradedExtensions {
+		if !existing[extension.Type] {
+			added = append(added, extension.Type)
+		}
+	}
+
+	return added
+}
@@ -0,0 +1,188 @@
+package failure
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	upgraderMocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShootUpgradeFailureHandler_HandleFailure(t *testing.T) {
+
+	operation := model.Operation{ID: operationID, Type: model.UpgradeShoot}
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			Name:                clusterName,
+			KubernetesVersion:   "1.27.2",
+			MachineType:         "m5.2xlarge",
+			MachineImage:        util.StringPtr("gardenlinux"),
+			MachineImageVersion: util.StringPtr("934.8.0"),
+			AdditionalWorkerPools: []model.WorkerPool{
+				{Name: "cpu", MachineType: "m5.4xlarge", MachineImage: util.StringPtr("gardenlinux"), MachineImageVersion: util.StringPtr("934.8.0")},
+			},
+		},
+	}
+
+	preUpgradeConfig := model.GardenerConfig{
+		Name:                clusterName,
+		KubernetesVersion:   "1.26.5",
+		MachineType:         "m5.xlarge",
+		MachineImage:        util.StringPtr("gardenlinux"),
+		MachineImageVersion: util.StringPtr("576.12.0"),
+		AdditionalWorkerPools: []model.WorkerPool{
+			{Name: "cpu", MachineType: "m5.2xlarge", MachineImage: util.StringPtr("gardenlinux"), MachineImageVersion: util.StringPtr("576.12.0")},
+		},
+	}
+
+	rolledBackConfig := model.GardenerConfig{
+		Name:                clusterName,
+		KubernetesVersion:   "1.27.2",
+		MachineType:         "m5.xlarge",
+		MachineImage:        util.StringPtr("gardenlinux"),
+		MachineImageVersion: util.StringPtr("934.8.0"),
+		AdditionalWorkerPools: []model.WorkerPool{
+			{Name: "cpu", MachineType: "m5.2xlarge", MachineImage: util.StringPtr("gardenlinux"), MachineImageVersion: util.StringPtr("934.8.0")},
+		},
+	}
+
+	t.Run("should roll back Shoot and Gardener config keeping upgraded Kubernetes version and machine images", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetShootUpgrade", operationID).Return(model.ShootUpgrade{OperationId: operationID, PreUpgradeGardenerConfig: preUpgradeConfig}, nil)
+		session.On("UpdateGardenerClusterConfig", rolledBackConfig).Return(nil)
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadWriteSession").Return(session)
+
+		shootUpgrader := &upgraderMocks.ShootUpgrader{}
+		shootUpgrader.On("UpgradeCluster", mock.Anything, runtimeID, rolledBackConfig, operationID).Return(nil)
+
+		handler := NewShootUpgradeFailureHandler(factory, shootUpgrader)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		session.AssertExpectations(t)
+		shootUpgrader.AssertExpectations(t)
+	})
+
+	t.Run("should remove extensions added by the upgrade", func(t *testing.T) {
+		// given
+		upgradedCluster := cluster
+		upgradedCluster.ClusterConfig.Extensions = []model.Extension{{Type: "shoot-oidc-service"}, {Type: "shoot-lakom-service"}}
+
+		preUpgradeConfigWithExtensions := preUpgradeConfig
+		preUpgradeConfigWithExtensions.Extensions = []model.Extension{{Type: "shoot-oidc-service"}}
+
+		expectedConfig := rolledBackConfig
+		expectedConfig.Extensions = []model.Extension{{Type: "shoot-oidc-service"}}
+		expectedConfig.RemovedExtensionTypes = []string{"shoot-lakom-service"}
+
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetShootUpgrade", operationID).Return(model.ShootUpgrade{OperationId: operationID, PreUpgradeGardenerConfig: preUpgradeConfigWithExtensions}, nil)
+		session.On("UpdateGardenerClusterConfig", expectedConfig).Return(nil)
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadWriteSession").Return(session)
+
+		shootUpgrader := &upgraderMocks.ShootUpgrader{}
+		shootUpgrader.On("UpgradeCluster", mock.Anything, runtimeID, expectedConfig, operationID).Return(nil)
+
+		handler := NewShootUpgradeFailureHandler(factory, shootUpgrader)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, upgradedCluster)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		session.AssertExpectations(t)
+		shootUpgrader.AssertExpectations(t)
+	})
+
+	t.Run("should remove worker pools added by the upgrade to Runtime without additional worker pools", func(t *testing.T) {
+		// given
+		preUpgradeConfigWithoutPools := preUpgradeConfig
+		preUpgradeConfigWithoutPools.AdditionalWorkerPools = nil
+
+		expectedConfig := rolledBackConfig
+		expectedConfig.AdditionalWorkerPools = []model.WorkerPool{}
+
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetShootUpgrade", operationID).Return(model.ShootUpgrade{OperationId: operationID, PreUpgradeGardenerConfig: preUpgradeConfigWithoutPools}, nil)
+		session.On("UpdateGardenerClusterConfig", expectedConfig).Return(nil)
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadWriteSession").Return(session)
+
+		shootUpgrader := &upgraderMocks.ShootUpgrader{}
+		shootUpgrader.On("UpgradeCluster", mock.Anything, runtimeID, expectedConfig, operationID).Return(nil)
+
+		handler := NewShootUpgradeFailureHandler(factory, shootUpgrader)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, reverted)
+		session.AssertExpectations(t)
+		shootUpgrader.AssertExpectations(t)
+	})
+
+	t.Run("should not roll back Shoot when Gardener config from before the upgrade not recorded", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetShootUpgrade", operationID).Return(model.ShootUpgrade{}, dberrors.NotFound("not found"))
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadWriteSession").Return(session)
+
+		shootUpgrader := &upgraderMocks.ShootUpgrader{}
+
+		handler := NewShootUpgradeFailureHandler(factory, shootUpgrader)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, reverted)
+		shootUpgrader.AssertNotCalled(t, "UpgradeCluster", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("should return error and keep Gardener config when failed to roll back Shoot", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+		session.On("GetShootUpgrade", operationID).Return(model.ShootUpgrade{OperationId: operationID, PreUpgradeGardenerConfig: preUpgradeConfig}, nil)
+
+		factory := &sessionMocks.Factory{}
+		factory.On("NewReadWriteSession").Return(session)
+
+		shootUpgrader := &upgraderMocks.ShootUpgrader{}
+		shootUpgrader.On("UpgradeCluster", mock.Anything, runtimeID, rolledBackConfig, operationID).Return(apperrors.Internal("error"))
+
+		handler := NewShootUpgradeFailureHandler(factory, shootUpgrader)
+
+		// when
+		reverted, err := handler.HandleFailure(context.Background(), operation, cluster)
+
+		// then
+		require.Error(t, err)
+		assert.False(t, reverted)
+		session.AssertNotCalled(t, "UpdateGardenerClusterConfig", mock.Anything)
+	})
+}
@@ -1,4 +1,4 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockery v2.30.1. DO NOT EDIT.
 
 package mocks
 
@@ -14,7 +14,49 @@ func (_m *OperationQueue) Add(processId string) {
 	_m.Called(processId)
 }
 
+// BusyWorkers provides a mock function with given fields:
+func (_m *OperationQueue) BusyWorkers() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
+// Len provides a mock function with given fields:
+func (_m *OperationQueue) Len() int {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	return r0
+}
+
 // Run provides a mock function with given fields: stop
 func (_m *OperationQueue) Run(stop <-chan struct{}) {
 	_m.Called(stop)
 }
+
+// NewOperationQueue creates a new instance of OperationQueue. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewOperationQueue(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *OperationQueue {
+	mock := &OperationQueue{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
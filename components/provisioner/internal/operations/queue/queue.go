@@ -2,6 +2,7 @@ package queue
 
 import (
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
@@ -14,6 +15,8 @@ import (
 type OperationQueue interface {
 	Add(processId string)
 	Run(stop <-chan struct{})
+	Len() int
+	BusyWorkers() int
 }
 
 const (
@@ -25,8 +28,9 @@ type Executor interface {
 }
 
 type Queue struct {
-	queue    workqueue.RateLimitingInterface
-	executor Executor
+	queue       workqueue.RateLimitingInterface
+	executor    Executor
+	busyWorkers atomic.Int32
 }
 
 func NewQueue(executor Executor) *Queue {
@@ -44,10 +48,27 @@ func (q *Queue) Run(stop <-chan struct{}) {
 	var waitGroup sync.WaitGroup
 
 	for i := 0; i < workersAmount; i++ {
-		createWorker(q.queue, q.executor.Execute, stop, &waitGroup)
+		createWorker(q.queue, q.process, stop, &waitGroup)
 	}
 }
 
+// Len returns the number of operations waiting to be processed
+func (q *Queue) Len() int {
+	return q.queue.Len()
+}
+
+// BusyWorkers returns the number of workers processing operations
+func (q *Queue) BusyWorkers() int {
+	return int(q.busyWorkers.Load())
+}
+
+func (q *Queue) process(operationId string) operations.ProcessingResult {
+	q.busyWorkers.Add(1)
+	defer q.busyWorkers.Add(-1)
+
+	return q.executor.Execute(operationId)
+}
+
 func createWorker(queue workqueue.RateLimitingInterface, process func(id string) operations.ProcessingResult, stopCh <-chan struct{}, waitGroup *sync.WaitGroup) {
 	waitGroup.Add(1)
 	go func() {
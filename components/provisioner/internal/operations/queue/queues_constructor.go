@@ -1,6 +1,8 @@
 package queue
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	gardener_apis "github.com/gardener/gardener/pkg/client/core/clientset/versioned/typed/core/v1beta1"
@@ -9,6 +11,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/failure"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/hibernation"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/provisioning"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/shootupgrade"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
@@ -37,16 +40,113 @@ type DeprovisioningTimeouts struct {
 
 type HibernationTimeouts struct {
 	WaitingForClusterHibernation time.Duration `envconfig:"default=60m"`
+	WaitingForClusterWakeUp      time.Duration `envconfig:"default=60m"`
+}
+
+type ProvisioningBackoffs struct {
+	ClusterCreation    operations.BackoffPolicy
+	ClusterDomains     operations.BackoffPolicy
+	BindingsCreation   operations.BackoffPolicy
+	ShootUpgrade       operations.BackoffPolicy
+	ShootRefresh       operations.BackoffPolicy
+	AgentConfiguration operations.BackoffPolicy
+
+	ClusterCreationPollInterval    time.Duration `envconfig:"default=20s"`
+	BindingsCreationPollInterval   time.Duration `envconfig:"default=20s"`
+	ShootUpgradePollInterval       time.Duration `envconfig:"default=20s"`
+	AgentConfigurationPollInterval time.Duration `envconfig:"default=20s"`
+}
+
+type DeprovisioningBackoffs struct {
+	ClusterDeletion           operations.BackoffPolicy
+	WaitingForClusterDeletion operations.BackoffPolicy
+
+	WaitingForClusterDeletionPollInterval time.Duration `envconfig:"default=20s"`
+}
+
+type HibernationBackoffs struct {
+	WaitingForClusterHibernation operations.BackoffPolicy
+	WaitingForClusterWakeUp      operations.BackoffPolicy
+
+	WaitingForClusterHibernationPollInterval time.Duration `envconfig:"default=20s"`
+	WaitingForClusterWakeUpPollInterval      time.Duration `envconfig:"default=20s"`
+}
+
+func (b ProvisioningBackoffs) Validate(leaseDuration time.Duration) error {
+	return validateBackoffs(leaseDuration, map[string]operations.BackoffPolicy{
+		"ClusterCreation":    b.ClusterCreation,
+		"ClusterDomains":     b.ClusterDomains,
+		"BindingsCreation":   b.BindingsCreation,
+		"ShootUpgrade":       b.ShootUpgrade,
+		"ShootRefresh":       b.ShootRefresh,
+		"AgentConfiguration": b.AgentConfiguration,
+	}, map[string]time.Duration{
+		"ClusterCreation":    b.ClusterCreationPollInterval,
+		"BindingsCreation":   b.BindingsCreationPollInterval,
+		"ShootUpgrade":       b.ShootUpgradePollInterval,
+		"AgentConfiguration": b.AgentConfigurationPollInterval,
+	})
+}
+
+func (b DeprovisioningBackoffs) Validate(leaseDuration time.Duration) error {
+	return validateBackoffs(leaseDuration, map[string]operations.BackoffPolicy{
+		"ClusterDeletion":           b.ClusterDeletion,
+		"WaitingForClusterDeletion": b.WaitingForClusterDeletion,
+	}, map[string]time.Duration{
+		"WaitingForClusterDeletion": b.WaitingForClusterDeletionPollInterval,
+	})
+}
+
+func (b HibernationBackoffs) Validate(leaseDuration time.Duration) error {
+	return validateBackoffs(leaseDuration, map[string]operations.BackoffPolicy{
+		"WaitingForClusterHibernation": b.WaitingForClusterHibernation,
+		"WaitingForClusterWakeUp":      b.WaitingForClusterWakeUp,
+	}, map[string]time.Duration{
+		"WaitingForClusterHibernation": b.WaitingForClusterHibernationPollInterval,
+		"WaitingForClusterWakeUp":      b.WaitingForClusterWakeUpPollInterval,
+	})
+}
+
+// validateBackoffs checks the backoff policies and the intervals of steps polling Gardener.
+// The operation lease is not renewed while the operation waits for the next poll either.
+func validateBackoffs(leaseDuration time.Duration, policies map[string]operations.BackoffPolicy, pollIntervals map[string]time.Duration) error {
+	for name, policy := range policies {
+		if err := policy.Validate(leaseDuration); err != nil {
+			return fmt.Errorf("invalid %s backoff: %s", name, err.Error())
+		}
+	}
+	for name, interval := range pollIntervals {
+		if interval <= 0 {
+			return fmt.Errorf("invalid %s poll interval: interval must be greater than zero", name)
+		}
+		if interval >= leaseDuration {
+			return fmt.Errorf("invalid %s poll interval: interval %s must be shorter than the operation lease duration %s", name, interval, leaseDuration)
+		}
+	}
+	return nil
+}
+
+type OperationLeaseConfig struct {
+	Owner           string        `envconfig:"optional"`
+	Duration        time.Duration `envconfig:"default=2m"`
+	ReclaimInterval time.Duration `envconfig:"default=1m"`
+}
+
+type FailureHandlingConfig struct {
+	KeepProvisioningResources bool `envconfig:"default=false"`
 }
 
 //go:generate mockery --name=KubeconfigProvider
 type KubeconfigProvider interface {
-	FetchFromShoot(shootName string) ([]byte, error)
-	FetchFromRequest(shootName string) ([]byte, error)
+	FetchFromShoot(ctx context.Context, shootName string) ([]byte, error)
+	FetchFromRequest(ctx context.Context, shootName string) ([]byte, error)
 }
 
 func CreateProvisioningQueue(
 	timeouts ProvisioningTimeouts,
+	backoffs ProvisioningBackoffs,
+	lease OperationLeaseConfig,
+	failureHandling FailureHandlingConfig,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
@@ -55,24 +155,26 @@ func CreateProvisioningQueue(
 	configurator runtime.Configurator,
 	kubeconfigProvider KubeconfigProvider) OperationQueue {
 
-	configureAgentStep := provisioning.NewConnectAgentStep(configurator, kubeconfigProvider, model.FinishedStage, timeouts.AgentConfiguration)
-	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, configureAgentStep.Name(), timeouts.BindingsCreation)
-	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), kubeconfigProvider, createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation)
+	configureAgentStep := provisioning.NewConnectAgentStep(configurator, kubeconfigProvider, model.FinishedStage, timeouts.AgentConfiguration, backoffs.AgentConfigurationPollInterval)
+	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, configureAgentStep.Name(), timeouts.BindingsCreation, backoffs.BindingsCreationPollInterval)
+	waitForClusterCreationStep := provisioning.NewWaitForClusterCreationStep(shootClient, factory.NewReadWriteSession(), kubeconfigProvider, createBindingsForOperatorsStep.Name(), timeouts.ClusterCreation, backoffs.ClusterCreationPollInterval)
 	waitForClusterDomainStep := provisioning.NewWaitForClusterDomainStep(shootClient, directorClient, waitForClusterCreationStep.Name(), timeouts.ClusterDomains)
 
 	provisionSteps := map[model.OperationStage]operations.Step{
-		model.ConnectRuntimeAgent:          configureAgentStep,
-		model.CreatingBindingsForOperators: createBindingsForOperatorsStep,
-		model.WaitingForClusterDomain:      waitForClusterDomainStep,
-		model.WaitingForClusterCreation:    waitForClusterCreationStep,
+		model.ConnectRuntimeAgent:          operations.WithBackoff(configureAgentStep, backoffs.AgentConfiguration),
+		model.CreatingBindingsForOperators: operations.WithBackoff(createBindingsForOperatorsStep, backoffs.BindingsCreation),
+		model.WaitingForClusterDomain:      operations.WithBackoff(waitForClusterDomainStep, backoffs.ClusterDomains),
+		model.WaitingForClusterCreation:    operations.WithBackoff(waitForClusterCreationStep, backoffs.ClusterCreation),
 	}
 
 	provisioningExecutor := operations.NewExecutor(
 		factory.NewReadWriteSession(),
 		model.Provision,
 		provisionSteps,
-		failure.NewNoopFailureHandler(),
+		failure.NewProvisioningFailureHandler(shootClient, directorClient, failureHandling.KeepProvisioningResources),
 		directorClient,
+		lease.Owner,
+		lease.Duration,
 	)
 
 	return NewQueue(provisioningExecutor)
@@ -80,17 +182,19 @@ func CreateProvisioningQueue(
 
 func CreateDeprovisioningQueue(
 	timeouts DeprovisioningTimeouts,
+	backoffs DeprovisioningBackoffs,
+	lease OperationLeaseConfig,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
 ) OperationQueue {
 
-	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, directorClient, model.FinishedStage, timeouts.WaitingForClusterDeletion)
+	waitForClusterDeletion := deprovisioning.NewWaitForClusterDeletionStep(shootClient, factory, directorClient, model.FinishedStage, timeouts.WaitingForClusterDeletion, backoffs.WaitingForClusterDeletionPollInterval)
 	deleteCluster := deprovisioning.NewDeleteClusterStep(shootClient, waitForClusterDeletion.Name(), timeouts.ClusterDeletion)
 
 	deprovisioningSteps := map[model.OperationStage]operations.Step{
-		model.DeleteCluster:          deleteCluster,
-		model.WaitForClusterDeletion: waitForClusterDeletion,
+		model.DeleteCluster:          operations.WithBackoff(deleteCluster, backoffs.ClusterDeletion),
+		model.WaitForClusterDeletion: operations.WithBackoff(waitForClusterDeletion, backoffs.WaitingForClusterDeletion),
 	}
 
 	deprovisioningExecutor := operations.NewExecutor(
@@ -99,6 +203,8 @@ func CreateDeprovisioningQueue(
 		deprovisioningSteps,
 		failure.NewNoopFailureHandler(),
 		directorClient,
+		lease.Owner,
+		lease.Duration,
 	)
 
 	return NewQueue(deprovisioningExecutor)
@@ -106,31 +212,92 @@ func CreateDeprovisioningQueue(
 
 func CreateShootUpgradeQueue(
 	timeouts ProvisioningTimeouts,
+	backoffs ProvisioningBackoffs,
+	lease OperationLeaseConfig,
 	factory dbsession.Factory,
 	directorClient director.DirectorClient,
 	shootClient gardener_apis.ShootInterface,
+	shootUpgrader failure.ShootUpgrader,
 	operatorRoleBindingConfig provisioning.OperatorRoleBinding,
 	k8sClientProvider k8s.K8sClientProvider,
 	kubeconfigProvider KubeconfigProvider,
 ) OperationQueue {
 
-	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation)
-	waitForShootUpgrade := shootupgrade.NewWaitForShootUpgradeStep(shootClient, factory.NewReadWriteSession(), kubeconfigProvider, createBindingsForOperatorsStep.Name(), timeouts.ShootUpgrade)
+	createBindingsForOperatorsStep := provisioning.NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorRoleBindingConfig, kubeconfigProvider, model.FinishedStage, timeouts.BindingsCreation, backoffs.BindingsCreationPollInterval)
+	waitForShootUpgrade := shootupgrade.NewWaitForShootUpgradeStep(shootClient, factory.NewReadWriteSession(), kubeconfigProvider, createBindingsForOperatorsStep.Name(), timeouts.ShootUpgrade, backoffs.ShootUpgradePollInterval)
 	waitForShootNewVersion := shootupgrade.NewWaitForShootNewVersionStep(shootClient, waitForShootUpgrade.Name(), timeouts.ShootRefresh)
 
 	upgradeSteps := map[model.OperationStage]operations.Step{
-		model.CreatingBindingsForOperators: createBindingsForOperatorsStep,
-		model.WaitingForShootUpgrade:       waitForShootUpgrade,
-		model.WaitingForShootNewVersion:    waitForShootNewVersion,
+		model.CreatingBindingsForOperators: operations.WithBackoff(createBindingsForOperatorsStep, backoffs.BindingsCreation),
+		model.WaitingForShootUpgrade:       operations.WithBackoff(waitForShootUpgrade, backoffs.ShootUpgrade),
+		model.WaitingForShootNewVersion:    operations.WithBackoff(waitForShootNewVersion, backoffs.ShootRefresh),
 	}
 
 	upgradeClusterExecutor := operations.NewExecutor(
 		factory.NewReadWriteSession(),
 		model.UpgradeShoot,
 		upgradeSteps,
-		failure.NewNoopFailureHandler(),
+		failure.NewShootUpgradeFailureHandler(factory, shootUpgrader),
 		directorClient,
+		lease.Owner,
+		lease.Duration,
 	)
 
 	return NewQueue(upgradeClusterExecutor)
 }
+
+func CreateHibernationQueue(
+	timeouts HibernationTimeouts,
+	backoffs HibernationBackoffs,
+	lease OperationLeaseConfig,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+) OperationQueue {
+
+	waitForHibernation := hibernation.NewWaitForHibernationStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterHibernation, backoffs.WaitingForClusterHibernationPollInterval)
+
+	hibernationSteps := map[model.OperationStage]operations.Step{
+		model.WaitForHibernation: operations.WithBackoff(waitForHibernation, backoffs.WaitingForClusterHibernation),
+	}
+
+	hibernationExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.Hibernate,
+		hibernationSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+		lease.Owner,
+		lease.Duration,
+	)
+
+	return NewQueue(hibernationExecutor)
+}
+
+func CreateWakeUpQueue(
+	timeouts HibernationTimeouts,
+	backoffs HibernationBackoffs,
+	lease OperationLeaseConfig,
+	factory dbsession.Factory,
+	directorClient director.DirectorClient,
+	shootClient gardener_apis.ShootInterface,
+) OperationQueue {
+
+	waitForWakeUp := hibernation.NewWaitForWakeUpStep(shootClient, model.FinishedStage, timeouts.WaitingForClusterWakeUp, backoffs.WaitingForClusterWakeUpPollInterval)
+
+	wakeUpSteps := map[model.OperationStage]operations.Step{
+		model.WaitForWakeUp: operations.WithBackoff(waitForWakeUp, backoffs.WaitingForClusterWakeUp),
+	}
+
+	wakeUpExecutor := operations.NewExecutor(
+		factory.NewReadWriteSession(),
+		model.WakeUp,
+		wakeUpSteps,
+		failure.NewNoopFailureHandler(),
+		directorClient,
+		lease.Owner,
+		lease.Duration,
+	)
+
+	return NewQueue(wakeUpExecutor)
+}
@@ -0,0 +1,50 @@
+package queue
+
+import (
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// OrphanedOperationsReclaimer periodically enqueues In Progress operations which are not leased by any provisioner instance,
+// so that operations of an instance which stopped renewing its leases are taken over by the remaining ones.
+type OrphanedOperationsReclaimer struct {
+	readSession dbsession.ReadSession
+	queues      map[model.OperationType]OperationQueue
+	interval    time.Duration
+	log         logrus.FieldLogger
+}
+
+func NewOrphanedOperationsReclaimer(readSession dbsession.ReadSession, queues map[model.OperationType]OperationQueue, interval time.Duration) *OrphanedOperationsReclaimer {
+	return &OrphanedOperationsReclaimer{
+		readSession: readSession,
+		queues:      queues,
+		interval:    interval,
+		log:         logrus.WithField("Component", "OrphanedOperationsReclaimer"),
+	}
+}
+
+func (r *OrphanedOperationsReclaimer) Run(stop <-chan struct{}) {
+	go wait.Until(r.Reclaim, r.interval, stop)
+}
+
+func (r *OrphanedOperationsReclaimer) Reclaim() {
+	orphanedOps, err := r.readSession.ListOrphanedInProgressOperations(time.Now())
+	if err != nil {
+		r.log.Warnf("failed to list orphaned operations: %s", err.Error())
+		return
+	}
+
+	for _, op := range orphanedOps {
+		operationQueue, found := r.queues[op.Type]
+		if !found {
+			continue
+		}
+
+		r.log.WithField("OperationId", op.ID).Infof("Enqueuing orphaned %s operation", op.Type)
+		operationQueue.Add(op.ID)
+	}
+}
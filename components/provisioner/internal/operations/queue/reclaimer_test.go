@@ -0,0 +1,59 @@
+package queue
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestOrphanedOperationsReclaimer_Reclaim(t *testing.T) {
+
+	t.Run("should enqueue orphaned operations to queues matching their type", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListOrphanedInProgressOperations", mock.AnythingOfType("time.Time")).Return([]model.Operation{
+			{ID: "provisioning", Type: model.Provision},
+			{ID: "deprovisioning", Type: model.DeprovisionNoInstall},
+			{ID: "reconnect", Type: model.ReconnectRuntime},
+		}, nil)
+
+		provisioningQueue := &mocks.OperationQueue{}
+		provisioningQueue.On("Add", "provisioning").Return()
+		deprovisioningQueue := &mocks.OperationQueue{}
+		deprovisioningQueue.On("Add", "deprovisioning").Return()
+
+		reclaimer := NewOrphanedOperationsReclaimer(readSession, map[model.OperationType]OperationQueue{
+			model.Provision:            provisioningQueue,
+			model.DeprovisionNoInstall: deprovisioningQueue,
+		}, 0)
+
+		// when
+		reclaimer.Reclaim()
+
+		// then
+		provisioningQueue.AssertExpectations(t)
+		deprovisioningQueue.AssertExpectations(t)
+	})
+
+	t.Run("should not enqueue anything when listing operations failed", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListOrphanedInProgressOperations", mock.AnythingOfType("time.Time")).Return(nil, dberrors.Internal("error"))
+
+		provisioningQueue := &mocks.OperationQueue{}
+
+		reclaimer := NewOrphanedOperationsReclaimer(readSession, map[model.OperationType]OperationQueue{
+			model.Provision: provisioningQueue,
+		}, 0)
+
+		// when
+		reclaimer.Reclaim()
+
+		// then
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
@@ -42,9 +42,9 @@ func (s *DeleteClusterStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *DeleteClusterStep) Run(cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+func (s *DeleteClusterStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
 
-	err := s.deleteShoot(cluster.ClusterConfig.Name)
+	err := s.deleteShoot(ctx, cluster.ClusterConfig.Name)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -52,8 +52,8 @@ func (s *DeleteClusterStep) Run(cluster model.Cluster, _ model.Operation, logger
 	return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
 }
 
-func (s *DeleteClusterStep) deleteShoot(gardenerClusterName string) error {
-	err := s.gardenerClient.Delete(context.Background(), gardenerClusterName, metav1.DeleteOptions{})
+func (s *DeleteClusterStep) deleteShoot(ctx context.Context, gardenerClusterName string) error {
+	err := s.gardenerClient.Delete(ctx, gardenerClusterName, metav1.DeleteOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return nil
@@ -70,7 +70,7 @@ func TestDeprovisionCluster_Run(t *testing.T) {
 			deleteClusterStep := NewDeleteClusterStep(gardenerClient, nextStageName, 10*time.Minute)
 
 			// when
-			result, err := deleteClusterStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := deleteClusterStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -112,7 +112,7 @@ func TestDeprovisionCluster_Run(t *testing.T) {
 			deleteClusterStep := NewDeleteClusterStep(gardenerClient, nextStageName, 10*time.Minute)
 
 			// when
-			_, err := deleteClusterStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := deleteClusterStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 			appErr := operations.ConvertToAppError(err)
 
 			// then
@@ -24,15 +24,17 @@ type WaitForClusterDeletionStep struct {
 	directorClient director.DirectorClient
 	nextStep       model.OperationStage
 	timeLimit      time.Duration
+	pollInterval   time.Duration
 }
 
-func NewWaitForClusterDeletionStep(gardenerClient GardenerClient, dbsFactory dbsession.Factory, directorClient director.DirectorClient, nextStep model.OperationStage, timeLimit time.Duration) *WaitForClusterDeletionStep {
+func NewWaitForClusterDeletionStep(gardenerClient GardenerClient, dbsFactory dbsession.Factory, directorClient director.DirectorClient, nextStep model.OperationStage, timeLimit, pollInterval time.Duration) *WaitForClusterDeletionStep {
 	return &WaitForClusterDeletionStep{
 		gardenerClient: gardenerClient,
 		dbsFactory:     dbsFactory,
 		directorClient: directorClient,
 		nextStep:       nextStep,
 		timeLimit:      timeLimit,
+		pollInterval:   pollInterval,
 	}
 }
 
@@ -44,18 +46,18 @@ func (s *WaitForClusterDeletionStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterDeletionStep) Run(cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForClusterDeletionStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
 
-	shootExists, err := s.shootExists(cluster.ClusterConfig.Name)
+	shootExists, err := s.shootExists(ctx, cluster.ClusterConfig.Name)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
 
 	if shootExists {
-		return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+		return operations.StageResult{Stage: s.Name(), Delay: s.pollInterval}, nil
 	}
 
-	err = s.setDeprovisioningFinished(cluster)
+	err = s.setDeprovisioningFinished(ctx, cluster)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -63,8 +65,8 @@ func (s *WaitForClusterDeletionStep) Run(cluster model.Cluster, _ model.Operatio
 	return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
 }
 
-func (s *WaitForClusterDeletionStep) shootExists(gardenerClusterName string) (bool, error) {
-	_, err := s.gardenerClient.Get(context.Background(), gardenerClusterName, v1.GetOptions{})
+func (s *WaitForClusterDeletionStep) shootExists(ctx context.Context, gardenerClusterName string) (bool, error) {
+	_, err := s.gardenerClient.Get(ctx, gardenerClusterName, v1.GetOptions{})
 	if err != nil {
 		if k8serrors.IsNotFound(err) {
 			return false, nil
@@ -75,7 +77,7 @@ func (s *WaitForClusterDeletionStep) shootExists(gardenerClusterName string) (bo
 	return true, nil
 }
 
-func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(cluster model.Cluster) error {
+func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(ctx context.Context, cluster model.Cluster) error {
 	session, dberr := s.dbsFactory.NewSessionWithinTransaction()
 	if dberr != nil {
 		return errors.Wrap(dberr, "error starting db session with transaction")
@@ -87,7 +89,7 @@ func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(cluster model.Clu
 		return errors.Wrap(dberr, "error marking cluster for deletion")
 	}
 
-	err := s.deleteRuntime(cluster)
+	err := s.deleteRuntime(ctx, cluster)
 	if err != nil {
 		return err
 	}
@@ -100,10 +102,10 @@ func (s *WaitForClusterDeletionStep) setDeprovisioningFinished(cluster model.Clu
 	return nil
 }
 
-func (s *WaitForClusterDeletionStep) deleteRuntime(cluster model.Cluster) error {
+func (s *WaitForClusterDeletionStep) deleteRuntime(ctx context.Context, cluster model.Cluster) error {
 	var exists bool
 	err := util.RetryOnError(5*time.Second, 3, "Error while checking if runtime exists in Director: %s", func() (err apperrors.AppError) {
-		exists, err = s.directorClient.RuntimeExists(cluster.ID, cluster.Tenant)
+		exists, err = s.directorClient.RuntimeExists(ctx, cluster.ID, cluster.Tenant)
 		return
 	})
 
@@ -116,7 +118,7 @@ func (s *WaitForClusterDeletionStep) deleteRuntime(cluster model.Cluster) error
 	}
 
 	err = util.RetryOnError(5*time.Second, 3, "Error while unregistering runtime in Director: %s", func() (err apperrors.AppError) {
-		err = s.directorClient.DeleteRuntime(cluster.ID, cluster.Tenant)
+		err = s.directorClient.DeleteRuntime(ctx, cluster.ID, cluster.Tenant)
 		return
 	})
 
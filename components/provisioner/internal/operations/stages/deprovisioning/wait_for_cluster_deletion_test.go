@@ -49,8 +49,8 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession := &dbMocks.WriteSessionWithinTransaction{}
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(true, nil)
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Return(nil)
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
 				dbSession.On("Commit").Return(nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
 			},
@@ -64,7 +64,7 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession := &dbMocks.WriteSessionWithinTransaction{}
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(false, nil)
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(false, nil)
 				dbSession.On("Commit").Return(nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
 			},
@@ -78,9 +78,9 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession := &dbMocks.WriteSessionWithinTransaction{}
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
-				directorClient.On("RuntimeExists", runtimeID, tenant).Once().Return(false, apperrors.Internal("exists err"))
-				directorClient.On("RuntimeExists", runtimeID, tenant).Once().Return(true, nil)
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Return(nil)
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Once().Return(false, apperrors.Internal("exists err"))
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Once().Return(true, nil)
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
 				dbSession.On("Commit").Return(nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
 			},
@@ -94,9 +94,9 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession := &dbMocks.WriteSessionWithinTransaction{}
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(true, nil)
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Once().Return(apperrors.Internal("delete error"))
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Once().Return(nil)
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Once().Return(apperrors.Internal("delete error"))
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Once().Return(nil)
 				dbSession.On("Commit").Return(nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
 			},
@@ -120,10 +120,10 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSessionFactory, directorClient)
 
-			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, directorClient, nextStageName, 10*time.Minute)
+			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, directorClient, nextStageName, 10*time.Minute, 20*time.Second)
 
 			// when
-			result, err := waitForClusterDeletionStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterDeletionStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -189,7 +189,7 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(false, apperrors.External("some error").SetComponent(apperrors.ErrMpsOAuth2))
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(false, apperrors.External("some error").SetComponent(apperrors.ErrMpsOAuth2))
 			},
 			cluster:            cluster,
 			unrecoverableError: false,
@@ -205,8 +205,8 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession.On("MarkClusterAsDeleted", runtimeID).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
 				dbSession.On("RollbackUnlessCommitted").Return()
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(true, nil)
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Return(apperrors.BadGateway("some error").SetComponent(apperrors.ErrCompassDirector).SetReason(apperrors.ErrReason("Invalid data")).Append("Failed to request"))
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(apperrors.BadGateway("some error").SetComponent(apperrors.ErrCompassDirector).SetReason(apperrors.ErrReason("Invalid data")).Append("Failed to request"))
 			},
 			cluster:            cluster,
 			unrecoverableError: false,
@@ -221,8 +221,8 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 				dbSession := &dbMocks.WriteSessionWithinTransaction{}
 				dbSession.On("MarkClusterAsDeleted", mock.AnythingOfType("string")).Return(nil)
 				dbSessionFactory.On("NewSessionWithinTransaction").Return(dbSession, nil)
-				directorClient.On("RuntimeExists", runtimeID, tenant).Return(true, nil)
-				directorClient.On("DeleteRuntime", runtimeID, tenant).Return(nil)
+				directorClient.On("RuntimeExists", mock.Anything, runtimeID, tenant).Return(true, nil)
+				directorClient.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
 				dbSession.On("Commit").Return(dberrors.Internal("some error"))
 				dbSession.On("RollbackUnlessCommitted").Return()
 			},
@@ -241,10 +241,10 @@ func TestWaitForClusterDeletion_Run(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSessionFactory, directorClient)
 
-			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, directorClient, nextStageName, 10*time.Minute)
+			waitForClusterDeletionStep := NewWaitForClusterDeletionStep(gardenerClient, dbSessionFactory, directorClient, nextStageName, 10*time.Minute, 20*time.Second)
 
 			// when
-			_, err := waitForClusterDeletionStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterDeletionStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 			appErr := operations.ConvertToAppError(err)
 
 			// then
@@ -0,0 +1,73 @@
+package hibernation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	gardener_types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type GardenerClient interface {
+	Get(ctx context.Context, name string, options v1.GetOptions) (*gardener_types.Shoot, error)
+}
+
+type WaitForHibernationStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+	pollInterval   time.Duration
+}
+
+func NewWaitForHibernationStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit, pollInterval time.Duration) *WaitForHibernationStep {
+	return &WaitForHibernationStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+		pollInterval:   pollInterval,
+	}
+}
+
+func (s *WaitForHibernationStep) Name() model.OperationStage {
+	return model.WaitForHibernation
+}
+
+func (s *WaitForHibernationStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForHibernationStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	return waitForHibernationState(ctx, s.gardenerClient, cluster, true, s.Name(), s.nextStep, s.pollInterval, logger)
+}
+
+// waitForHibernationState waits until Gardener finishes reconciling the Shoot into the expected hibernation state.
+func waitForHibernationState(ctx context.Context, gardenerClient GardenerClient, cluster model.Cluster, hibernated bool, stage, nextStage model.OperationStage, pollInterval time.Duration, logger logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
+	if err != nil {
+		return operations.StageResult{}, err
+	}
+
+	lastOperation := shoot.Status.LastOperation
+	reconciled := shoot.Status.ObservedGeneration == shoot.Generation && lastOperation != nil
+
+	if reconciled && lastOperation.State == gardener_types.LastOperationStateFailed {
+		action := "hibernation"
+		if !hibernated {
+			action = "wake-up"
+		}
+		logger.Warningf("Gardener Shoot cluster %s operation failed! Last state: %s, Description: %s", action, lastOperation.State, lastOperation.Description)
+
+		err := fmt.Errorf("Gardener Shoot cluster %s failed. Last Shoot state: %s, Shoot description: %s", action, lastOperation.State, lastOperation.Description)
+		return operations.StageResult{}, operations.NewNonRecoverableError(err)
+	}
+
+	if reconciled && lastOperation.State == gardener_types.LastOperationStateSucceeded && shoot.Status.IsHibernated == hibernated {
+		return operations.StageResult{Stage: nextStage, Delay: 0}, nil
+	}
+
+	return operations.StageResult{Stage: stage, Delay: pollInterval}, nil
+}
@@ -0,0 +1,139 @@
+package hibernation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	gardener_mocks "github.com/kyma-project/control-plane/components/provisioner/internal/operations/stages/deprovisioning/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	clusterName                        = "shootName"
+	nextStageName model.OperationStage = "NextStage"
+)
+
+func TestWaitForHibernation_Run(t *testing.T) {
+
+	cluster := model.Cluster{
+		ID: "runtimeID",
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	for _, testCase := range []struct {
+		description   string
+		shoot         *testkit.TestShoot
+		expectedStage model.OperationStage
+		expectedDelay time.Duration
+	}{
+		{
+			description:   "should go to the next step when Shoot is hibernated",
+			shoot:         testkit.NewTestShoot(clusterName).WithGeneration(2).WithObservedGeneration(2).WithOperationSucceeded().WithHibernationState(true, true),
+			expectedStage: nextStageName,
+			expectedDelay: 0,
+		},
+		{
+			description:   "should continue waiting when Shoot hibernation is not observed yet",
+			shoot:         testkit.NewTestShoot(clusterName).WithGeneration(2).WithObservedGeneration(1).WithOperationSucceeded().WithHibernationState(true, false),
+			expectedStage: model.WaitForHibernation,
+			expectedDelay: 20 * time.Second,
+		},
+		{
+			description:   "should continue waiting when Shoot is being hibernated",
+			shoot:         testkit.NewTestShoot(clusterName).WithGeneration(2).WithObservedGeneration(2).WithOperationProcessing().WithHibernationState(true, false),
+			expectedStage: model.WaitForHibernation,
+			expectedDelay: 20 * time.Second,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &gardener_mocks.GardenerClient{}
+			gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(testCase.shoot.ToShoot(), nil)
+
+			step := NewWaitForHibernationStep(gardenerClient, nextStageName, time.Hour, 20*time.Second)
+
+			// when
+			result, err := step.Run(context.Background(), cluster, model.Operation{}, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStage, result.Stage)
+			assert.Equal(t, testCase.expectedDelay, result.Delay)
+			gardenerClient.AssertExpectations(t)
+		})
+	}
+
+	t.Run("should return non recoverable error when Shoot hibernation failed", func(t *testing.T) {
+		// given
+		gardenerClient := &gardener_mocks.GardenerClient{}
+		gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(
+			testkit.NewTestShoot(clusterName).WithGeneration(2).WithObservedGeneration(2).WithOperationFailed().ToShoot(), nil)
+
+		step := NewWaitForHibernationStep(gardenerClient, nextStageName, time.Hour, 20*time.Second)
+
+		// when
+		_, err := step.Run(context.Background(), cluster, model.Operation{}, logrus.New())
+
+		// then
+		require.Error(t, err)
+		nonRecoverable := operations.NonRecoverableError{}
+		assert.True(t, errors.As(err, &nonRecoverable))
+	})
+}
+
+func TestWaitForWakeUp_Run(t *testing.T) {
+
+	cluster := model.Cluster{
+		ID: "runtimeID",
+		ClusterConfig: model.GardenerConfig{
+			Name: clusterName,
+		},
+	}
+
+	for _, testCase := range []struct {
+		description   string
+		shoot         *testkit.TestShoot
+		expectedStage model.OperationStage
+		expectedDelay time.Duration
+	}{
+		{
+			description:   "should go to the next step when Shoot is woken up",
+			shoot:         testkit.NewTestShoot(clusterName).WithGeneration(3).WithObservedGeneration(3).WithOperationSucceeded().WithHibernationState(true, false),
+			expectedStage: nextStageName,
+			expectedDelay: 0,
+		},
+		{
+			description:   "should continue waiting when Shoot is still hibernated",
+			shoot:         testkit.NewTestShoot(clusterName).WithGeneration(3).WithObservedGeneration(3).WithOperationProcessing().WithHibernationState(true, true),
+			expectedStage: model.WaitForWakeUp,
+			expectedDelay: 20 * time.Second,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			gardenerClient := &gardener_mocks.GardenerClient{}
+			gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(testCase.shoot.ToShoot(), nil)
+
+			step := NewWaitForWakeUpStep(gardenerClient, nextStageName, time.Hour, 20*time.Second)
+
+			// when
+			result, err := step.Run(context.Background(), cluster, model.Operation{}, logrus.New())
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedStage, result.Stage)
+			assert.Equal(t, testCase.expectedDelay, result.Delay)
+			gardenerClient.AssertExpectations(t)
+		})
+	}
+}
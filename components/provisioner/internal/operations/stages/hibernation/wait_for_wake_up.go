@@ -0,0 +1,38 @@
+package hibernation
+
+import (
+	"context"
+	"time"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/operations"
+	"github.com/sirupsen/logrus"
+)
+
+type WaitForWakeUpStep struct {
+	gardenerClient GardenerClient
+	nextStep       model.OperationStage
+	timeLimit      time.Duration
+	pollInterval   time.Duration
+}
+
+func NewWaitForWakeUpStep(gardenerClient GardenerClient, nextStep model.OperationStage, timeLimit, pollInterval time.Duration) *WaitForWakeUpStep {
+	return &WaitForWakeUpStep{
+		gardenerClient: gardenerClient,
+		nextStep:       nextStep,
+		timeLimit:      timeLimit,
+		pollInterval:   pollInterval,
+	}
+}
+
+func (s *WaitForWakeUpStep) Name() model.OperationStage {
+	return model.WaitForWakeUp
+}
+
+func (s *WaitForWakeUpStep) TimeLimit() time.Duration {
+	return s.timeLimit
+}
+
+func (s *WaitForWakeUpStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+	return waitForHibernationState(ctx, s.gardenerClient, cluster, false, s.Name(), s.nextStep, s.pollInterval, logger)
+}
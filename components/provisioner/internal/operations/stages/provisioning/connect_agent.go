@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"context"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
@@ -14,18 +15,21 @@ type ConnectAgentStep struct {
 	dynamicKubeconfigProvider DynamicKubeconfigProvider
 	nextStage                 model.OperationStage
 	timeLimit                 time.Duration
+	pollInterval              time.Duration
 }
 
 func NewConnectAgentStep(
 	configurator runtime.Configurator,
 	dynamicKubeconfigProvider DynamicKubeconfigProvider,
 	nextStage model.OperationStage,
-	timeLimit time.Duration) *ConnectAgentStep {
+	timeLimit time.Duration,
+	pollInterval time.Duration) *ConnectAgentStep {
 	return &ConnectAgentStep{
 		runtimeConfigurator:       configurator,
 		dynamicKubeconfigProvider: dynamicKubeconfigProvider,
 		nextStage:                 nextStage,
 		timeLimit:                 timeLimit,
+		pollInterval:              pollInterval,
 	}
 }
 
@@ -37,17 +41,17 @@ func (s *ConnectAgentStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *ConnectAgentStep) Run(cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
+func (s *ConnectAgentStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, _ logrus.FieldLogger) (operations.StageResult, error) {
 
 	var kubeconfig []byte
 	{
 		var err error
-		kubeconfig, err = s.dynamicKubeconfigProvider.FetchFromRequest(cluster.ClusterConfig.Name)
+		kubeconfig, err = s.dynamicKubeconfigProvider.FetchFromRequest(ctx, cluster.ClusterConfig.Name)
 		if err != nil {
-			return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+			return operations.StageResult{Stage: s.Name(), Delay: s.pollInterval}, nil
 		}
 	}
-	err := s.runtimeConfigurator.ConfigureRuntime(cluster, string(kubeconfig))
+	err := s.runtimeConfigurator.ConfigureRuntime(ctx, cluster, string(kubeconfig))
 	if err != nil {
 		return operations.StageResult{}, err.Append("failed to configure Runtime Agent")
 	}
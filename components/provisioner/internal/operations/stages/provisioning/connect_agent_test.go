@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,17 +33,17 @@ func TestConnectAgentStep_Run(t *testing.T) {
 		},
 	}
 	dynamicKubeconfigProvider := &provisioning_mocks.DynamicKubeconfigProvider{}
-	dynamicKubeconfigProvider.On("FetchFromRequest", "shoot").Return([]byte("dynamic_kubeconfig"), nil)
+	dynamicKubeconfigProvider.On("FetchFromRequest", mock.Anything, "shoot").Return([]byte("dynamic_kubeconfig"), nil)
 
 	t.Run("should return next step when finished", func(t *testing.T) {
 		// given
 		configurator := &mocks.Configurator{}
-		configurator.On("ConfigureRuntime", cluster, dynamicKubeconfig).Return(nil)
+		configurator.On("ConfigureRuntime", mock.Anything, cluster, dynamicKubeconfig).Return(nil)
 
-		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := stage.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := stage.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -52,14 +54,14 @@ func TestConnectAgentStep_Run(t *testing.T) {
 	t.Run("should attempt retry when failed to get dynamic kubeconfig", func(t *testing.T) {
 		// given
 		dynamicKubeconfigProvider := &provisioning_mocks.DynamicKubeconfigProvider{}
-		dynamicKubeconfigProvider.On("FetchFromRequest", "shoot").Return(nil, errors.New("some error"))
+		dynamicKubeconfigProvider.On("FetchFromRequest", mock.Anything, "shoot").Return(nil, errors.New("some error"))
 
 		configurator := &mocks.Configurator{}
 
-		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := stage.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := stage.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -70,12 +72,12 @@ func TestConnectAgentStep_Run(t *testing.T) {
 	t.Run("should return error when failed to configure cluster", func(t *testing.T) {
 		// given
 		configurator := &mocks.Configurator{}
-		configurator.On("ConfigureRuntime", cluster, dynamicKubeconfig).Return(apperrors.Internal("error"))
+		configurator.On("ConfigureRuntime", mock.Anything, cluster, dynamicKubeconfig).Return(apperrors.Internal("error"))
 
-		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		stage := NewConnectAgentStep(configurator, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		_, err := stage.Run(cluster, model.Operation{}, &logrus.Entry{})
+		_, err := stage.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.Error(t, err)
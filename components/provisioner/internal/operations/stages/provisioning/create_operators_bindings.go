@@ -38,7 +38,7 @@ const (
 
 //go:generate mockery --name=DynamicKubeconfigProvider
 type DynamicKubeconfigProvider interface {
-	FetchFromRequest(shootName string) ([]byte, error)
+	FetchFromRequest(ctx context.Context, shootName string) ([]byte, error)
 }
 
 type OperatorRoleBinding struct {
@@ -53,6 +53,7 @@ type CreateBindingsForOperatorsStep struct {
 	dynamicKubeconfigProvider DynamicKubeconfigProvider
 	nextStep                  model.OperationStage
 	timeLimit                 time.Duration
+	pollInterval              time.Duration
 }
 
 func NewCreateBindingsForOperatorsStep(
@@ -60,7 +61,8 @@ func NewCreateBindingsForOperatorsStep(
 	operatorRoleBindingConfig OperatorRoleBinding,
 	dynamicKubeconfigProvider DynamicKubeconfigProvider,
 	nextStep model.OperationStage,
-	timeLimit time.Duration) *CreateBindingsForOperatorsStep {
+	timeLimit time.Duration,
+	pollInterval time.Duration) *CreateBindingsForOperatorsStep {
 
 	return &CreateBindingsForOperatorsStep{
 		k8sClientProvider:         k8sClientProvider,
@@ -68,6 +70,7 @@ func NewCreateBindingsForOperatorsStep(
 		dynamicKubeconfigProvider: dynamicKubeconfigProvider,
 		nextStep:                  nextStep,
 		timeLimit:                 timeLimit,
+		pollInterval:              pollInterval,
 	}
 }
 
@@ -79,15 +82,15 @@ func (s *CreateBindingsForOperatorsStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *CreateBindingsForOperatorsStep) Run(cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
+func (s *CreateBindingsForOperatorsStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
 
 	var kubeconfig []byte
 	{
 		var err error
-		kubeconfig, err = s.dynamicKubeconfigProvider.FetchFromRequest(cluster.ClusterConfig.Name)
+		kubeconfig, err = s.dynamicKubeconfigProvider.FetchFromRequest(ctx, cluster.ClusterConfig.Name)
 		if err != nil {
 			// we cannot read kubeconfig from gardener cluster
-			return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+			return operations.StageResult{Stage: s.Name(), Delay: s.pollInterval}, nil
 		}
 	}
 
@@ -163,15 +166,15 @@ func (s *CreateBindingsForOperatorsStep) Run(cluster model.Cluster, _ model.Oper
 		}
 	}
 
-	if err := createClusterRoles(k8sClient.RbacV1().ClusterRoles(), clusterRoles); err != nil {
+	if err := createClusterRoles(ctx, k8sClient.RbacV1().ClusterRoles(), clusterRoles); err != nil {
 		return operations.StageResult{}, err
 	}
 
-	if err := k8sClient.RbacV1().ClusterRoleBindings().DeleteCollection(context.Background(), metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "reconciler.kyma-project.io/managed-by=reconciler,app=kyma"}); err != nil {
+	if err := k8sClient.RbacV1().ClusterRoleBindings().DeleteCollection(ctx, metav1.DeleteOptions{}, metav1.ListOptions{LabelSelector: "reconciler.kyma-project.io/managed-by=reconciler,app=kyma"}); err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(errors.Wrap(err, "failed to delete cluster role bindings")).SetComponent(apperrors.ErrClusterK8SClient)
 	}
 
-	if err := createClusterRoleBindings(k8sClient.RbacV1().ClusterRoleBindings(), clusterRoleBindings...); err != nil {
+	if err := createClusterRoleBindings(ctx, k8sClient.RbacV1().ClusterRoleBindings(), clusterRoleBindings...); err != nil {
 		return operations.StageResult{}, err
 	}
 
@@ -218,9 +221,9 @@ func buildClusterRole(name string, labels map[string]string, aggregationSelector
 	return cr
 }
 
-func createClusterRoles(crClient v1.ClusterRoleInterface, clusterRoles []v12.ClusterRole) error {
+func createClusterRoles(ctx context.Context, crClient v1.ClusterRoleInterface, clusterRoles []v12.ClusterRole) error {
 	for _, cr := range clusterRoles {
-		if _, err := crClient.Create(context.Background(), &cr, metav1.CreateOptions{}); err != nil {
+		if _, err := crClient.Create(ctx, &cr, metav1.CreateOptions{}); err != nil {
 			if !k8serrors.IsAlreadyExists(err) {
 				return util.K8SErrorToAppError(errors.Wrapf(err, "failed to create %s ClusterRole", cr.Name)).SetComponent(apperrors.ErrClusterK8SClient)
 			}
@@ -230,9 +233,9 @@ func createClusterRoles(crClient v1.ClusterRoleInterface, clusterRoles []v12.Clu
 	return nil
 }
 
-func createClusterRoleBindings(crbClient v1.ClusterRoleBindingInterface, clusterRoleBindings ...v12.ClusterRoleBinding) error {
+func createClusterRoleBindings(ctx context.Context, crbClient v1.ClusterRoleBindingInterface, clusterRoleBindings ...v12.ClusterRoleBinding) error {
 	for _, crb := range clusterRoleBindings {
-		if _, err := crbClient.Create(context.Background(), &crb, metav1.CreateOptions{}); err != nil {
+		if _, err := crbClient.Create(ctx, &crb, metav1.CreateOptions{}); err != nil {
 			if !k8serrors.IsAlreadyExists(err) {
 				return util.K8SErrorToAppError(errors.Wrapf(err, "failed to create %s ClusterRoleBinding", crb.Name)).SetComponent(apperrors.ErrClusterK8SClient)
 			}
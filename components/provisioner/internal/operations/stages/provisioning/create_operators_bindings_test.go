@@ -18,6 +18,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util/k8s/mocks"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -40,7 +41,7 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 	}
 
 	dynamicKubeconfigProvider := &provisioning_mocks.DynamicKubeconfigProvider{}
-	dynamicKubeconfigProvider.On("FetchFromRequest", "shoot").Return([]byte("dynamic_kubeconfig"), nil)
+	dynamicKubeconfigProvider.On("FetchFromRequest", mock.Anything, "shoot").Return([]byte("dynamic_kubeconfig"), nil)
 
 	t.Run("should return next step when finished", func(t *testing.T) {
 		// given
@@ -48,10 +49,10 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		k8sClientProvider := &mocks.K8sClientProvider{}
 		k8sClientProvider.On("CreateK8SClient", dynamicKubeconfig).Return(k8sClient, nil)
 
-		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -72,10 +73,10 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		k8sClientProvider := &mocks.K8sClientProvider{}
 		k8sClientProvider.On("CreateK8SClient", dynamicKubeconfig).Return(k8sClient, nil)
 
-		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -93,10 +94,10 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		k8sClientProvider := &mocks.K8sClientProvider{}
 		k8sClientProvider.On("CreateK8SClient", dynamicKubeconfig).Return(k8sClient, nil)
 
-		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -107,12 +108,12 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 	t.Run("should attempt retry when failed to get dynamic kubeconfig", func(t *testing.T) {
 		// given
 		dynamicKubeconfigProvider := &provisioning_mocks.DynamicKubeconfigProvider{}
-		dynamicKubeconfigProvider.On("FetchFromRequest", "shoot").Return(nil, errors.New("some error"))
+		dynamicKubeconfigProvider.On("FetchFromRequest", mock.Anything, "shoot").Return(nil, errors.New("some error"))
 
-		step := NewCreateBindingsForOperatorsStep(nil, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(nil, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		result, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		result, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.NoError(t, err)
@@ -125,10 +126,10 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		k8sClientProvider := &mocks.K8sClientProvider{}
 		k8sClientProvider.On("CreateK8SClient", dynamicKubeconfig).Return(nil, apperrors.Internal("error"))
 
-		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		_, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		_, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.Error(t, err)
@@ -147,10 +148,10 @@ func TestCreateBindingsForOperatorsStep_Run(t *testing.T) {
 		k8sClientProvider := &mocks.K8sClientProvider{}
 		k8sClientProvider.On("CreateK8SClient", dynamicKubeconfig).Return(k8sClient, nil)
 
-		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute)
+		step := NewCreateBindingsForOperatorsStep(k8sClientProvider, operatorBindingConfig, dynamicKubeconfigProvider, nextStageName, time.Minute, 20*time.Second)
 
 		// when
-		_, err := step.Run(cluster, model.Operation{}, &logrus.Entry{})
+		_, err := step.Run(context.Background(), cluster, model.Operation{}, &logrus.Entry{})
 
 		// then
 		require.Error(t, err)
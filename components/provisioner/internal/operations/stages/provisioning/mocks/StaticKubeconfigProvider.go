@@ -2,32 +2,36 @@
 
 package mocks
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "context"
+
+	mock "github.com/stretchr/testify/mock"
+)
 
 // StaticKubeconfigProvider is an autogenerated mock type for the StaticKubeconfigProvider type
 type StaticKubeconfigProvider struct {
 	mock.Mock
 }
 
-// FetchFromShoot provides a mock function with given fields: shootName
-func (_m *StaticKubeconfigProvider) FetchFromShoot(shootName string) ([]byte, error) {
-	ret := _m.Called(shootName)
+// FetchFromShoot provides a mock function with given fields: ctx, shootName
+func (_m *StaticKubeconfigProvider) FetchFromShoot(ctx context.Context, shootName string) ([]byte, error) {
+	ret := _m.Called(ctx, shootName)
 
 	var r0 []byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func(string) ([]byte, error)); ok {
-		return rf(shootName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
+		return rf(ctx, shootName)
 	}
-	if rf, ok := ret.Get(0).(func(string) []byte); ok {
-		r0 = rf(shootName)
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, shootName)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string) error); ok {
-		r1 = rf(shootName)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shootName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -23,21 +23,23 @@ type WaitForClusterCreationStep struct {
 	staticKubeconfigProvider StaticKubeconfigProvider
 	nextStep                 model.OperationStage
 	timeLimit                time.Duration
+	pollInterval             time.Duration
 }
 
 //go:generate mockery --name=StaticKubeconfigProvider
 type StaticKubeconfigProvider interface {
-	FetchFromShoot(shootName string) ([]byte, error)
+	FetchFromShoot(ctx context.Context, shootName string) ([]byte, error)
 }
 
-func NewWaitForClusterCreationStep(gardenerClient GardenerClient, dbSession dbsession.ReadWriteSession, staticKubeconfigProvider StaticKubeconfigProvider, nextStep model.OperationStage, timeLimit time.Duration) *WaitForClusterCreationStep {
+func NewWaitForClusterCreationStep(gardenerClient GardenerClient, dbSession dbsession.ReadWriteSession, staticKubeconfigProvider StaticKubeconfigProvider, nextStep model.OperationStage, timeLimit, pollInterval time.Duration) *WaitForClusterCreationStep {
 	return &WaitForClusterCreationStep{
 		gardenerClient:           gardenerClient,
 		dbSession:                dbSession,
 		staticKubeconfigProvider: staticKubeconfigProvider,
 
-		nextStep:  nextStep,
-		timeLimit: timeLimit,
+		nextStep:     nextStep,
+		timeLimit:    timeLimit,
+		pollInterval: pollInterval,
 	}
 }
 
@@ -49,8 +51,8 @@ func (s *WaitForClusterCreationStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
-	shoot, err := s.gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+func (s *WaitForClusterCreationStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger log.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 	}
@@ -59,7 +61,7 @@ func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operatio
 
 	if lastOperation != nil {
 		if lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
-			return s.proceedToInstallation(cluster, shoot)
+			return s.proceedToInstallation(ctx, cluster, shoot)
 		}
 
 		if lastOperation.State == gardencorev1beta1.LastOperationStateFailed {
@@ -85,10 +87,10 @@ func (s *WaitForClusterCreationStep) Run(cluster model.Cluster, _ model.Operatio
 		}
 	}
 
-	return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+	return operations.StageResult{Stage: s.Name(), Delay: s.pollInterval}, nil
 }
 
-func (s *WaitForClusterCreationStep) proceedToInstallation(cluster model.Cluster, shoot *gardener_types.Shoot) (operations.StageResult, error) {
+func (s *WaitForClusterCreationStep) proceedToInstallation(ctx context.Context, cluster model.Cluster, shoot *gardener_types.Shoot) (operations.StageResult, error) {
 
 	if cluster.ClusterConfig.Seed == "" && shoot.Spec.SeedName != nil && *shoot.Spec.SeedName != "" {
 
@@ -101,7 +103,7 @@ func (s *WaitForClusterCreationStep) proceedToInstallation(cluster model.Cluster
 		}
 	}
 
-	kubeconfig, err := s.staticKubeconfigProvider.FetchFromShoot(shoot.Name)
+	kubeconfig, err := s.staticKubeconfigProvider.FetchFromShoot(ctx, shoot.Name)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
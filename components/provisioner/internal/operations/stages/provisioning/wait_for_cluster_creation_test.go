@@ -75,7 +75,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			description: "should go to the next stage if cluster was created based on configuration with gardener seed provided",
 			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return([]byte("kubeconfig"), nil)
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return([]byte("kubeconfig"), nil)
 
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(nil)
 
@@ -88,7 +88,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			description: "should go to the next stage if cluster was created based on configuration without gardener seed provided",
 			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return([]byte("kubeconfig"), nil)
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return([]byte("kubeconfig"), nil)
 
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(nil)
 				dbSession.On("UpdateGardenerClusterConfig", cluster.ClusterConfig).Return(nil)
@@ -107,9 +107,9 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute)
+			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute, 20*time.Second)
 			// when
-			result, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -137,7 +137,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			description: "should return error if failed to fetch kubeconfig",
 			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededState(clusterName), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return(nil, errors.New("some error"))
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return(nil, errors.New("some error"))
 			},
 			unrecoverableError: false,
 			cluster:            cluster,
@@ -170,7 +170,7 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 			description: "should return error if failed to update kubeconfig data in database",
 			mockFunc: func(gardenerClient *gardener_mocks.GardenerClient, dbSession *dbMocks.ReadWriteSession, kubeconfigProvider *provisioning_mocks.KubeconfigProvider) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootInSucceededStateWithSeed(clusterName, "az-eu2"), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return([]byte("kubeconfig"), nil)
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return([]byte("kubeconfig"), nil)
 
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(dberrors.Internal("some error"))
 			},
@@ -196,10 +196,10 @@ func TestWaitForClusterInitialization_Run(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute)
+			waitForClusterCreationStep := NewWaitForClusterCreationStep(gardenerClient, dbSession, kubeconfigProvider, nextStageName, 10*time.Minute, 20*time.Second)
 
 			// when
-			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
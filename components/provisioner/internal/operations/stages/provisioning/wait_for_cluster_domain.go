@@ -47,8 +47,8 @@ func (s *WaitForClusterDomainStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForClusterDomainStep) Run(cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
-	shoot, err := s.gardenerClient.Get(context.Background(), cluster.ClusterConfig.Name, v1.GetOptions{})
+func (s *WaitForClusterDomainStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, log logrus.FieldLogger) (operations.StageResult, error) {
+	shoot, err := s.gardenerClient.Get(ctx, cluster.ClusterConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, util.K8SErrorToAppError(err).SetComponent(apperrors.ErrGardenerClient)
 	}
@@ -61,13 +61,13 @@ func (s *WaitForClusterDomainStep) Run(cluster model.Cluster, _ model.Operation,
 	// TODO: Consider updating Labels and StatusCondition separately without getting the Runtime
 	//       It'll be possible after this issue implementation:
 	//       - https://github.com/kyma-project/control-plane/issues/1186
-	runtimeInput, err := s.prepareProvisioningUpdateRuntimeInput(cluster.ID, cluster.Tenant, shoot)
+	runtimeInput, err := s.prepareProvisioningUpdateRuntimeInput(ctx, cluster.ID, cluster.Tenant, shoot)
 	if err != nil {
 		return operations.StageResult{}, err
 	}
 
 	err = util.RetryOnError(5*time.Second, 3, "Error while updating runtime in Director: %s", func() (err apperrors.AppError) {
-		err = s.directorClient.UpdateRuntime(cluster.ID, runtimeInput, cluster.Tenant)
+		err = s.directorClient.UpdateRuntime(ctx, cluster.ID, runtimeInput, cluster.Tenant)
 		return
 	})
 
@@ -78,11 +78,11 @@ func (s *WaitForClusterDomainStep) Run(cluster model.Cluster, _ model.Operation,
 	return operations.StageResult{Stage: s.nextStep, Delay: 0}, nil
 }
 
-func (s *WaitForClusterDomainStep) prepareProvisioningUpdateRuntimeInput(runtimeId, tenant string, shoot *gardener_types.Shoot) (*graphql.RuntimeUpdateInput, error) {
+func (s *WaitForClusterDomainStep) prepareProvisioningUpdateRuntimeInput(ctx context.Context, runtimeId, tenant string, shoot *gardener_types.Shoot) (*graphql.RuntimeUpdateInput, error) {
 	var runtime graphql.RuntimeExt
 
 	err := util.RetryOnError(5*time.Second, 3, "Error while getting runtime from Director: %s", func() (err apperrors.AppError) {
-		runtime, err = s.directorClient.GetRuntime(runtimeId, tenant)
+		runtime, err = s.directorClient.GetRuntime(ctx, runtimeId, tenant)
 		return
 	})
 	if err != nil {
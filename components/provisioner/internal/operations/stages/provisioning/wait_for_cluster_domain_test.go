@@ -83,8 +83,8 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 				runtime := fixRuntime(runtimeID, clusterName, map[string]interface{}{
 					"label": "value",
 				})
-				directorClient.On("GetRuntime", runtimeID, tenant).Return(runtime, nil)
-				directorClient.On("UpdateRuntime", runtimeID, mock.Anything, tenant).Return(nil)
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Return(runtime, nil)
+				directorClient.On("UpdateRuntime", mock.Anything, runtimeID, mock.Anything, tenant).Return(nil)
 			},
 			expectedStage: nextStageName,
 			expectedDelay: 0,
@@ -97,9 +97,9 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 				runtime := fixRuntime(runtimeID, clusterName, map[string]interface{}{
 					"label": "value",
 				})
-				directorClient.On("GetRuntime", runtimeID, tenant).Once().Return(graphql.RuntimeExt{}, apperrors.Internal("get runtime error"))
-				directorClient.On("GetRuntime", runtimeID, tenant).Once().Return(runtime, nil)
-				directorClient.On("UpdateRuntime", runtimeID, mock.Anything, tenant).Return(nil)
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Once().Return(graphql.RuntimeExt{}, apperrors.Internal("get runtime error"))
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Once().Return(runtime, nil)
+				directorClient.On("UpdateRuntime", mock.Anything, runtimeID, mock.Anything, tenant).Return(nil)
 			},
 			expectedStage: nextStageName,
 			expectedDelay: 0,
@@ -112,9 +112,9 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 				runtime := fixRuntime(runtimeID, clusterName, map[string]interface{}{
 					"label": "value",
 				})
-				directorClient.On("GetRuntime", runtimeID, tenant).Return(runtime, nil)
-				directorClient.On("UpdateRuntime", runtimeID, mock.Anything, tenant).Once().Return(apperrors.Internal("update runtime error"))
-				directorClient.On("UpdateRuntime", runtimeID, mock.Anything, tenant).Once().Return(nil)
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Return(runtime, nil)
+				directorClient.On("UpdateRuntime", mock.Anything, runtimeID, mock.Anything, tenant).Once().Return(apperrors.Internal("update runtime error"))
+				directorClient.On("UpdateRuntime", mock.Anything, runtimeID, mock.Anything, tenant).Once().Return(nil)
 			},
 			expectedStage: nextStageName,
 			expectedDelay: 0,
@@ -130,7 +130,7 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 			waitForClusterDomainStep := NewWaitForClusterDomainStep(gardenerClient, directorClient, nextStageName, 10*time.Minute)
 
 			// when
-			result, err := waitForClusterDomainStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForClusterDomainStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -159,7 +159,7 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 			description: "should return error if failed to get Runtime from Director",
 			mockFunc: func(gardenerClient *gardenerMocks.GardenerClient, directorClient *directormock.DirectorClient) {
 				gardenerClient.On("Get", context.Background(), clusterName, mock.Anything).Return(fixShootWithDomainSet(clusterName, domain), nil)
-				directorClient.On("GetRuntime", runtimeID, tenant).Return(graphql.RuntimeExt{}, apperrors.Internal("some error"))
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Return(graphql.RuntimeExt{}, apperrors.Internal("some error"))
 
 			},
 			unrecoverableError: false,
@@ -173,8 +173,8 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 				runtime := fixRuntime(runtimeID, clusterName, map[string]interface{}{
 					"label": "value",
 				})
-				directorClient.On("GetRuntime", runtimeID, tenant).Return(runtime, nil)
-				directorClient.On("UpdateRuntime", runtimeID, mock.Anything, tenant).Return(apperrors.Internal("some error"))
+				directorClient.On("GetRuntime", mock.Anything, runtimeID, tenant).Return(runtime, nil)
+				directorClient.On("UpdateRuntime", mock.Anything, runtimeID, mock.Anything, tenant).Return(apperrors.Internal("some error"))
 			},
 			unrecoverableError: false,
 			cluster:            cluster,
@@ -190,7 +190,7 @@ func TestWaitForClusterDomain_Run(t *testing.T) {
 			waitForClusterDomainStep := NewWaitForClusterDomainStep(gardenerClient, directorClient, nextStageName, 10*time.Minute)
 
 			// when
-			_, err := waitForClusterDomainStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterDomainStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
@@ -34,11 +34,11 @@ func (s *WaitForShootNewVersionStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForShootNewVersionStep) Run(cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForShootNewVersionStep) Run(ctx context.Context, cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
 
 	gardenerConfig := cluster.ClusterConfig
 
-	shoot, err := s.gardenerClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+	shoot, err := s.gardenerClient.Get(ctx, gardenerConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -24,13 +24,14 @@ type GardenerClient interface {
 
 //go:generate mockery --name=KubeconfigProvider
 type KubeconfigProvider interface {
-	FetchFromShoot(shootName string) ([]byte, error)
+	FetchFromShoot(ctx context.Context, shootName string) ([]byte, error)
 }
 
 type WaitForShootUpgradeStep struct {
 	gardenerClient GardenerClient
 	nextStep       model.OperationStage
 	timeLimit      time.Duration
+	pollInterval   time.Duration
 
 	dbSession          dbsession.ReadWriteSession
 	kubeconfigProvider KubeconfigProvider
@@ -38,13 +39,14 @@ type WaitForShootUpgradeStep struct {
 
 func NewWaitForShootUpgradeStep(gardenerClient GardenerClient,
 	dbSession dbsession.ReadWriteSession, kubeconfigProvider KubeconfigProvider,
-	nextStep model.OperationStage, timeLimit time.Duration,
+	nextStep model.OperationStage, timeLimit, pollInterval time.Duration,
 ) *WaitForShootUpgradeStep {
 
 	return &WaitForShootUpgradeStep{
 		gardenerClient: gardenerClient,
 		nextStep:       nextStep,
 		timeLimit:      timeLimit,
+		pollInterval:   pollInterval,
 
 		dbSession:          dbSession,
 		kubeconfigProvider: kubeconfigProvider,
@@ -59,11 +61,11 @@ func (s *WaitForShootUpgradeStep) TimeLimit() time.Duration {
 	return s.timeLimit
 }
 
-func (s *WaitForShootUpgradeStep) Run(cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
+func (s *WaitForShootUpgradeStep) Run(ctx context.Context, cluster model.Cluster, _ model.Operation, logger logrus.FieldLogger) (operations.StageResult, error) {
 
 	gardenerConfig := cluster.ClusterConfig
 
-	shoot, err := s.gardenerClient.Get(context.Background(), gardenerConfig.Name, v1.GetOptions{})
+	shoot, err := s.gardenerClient.Get(ctx, gardenerConfig.Name, v1.GetOptions{})
 	if err != nil {
 		return operations.StageResult{}, err
 	}
@@ -73,7 +75,7 @@ func (s *WaitForShootUpgradeStep) Run(cluster model.Cluster, _ model.Operation,
 	if lastOperation != nil {
 		if lastOperation.State == gardencorev1beta1.LastOperationStateSucceeded {
 
-			kubeconfig, err := s.kubeconfigProvider.FetchFromShoot(shoot.Name)
+			kubeconfig, err := s.kubeconfigProvider.FetchFromShoot(ctx, shoot.Name)
 			if err != nil {
 				return operations.StageResult{}, err
 			}
@@ -96,5 +98,5 @@ func (s *WaitForShootUpgradeStep) Run(cluster model.Cluster, _ model.Operation,
 		}
 	}
 
-	return operations.StageResult{Stage: s.Name(), Delay: 20 * time.Second}, nil
+	return operations.StageResult{Stage: s.Name(), Delay: s.pollInterval}, nil
 }
@@ -91,7 +91,7 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 					testkit.NewTestShoot(clusterName).
 						WithOperationSucceeded().
 						ToShoot(), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return([]byte("kubeconfig"), nil)
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return([]byte("kubeconfig"), nil)
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(nil)
 			},
 			expectedStage: model.FinishedStage,
@@ -106,9 +106,9 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForShootClusterUpgradeStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute)
+			waitForShootClusterUpgradeStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute, 20*time.Second)
 			// when
-			result, err := waitForShootClusterUpgradeStep.Run(cluster, model.Operation{}, logrus.New())
+			result, err := waitForShootClusterUpgradeStep.Run(context.Background(), cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.NoError(t, err)
@@ -151,7 +151,7 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 					testkit.NewTestShoot(clusterName).
 						WithOperationSucceeded().
 						ToShoot(), nil)
-				kubeconfigProvider.On("FetchFromShoot", clusterName).Return([]byte("kubeconfig"), nil)
+				kubeconfigProvider.On("FetchFromShoot", mock.Anything, clusterName).Return([]byte("kubeconfig"), nil)
 				dbSession.On("UpdateKubeconfig", cluster.ID, "kubeconfig").Return(dberrors.Internal("error"))
 			},
 			unrecoverableError: false,
@@ -177,10 +177,10 @@ func TestWaitForClusterUpgrade(t *testing.T) {
 
 			testCase.mockFunc(gardenerClient, dbSession, kubeconfigProvider)
 
-			waitForClusterCreationStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute)
+			waitForClusterCreationStep := NewWaitForShootUpgradeStep(gardenerClient, dbSession, kubeconfigProvider, model.FinishedStage, time.Minute, 20*time.Second)
 
 			// when
-			_, err := waitForClusterCreationStep.Run(testCase.cluster, model.Operation{}, logrus.New())
+			_, err := waitForClusterCreationStep.Run(context.Background(), testCase.cluster, model.Operation{}, logrus.New())
 
 			// then
 			require.Error(t, err)
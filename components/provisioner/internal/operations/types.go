@@ -1,6 +1,7 @@
 package operations
 
 import (
+	"context"
 	"time"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
@@ -16,7 +17,7 @@ type ProcessingResult struct {
 
 type Step interface {
 	Name() model.OperationStage
-	Run(cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error)
+	Run(ctx context.Context, cluster model.Cluster, operation model.Operation, logger logrus.FieldLogger) (StageResult, error)
 	TimeLimit() time.Duration
 }
 
@@ -37,8 +38,10 @@ func NewNonRecoverableError(err error) NonRecoverableError {
 	return NonRecoverableError{error: err}
 }
 
+// FailureHandler reacts to the non-recoverable failure of the operation.
+// It reports whether it removed or rolled back the resources of the Runtime, in which case the operation cannot be retried.
 type FailureHandler interface {
-	HandleFailure(operation model.Operation, cluster model.Cluster) error
+	HandleFailure(ctx context.Context, operation model.Operation, cluster model.Cluster) (bool, error)
 }
 
 func ConvertToAppError(err error) apperrors.AppError {
@@ -0,0 +1,82 @@
+package keyrotation
+
+import (
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/sirupsen/logrus"
+)
+
+// ReEncryptionJob re-encrypts kubeconfigs and cluster administrators encrypted with retired keys
+// using the active encryption key, so that the retired keys can be removed from the keyring.
+//
+// Values stored in plain text or encrypted in the legacy format without a key ID are re-encrypted only if legacy values migration is enabled.
+// The migration cannot be reverted: once re-encrypted, the values can no longer be read by Provisioner versions which do not support the keyring.
+type ReEncryptionJob struct {
+	session       dbsession.ReadWriteSession
+	batchSize     int
+	migrateLegacy bool
+	log           logrus.FieldLogger
+}
+
+func NewReEncryptionJob(session dbsession.ReadWriteSession, batchSize int, migrateLegacy bool) *ReEncryptionJob {
+	return &ReEncryptionJob{
+		session:       session,
+		batchSize:     batchSize,
+		migrateLegacy: migrateLegacy,
+		log:           logrus.WithField("Component", "ReEncryptionJob"),
+	}
+}
+
+// Run re-encrypts the records in batches and logs the progress after each batch. Records which cannot be decrypted are skipped.
+// It returns without changes if no record needs to be re-encrypted.
+func (j *ReEncryptionJob) Run() {
+	progress, dberr := j.session.CountRecordsToReEncrypt(j.migrateLegacy)
+	if dberr != nil {
+		j.log.Errorf("failed to count records to re-encrypt: %s", dberr.Error())
+		return
+	}
+
+	if progress.Kubeconfigs == 0 && progress.Administrators == 0 {
+		j.log.Info("No records to re-encrypt with the active key")
+		return
+	}
+
+	j.reEncrypt("kubeconfigs", progress.Kubeconfigs, j.session.ReEncryptKubeconfigs)
+	j.reEncrypt("administrators", progress.Administrators, j.session.ReEncryptAdministrators)
+}
+
+func (j *ReEncryptionJob) reEncrypt(records string, total int, reEncryptBatch func(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error)) {
+	if total == 0 {
+		return
+	}
+
+	log := j.log.WithField("Records", records)
+	log.Infof("Re-encrypting %d records with the active key", total)
+
+	var summary model.ReEncryptionBatch
+	for {
+		batch, dberr := reEncryptBatch(summary.LastID, j.batchSize, j.migrateLegacy)
+		summary.Processed += batch.Processed
+		summary.ReEncrypted += batch.ReEncrypted
+		summary.Failed += batch.Failed
+
+		if dberr != nil {
+			log.Errorf("failed to re-encrypt records after %d of %d processed: %s", summary.Processed, total, dberr.Error())
+			return
+		}
+
+		if batch.Processed == 0 {
+			break
+		}
+		summary.LastID = batch.LastID
+
+		log.Infof("Re-encryption progress: %d of %d processed, %d re-encrypted, %d failed", summary.Processed, total, summary.ReEncrypted, summary.Failed)
+
+		if batch.Processed < j.batchSize {
+			break
+		}
+	}
+
+	log.Infof("Re-encryption finished: %d of %d processed, %d re-encrypted, %d failed", summary.Processed, total, summary.ReEncrypted, summary.Failed)
+}
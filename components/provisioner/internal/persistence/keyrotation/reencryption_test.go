@@ -0,0 +1,71 @@
+package keyrotation
+
+import (
+	"testing"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+)
+
+func TestReEncryptionJob_Run(t *testing.T) {
+	t.Run("should re-encrypt records in batches", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+
+		session.On("CountRecordsToReEncrypt", true).Return(model.ReEncryptionProgress{Kubeconfigs: 3, Administrators: 2}, nil)
+		session.On("ReEncryptKubeconfigs", "", 2, true).Return(model.ReEncryptionBatch{LastID: "id-2", Processed: 2, ReEncrypted: 2}, nil).Once()
+		session.On("ReEncryptKubeconfigs", "id-2", 2, true).Return(model.ReEncryptionBatch{LastID: "id-3", Processed: 1, Failed: 1}, nil).Once()
+		session.On("ReEncryptAdministrators", "", 2, true).Return(model.ReEncryptionBatch{LastID: "admin-2", Processed: 2, ReEncrypted: 2}, nil).Once()
+		session.On("ReEncryptAdministrators", "admin-2", 2, true).Return(model.ReEncryptionBatch{LastID: "admin-2"}, nil).Once()
+
+		// when
+		NewReEncryptionJob(session, 2, true).Run()
+
+		// then
+		session.AssertExpectations(t)
+	})
+
+	t.Run("should continue with administrators when failed to re-encrypt kubeconfigs", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+
+		session.On("CountRecordsToReEncrypt", false).Return(model.ReEncryptionProgress{Kubeconfigs: 3, Administrators: 1}, nil)
+		session.On("ReEncryptKubeconfigs", "", 100, false).Return(model.ReEncryptionBatch{}, dberrors.Internal("error")).Once()
+		session.On("ReEncryptAdministrators", "", 100, false).Return(model.ReEncryptionBatch{LastID: "admin-1", Processed: 1, ReEncrypted: 1}, nil).Once()
+
+		// when
+		NewReEncryptionJob(session, 100, false).Run()
+
+		// then
+		session.AssertExpectations(t)
+	})
+
+	t.Run("should not re-encrypt when no records are encrypted with retired keys", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+
+		session.On("CountRecordsToReEncrypt", false).Return(model.ReEncryptionProgress{}, nil)
+
+		// when
+		NewReEncryptionJob(session, 100, false).Run()
+
+		// then
+		session.AssertExpectations(t)
+		session.AssertNotCalled(t, "ReEncryptKubeconfigs")
+		session.AssertNotCalled(t, "ReEncryptAdministrators")
+	})
+
+	t.Run("should not re-encrypt when failed to count records", func(t *testing.T) {
+		// given
+		session := &sessionMocks.ReadWriteSession{}
+
+		session.On("CountRecordsToReEncrypt", true).Return(model.ReEncryptionProgress{}, dberrors.Internal("error"))
+
+		// when
+		NewReEncryptionJob(session, 100, true).Run()
+
+		// then
+		session.AssertExpectations(t)
+	})
+}
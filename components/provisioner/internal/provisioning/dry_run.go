@@ -0,0 +1,55 @@
+package provisioning
+
+import (
+	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+	"github.com/pmezard/go-difflib/difflib"
+	"sigs.k8s.io/yaml"
+)
+
+// newDryRunResult renders the Shoot returned by the dry run as YAML. If the current Shoot is provided,
+// the result contains the unified diff between the current and the rendered Shoot.
+func newDryRunResult(currentShoot, renderedShoot *gardener_Types.Shoot) (*gqlschema.DryRunResult, apperrors.AppError) {
+	renderedManifest, err := shootManifest(renderedShoot)
+	if err != nil {
+		return nil, err.Append("Failed to render Shoot manifest")
+	}
+
+	result := &gqlschema.DryRunResult{Shoot: renderedManifest}
+	if currentShoot == nil {
+		return result, nil
+	}
+
+	currentManifest, err := shootManifest(currentShoot)
+	if err != nil {
+		return nil, err.Append("Failed to render current Shoot manifest")
+	}
+
+	diff, diffErr := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(currentManifest),
+		B:        difflib.SplitLines(renderedManifest),
+		FromFile: "current",
+		ToFile:   "dry-run",
+		Context:  3,
+	})
+	if diffErr != nil {
+		return nil, apperrors.Internal("Failed to compute Shoot diff: %s", diffErr.Error())
+	}
+	result.Diff = &diff
+
+	return result, nil
+}
+
+func shootManifest(shoot *gardener_Types.Shoot) (string, apperrors.AppError) {
+	shoot = shoot.DeepCopy()
+	// Managed fields only add noise to the manifest and the diff
+	shoot.ManagedFields = nil
+
+	data, err := yaml.Marshal(shoot)
+	if err != nil {
+		return "", apperrors.Internal("Failed to encode Shoot %s: %s", shoot.Name, err.Error())
+	}
+
+	return string(data), nil
+}
@@ -2,6 +2,7 @@ package provisioning
 
 import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 )
 
@@ -21,6 +22,18 @@ func (c graphQLConverter) RuntimeStatusToGraphQLStatus(status model.RuntimeStatu
 		LastOperationStatus:     c.OperationStatusToGQLOperationStatus(status.LastOperationStatus),
 		RuntimeConnectionStatus: c.runtimeConnectionStatusToGraphQLStatus(status.RuntimeConnectionStatus),
 		RuntimeConfiguration:    c.clusterToToGraphQLRuntimeConfiguration(status.RuntimeConfiguration),
+		HibernationStatus:       c.hibernationStatusToGraphQLStatus(status.HibernationStatus),
+	}
+}
+
+func (c graphQLConverter) hibernationStatusToGraphQLStatus(status *model.HibernationStatus) *gqlschema.HibernationStatus {
+	if status == nil {
+		return nil
+	}
+
+	return &gqlschema.HibernationStatus{
+		Hibernated:          &status.Hibernated,
+		HibernationPossible: &status.HibernationPossible,
 	}
 }
 
@@ -36,9 +49,37 @@ func (c graphQLConverter) OperationStatusToGQLOperationStatus(operation model.Op
 			Reason:     operation.Reason,
 			Component:  operation.Component,
 		},
+		StageHistory: c.stageHistoryToGraphQLStageHistory(operation.StageHistory),
+		Warnings:     upgradeWarningsToGraphQL(operation.Warnings),
 	}
 }
 
+func (c graphQLConverter) stageHistoryToGraphQLStageHistory(history []model.OperationStageHistoryEntry) []*gqlschema.StageHistoryEntry {
+	if history == nil {
+		return nil
+	}
+
+	entries := make([]*gqlschema.StageHistoryEntry, 0, len(history))
+	for _, entry := range history {
+		gqlEntry := &gqlschema.StageHistoryEntry{
+			Stage:     string(entry.Stage),
+			Message:   util.StringPtr(entry.Message),
+			Timestamp: entry.EventTimestamp,
+			Retries:   entry.Retries,
+		}
+		if entry.ErrMessage != "" {
+			gqlEntry.LastError = &gqlschema.LastError{
+				ErrMessage: entry.ErrMessage,
+				Reason:     entry.Reason,
+				Component:  entry.Component,
+			}
+		}
+		entries = append(entries, gqlEntry)
+	}
+
+	return entries
+}
+
 func (c graphQLConverter) runtimeConnectionStatusToGraphQLStatus(status model.RuntimeAgentConnectionStatus) *gqlschema.RuntimeConnectionStatus {
 	return &gqlschema.RuntimeConnectionStatus{Status: c.runtimeAgentConnectionStatusToGraphQLStatus(status)}
 }
@@ -104,6 +145,135 @@ func (c graphQLConverter) gardenerConfigToGraphQLConfig(config model.GardenerCon
 		ShootNetworkingFilterDisabled:       config.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        config.ControlPlaneFailureTolerance,
 		EuAccess:                            &config.EuAccess,
+		HibernationSchedules:                c.hibernationSchedulesToGraphQLSchedules(config.HibernationSchedules),
+		AdditionalWorkerPools:               c.workerPoolsToGraphQLWorkerPools(config.AdditionalWorkerPools),
+		WorkerLabels:                        c.labelsToGraphQLLabels(config.WorkerLabels),
+		WorkerTaints:                        c.taintsToGraphQLTaints(config.WorkerTaints),
+		KubeletConfig:                       c.kubeletConfigToGraphQLConfig(config.KubeletConfig),
+		CriName:                             config.CRIName,
+		Extensions:                          c.extensionsToGraphQLExtensions(config.Extensions),
+	}
+}
+
+func (c graphQLConverter) extensionsToGraphQLExtensions(extensions []model.Extension) []*gqlschema.Extension {
+	if extensions == nil {
+		return nil
+	}
+
+	graphQLExtensions := make([]*gqlschema.Extension, 0, len(extensions))
+	for _, extension := range extensions {
+		graphQLExtensions = append(graphQLExtensions, &gqlschema.Extension{
+			Type:           extension.Type,
+			Disabled:       extension.Disabled,
+			ProviderConfig: extension.ProviderConfig,
+		})
+	}
+
+	return graphQLExtensions
+}
+
+func (c graphQLConverter) hibernationSchedulesToGraphQLSchedules(schedules []model.HibernationSchedule) []*gqlschema.HibernationSchedule {
+	if schedules == nil {
+		return nil
+	}
+
+	graphQLSchedules := make([]*gqlschema.HibernationSchedule, 0, len(schedules))
+	for _, schedule := range schedules {
+		graphQLSchedules = append(graphQLSchedules, &gqlschema.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+
+	return graphQLSchedules
+}
+
+func (c graphQLConverter) workerPoolsToGraphQLWorkerPools(pools []model.WorkerPool) []*gqlschema.WorkerPool {
+	if pools == nil {
+		return nil
+	}
+
+	graphQLPools := make([]*gqlschema.WorkerPool, 0, len(pools))
+	for _, pool := range pools {
+		graphQLPool := &gqlschema.WorkerPool{
+			Name:                pool.Name,
+			MachineType:         pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGb:        pool.VolumeSizeGB,
+			AutoScalerMin:       pool.AutoScalerMin,
+			AutoScalerMax:       pool.AutoScalerMax,
+			MaxSurge:            pool.MaxSurge,
+			MaxUnavailable:      pool.MaxUnavailable,
+			Zones:               pool.Zones,
+			Labels:              c.labelsToGraphQLLabels(pool.Labels),
+			Taints:              c.taintsToGraphQLTaints(pool.Taints),
+			KubeletConfig:       c.kubeletConfigToGraphQLConfig(pool.KubeletConfig),
+			CriName:             pool.CRIName,
+		}
+
+		graphQLPools = append(graphQLPools, graphQLPool)
+	}
+
+	return graphQLPools
+}
+
+func (c graphQLConverter) labelsToGraphQLLabels(labels map[string]string) gqlschema.Labels {
+	if labels == nil {
+		return nil
+	}
+
+	graphQLLabels := gqlschema.Labels{}
+	for key, value := range labels {
+		graphQLLabels[key] = value
+	}
+
+	return graphQLLabels
+}
+
+func (c graphQLConverter) taintsToGraphQLTaints(taints []model.Taint) []*gqlschema.Taint {
+	if taints == nil {
+		return nil
+	}
+
+	graphQLTaints := make([]*gqlschema.Taint, 0, len(taints))
+	for _, taint := range taints {
+		graphQLTaints = append(graphQLTaints, &gqlschema.Taint{
+			Key:    taint.Key,
+			Value:  util.StringPtr(taint.Value),
+			Effect: taint.Effect,
+		})
+	}
+
+	return graphQLTaints
+}
+
+func (c graphQLConverter) kubeletConfigToGraphQLConfig(config *model.KubeletConfig) *gqlschema.KubeletConfig {
+	if config == nil {
+		return nil
+	}
+
+	return &gqlschema.KubeletConfig{
+		MaxPods:                 config.MaxPods,
+		EvictionHard:            c.evictionThresholdsToGraphQLThresholds(config.EvictionHard),
+		EvictionSoft:            c.evictionThresholdsToGraphQLThresholds(config.EvictionSoft),
+		EvictionSoftGracePeriod: c.evictionThresholdsToGraphQLThresholds(config.EvictionSoftGracePeriod),
+	}
+}
+
+func (c graphQLConverter) evictionThresholdsToGraphQLThresholds(thresholds *model.EvictionThresholds) *gqlschema.EvictionThresholds {
+	if thresholds == nil {
+		return nil
+	}
+
+	return &gqlschema.EvictionThresholds{
+		MemoryAvailable:   thresholds.MemoryAvailable,
+		ImageFSAvailable:  thresholds.ImageFSAvailable,
+		ImageFSInodesFree: thresholds.ImageFSInodesFree,
+		NodeFSAvailable:   thresholds.NodeFSAvailable,
+		NodeFSInodesFree:  thresholds.NodeFSInodesFree,
 	}
 }
 
@@ -176,6 +346,8 @@ func (c graphQLConverter) operationTypeToGraphQLType(operationType model.Operati
 		return gqlschema.OperationTypeReconnectRuntime
 	case model.Hibernate:
 		return gqlschema.OperationTypeHibernate
+	case model.WakeUp:
+		return gqlschema.OperationTypeWakeUp
 	default:
 		return ""
 	}
@@ -189,6 +361,8 @@ func (c graphQLConverter) operationStateToGraphQLState(state model.OperationStat
 		return gqlschema.OperationStateSucceeded
 	case model.Failed:
 		return gqlschema.OperationStateFailed
+	case model.Cancelled:
+		return gqlschema.OperationStateCancelled
 	default:
 		return ""
 	}
@@ -236,3 +410,62 @@ func (c graphQLConverter) dnsConfigToGraphQLConfig(config *model.DNSConfig) *gql
 
 	return &gqlConfig
 }
+
+func upgradeWarningsToGraphQL(warnings []model.UpgradeWarning) []*gqlschema.UpgradeWarning {
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	result := make([]*gqlschema.UpgradeWarning, 0, len(warnings))
+	for _, warning := range warnings {
+		result = append(result, &gqlschema.UpgradeWarning{
+			Code:    gqlschema.UpgradeWarningCode(warning.Code),
+			Message: warning.Message,
+		})
+	}
+
+	return result
+}
+
+func versionDriftGroupsToGraphQL(groups []model.VersionDriftGroup) []*gqlschema.VersionDriftGroup {
+	result := make([]*gqlschema.VersionDriftGroup, 0, len(groups))
+	for _, group := range groups {
+		runtimes := make([]*gqlschema.RuntimeVersionDrift, 0, len(group.Runtimes))
+		for _, runtime := range group.Runtimes {
+			runtimeDrift := &gqlschema.RuntimeVersionDrift{
+				RuntimeID:         runtime.RuntimeID,
+				ShootName:         runtime.ShootName,
+				KubernetesVersion: expiringVersionToGraphQL(runtime.KubernetesVersion),
+			}
+			for i := range runtime.MachineImages {
+				runtimeDrift.MachineImages = append(runtimeDrift.MachineImages, expiringVersionToGraphQL(&runtime.MachineImages[i]))
+			}
+			runtimes = append(runtimes, runtimeDrift)
+		}
+
+		result = append(result, &gqlschema.VersionDriftGroup{
+			Provider: group.Provider,
+			Region:   group.Region,
+			Runtimes: runtimes,
+		})
+	}
+
+	return result
+}
+
+func expiringVersionToGraphQL(version *model.ExpiringVersion) *gqlschema.ExpiringVersion {
+	if version == nil {
+		return nil
+	}
+
+	gqlVersion := &gqlschema.ExpiringVersion{
+		Version:        version.Version,
+		ExpirationDate: version.ExpirationDate,
+		Expired:        version.Expired,
+	}
+	if version.Name != "" {
+		gqlVersion.Name = &version.Name
+	}
+
+	return gqlVersion
+}
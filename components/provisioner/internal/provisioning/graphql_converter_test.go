@@ -99,6 +99,7 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 		require.NoError(t, err)
 
 		runtimeStatus := model.RuntimeStatus{
+			HibernationStatus: &model.HibernationStatus{Hibernated: true, HibernationPossible: true},
 			LastOperationStatus: model.Operation{
 				ID:        "5f6e3ab6-d803-430a-8fac-29c9c9b4485a",
 				Type:      model.Deprovision,
@@ -162,6 +163,10 @@ func TestRuntimeStatusToGraphQLStatus(t *testing.T) {
 			RuntimeConnectionStatus: &gqlschema.RuntimeConnectionStatus{
 				Status: gqlschema.RuntimeAgentConnectionStatusDisconnected,
 			},
+			HibernationStatus: &gqlschema.HibernationStatus{
+				Hibernated:          util.BoolPtr(true),
+				HibernationPossible: util.BoolPtr(true),
+			},
 			RuntimeConfiguration: &gqlschema.RuntimeConfig{
 				ClusterConfig: &gqlschema.GardenerConfig{
 					Name:                                &clusterName,
@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"fmt"
+
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
@@ -119,9 +121,136 @@ func (c converter) gardenerConfigFromInput(runtimeID string, input *gqlschema.Ga
 		ShootNetworkingFilterDisabled:       input.ShootNetworkingFilterDisabled,
 		ControlPlaneFailureTolerance:        input.ControlPlaneFailureTolerance,
 		EuAccess:                            util.UnwrapBoolOrDefault(input.EuAccess, c.defaultEuAccess),
+		HibernationSchedules:                hibernationSchedulesFromInput(input.HibernationSchedules),
+		AdditionalWorkerPools:               workerPoolsFromInput(input.AdditionalWorkerPools),
+		WorkerLabels:                        labelsFromInput(input.WorkerLabels),
+		WorkerTaints:                        taintsFromInput(input.WorkerTaints),
+		KubeletConfig:                       kubeletConfigFromInput(input.KubeletConfig),
+		CRIName:                             input.CriName,
+		Extensions:                          extensionsFromInput(input.Extensions),
 	}, nil
 }
 
+func extensionsFromInput(input []*gqlschema.ExtensionInput) []model.Extension {
+	if input == nil {
+		return nil
+	}
+
+	extensions := make([]model.Extension, 0, len(input))
+	for _, extension := range input {
+		extensions = append(extensions, model.Extension{
+			Type:           extension.Type,
+			Disabled:       extension.Disabled,
+			ProviderConfig: extension.ProviderConfig,
+		})
+	}
+
+	return extensions
+}
+
+func hibernationSchedulesFromInput(input []*gqlschema.HibernationScheduleInput) []model.HibernationSchedule {
+	if input == nil {
+		return nil
+	}
+
+	schedules := make([]model.HibernationSchedule, 0, len(input))
+	for _, schedule := range input {
+		schedules = append(schedules, model.HibernationSchedule{
+			Start:    schedule.Start,
+			End:      schedule.End,
+			Location: schedule.Location,
+		})
+	}
+
+	return schedules
+}
+
+func workerPoolsFromInput(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	if input == nil {
+		return nil
+	}
+
+	pools := make([]model.WorkerPool, 0, len(input))
+	for _, pool := range input {
+		pools = append(pools, model.WorkerPool{
+			Name:                pool.Name,
+			MachineType:         pool.MachineType,
+			MachineImage:        pool.MachineImage,
+			MachineImageVersion: pool.MachineImageVersion,
+			DiskType:            pool.DiskType,
+			VolumeSizeGB:        pool.VolumeSizeGb,
+			AutoScalerMin:       pool.AutoScalerMin,
+			AutoScalerMax:       pool.AutoScalerMax,
+			MaxSurge:            pool.MaxSurge,
+			MaxUnavailable:      pool.MaxUnavailable,
+			Zones:               pool.Zones,
+			Labels:              labelsFromInput(pool.Labels),
+			Taints:              taintsFromInput(pool.Taints),
+			KubeletConfig:       kubeletConfigFromInput(pool.KubeletConfig),
+			CRIName:             pool.CriName,
+		})
+	}
+
+	return pools
+}
+
+func labelsFromInput(input gqlschema.Labels) map[string]string {
+	if input == nil {
+		return nil
+	}
+
+	labels := make(map[string]string, len(input))
+	for key, value := range input {
+		labels[key] = fmt.Sprint(value)
+	}
+
+	return labels
+}
+
+func taintsFromInput(input []*gqlschema.TaintInput) []model.Taint {
+	if input == nil {
+		return nil
+	}
+
+	taints := make([]model.Taint, 0, len(input))
+	for _, taint := range input {
+		taints = append(taints, model.Taint{
+			Key:    taint.Key,
+			Value:  util.UnwrapStr(taint.Value),
+			Effect: taint.Effect,
+		})
+	}
+
+	return taints
+}
+
+func kubeletConfigFromInput(input *gqlschema.KubeletConfigInput) *model.KubeletConfig {
+	if input == nil {
+		return nil
+	}
+
+	return &model.KubeletConfig{
+		MaxPods:                 input.MaxPods,
+		EvictionHard:            evictionThresholdsFromInput(input.EvictionHard),
+		EvictionSoft:            evictionThresholdsFromInput(input.EvictionSoft),
+		EvictionSoftGracePeriod: evictionThresholdsFromInput(input.EvictionSoftGracePeriod),
+	}
+}
+
+func evictionThresholdsFromInput(input *gqlschema.EvictionThresholdsInput) *model.EvictionThresholds {
+	if input == nil {
+		return nil
+	}
+
+	return &model.EvictionThresholds{
+		MemoryAvailable:   input.MemoryAvailable,
+		ImageFSAvailable:  input.ImageFSAvailable,
+		ImageFSInodesFree: input.ImageFSInodesFree,
+		NodeFSAvailable:   input.NodeFSAvailable,
+		NodeFSInodesFree:  input.NodeFSInodesFree,
+	}
+}
+
 func oidcConfigFromInput(config *gqlschema.OIDCConfigInput) *model.OIDCConfig {
 	if config != nil {
 		return &model.OIDCConfig{
@@ -184,6 +313,8 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		Region:       config.Region,
 		LicenceType:  config.LicenceType,
 		WorkerCidr:   config.WorkerCidr,
+		PodsCIDR:     config.PodsCIDR,
+		ServicesCIDR: config.ServicesCIDR,
 
 		Purpose:                             util.DefaultStrIfNil(input.Purpose, config.Purpose),
 		KubernetesVersion:                   util.UnwrapStrOrDefault(input.KubernetesVersion, config.KubernetesVersion),
@@ -202,28 +333,119 @@ func (c converter) UpgradeShootInputToGardenerConfig(input gqlschema.GardenerUpg
 		OIDCConfig:                          oidcConfigFromInput(input.OidcConfig),
 		ExposureClassName:                   util.DefaultStrIfNil(input.ExposureClassName, config.ExposureClassName),
 		ShootNetworkingFilterDisabled:       util.DefaultBoolIfNil(input.ShootNetworkingFilterDisabled, config.ShootNetworkingFilterDisabled),
+		HibernationSchedules:                upgradedHibernationSchedules(input.HibernationSchedules, config.HibernationSchedules),
+		AdditionalWorkerPools:               upgradedWorkerPools(input.AdditionalWorkerPools),
+		WorkerLabels:                        upgradedLabels(input.WorkerLabels, config.WorkerLabels),
+		WorkerTaints:                        upgradedTaints(input.WorkerTaints, config.WorkerTaints),
+		KubeletConfig:                       upgradedKubeletConfig(input.KubeletConfig, config.KubeletConfig),
+		CRIName:                             util.DefaultStrIfNil(input.CriName, config.CRIName),
+		ControlPlaneFailureTolerance:        util.DefaultStrIfNil(input.ControlPlaneFailureTolerance, config.ControlPlaneFailureTolerance),
+		Extensions:                          upgradedExtensions(input.Extensions),
+		RemovedExtensionTypes:               removedExtensionTypes(input.Extensions, config.Extensions),
 	}, nil
 }
 
-func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
+// upgradedExtensions does not fall back to the current extensions, as nil keeps the extensions of the Shoot
+// and the stored ones untouched, while the current ones would be applied to the Shoot again
+func upgradedExtensions(input []*gqlschema.ExtensionInput) []model.Extension {
+	return extensionsFromInput(input)
+}
+
+// removedExtensionTypes returns the types of the current extensions missing from the provided ones, which the upgrade removes from the Shoot
+func removedExtensionTypes(input []*gqlschema.ExtensionInput, current []model.Extension) []string {
 	if input == nil {
-		return nil, apperrors.Internal("provider config not specified")
+		return nil
 	}
 
-	if input.GcpConfig != nil {
-		return model.NewGCPGardenerConfig(input.GcpConfig)
+	provided := make(map[string]bool, len(input))
+	for _, extension := range input {
+		provided[extension.Type] = true
 	}
-	if input.AzureConfig != nil {
-		return model.NewAzureGardenerConfig(input.AzureConfig)
+
+	var removed []string
+	for _, extension := range current {
+		if !provided[extension.Type] {
+			removed = append(removed, extension.Type)
+		}
 	}
-	if input.AwsConfig != nil {
-		return model.NewAWSGardenerConfig(input.AwsConfig)
+
+	return removed
+}
+
+func upgradedHibernationSchedules(input []*gqlschema.HibernationScheduleInput, current []model.HibernationSchedule) []model.HibernationSchedule {
+	if input == nil {
+		return current
+	}
+
+	return hibernationSchedulesFromInput(input)
+}
+
+// upgradedWorkerPools does not fall back to the current worker pools, as nil keeps the worker pools of the Shoot
+// and the stored ones untouched, while the current ones would replace the worker pools of the Shoot
+func upgradedWorkerPools(input []*gqlschema.WorkerPoolInput) []model.WorkerPool {
+	return workerPoolsFromInput(input)
+}
+
+func upgradedLabels(input gqlschema.Labels, current map[string]string) map[string]string {
+	if input == nil {
+		return current
 	}
-	if input.OpenStackConfig != nil {
-		return model.NewOpenStackGardenerConfig(input.OpenStackConfig)
+
+	return labelsFromInput(input)
+}
+
+func upgradedTaints(input []*gqlschema.TaintInput, current []model.Taint) []model.Taint {
+	if input == nil {
+		return current
+	}
+
+	return taintsFromInput(input)
+}
+
+// upgradedKubeletConfig keeps the current kubelet settings which are not provided
+func upgradedKubeletConfig(input *gqlschema.KubeletConfigInput, current *model.KubeletConfig) *model.KubeletConfig {
+	if input == nil {
+		return current
+	}
+
+	upgraded := kubeletConfigFromInput(input)
+	if current == nil {
+		return upgraded
+	}
+
+	if upgraded.MaxPods == nil {
+		upgraded.MaxPods = current.MaxPods
+	}
+	upgraded.EvictionHard = upgradedEvictionThresholds(upgraded.EvictionHard, current.EvictionHard)
+	upgraded.EvictionSoft = upgradedEvictionThresholds(upgraded.EvictionSoft, current.EvictionSoft)
+	upgraded.EvictionSoftGracePeriod = upgradedEvictionThresholds(upgraded.EvictionSoftGracePeriod, current.EvictionSoftGracePeriod)
+
+	return upgraded
+}
+
+func upgradedEvictionThresholds(upgraded, current *model.EvictionThresholds) *model.EvictionThresholds {
+	if upgraded == nil {
+		return current
+	}
+	if current == nil {
+		return upgraded
+	}
+
+	return &model.EvictionThresholds{
+		MemoryAvailable:   util.DefaultStrIfNil(upgraded.MemoryAvailable, current.MemoryAvailable),
+		ImageFSAvailable:  util.DefaultStrIfNil(upgraded.ImageFSAvailable, current.ImageFSAvailable),
+		ImageFSInodesFree: util.DefaultStrIfNil(upgraded.ImageFSInodesFree, current.ImageFSInodesFree),
+		NodeFSAvailable:   util.DefaultStrIfNil(upgraded.NodeFSAvailable, current.NodeFSAvailable),
+		NodeFSInodesFree:  util.DefaultStrIfNil(upgraded.NodeFSInodesFree, current.NodeFSInodesFree),
+	}
+}
+
+func (c converter) providerSpecificConfigFromInput(input *gqlschema.ProviderSpecificInput) (model.GardenerProviderConfig, apperrors.AppError) {
+	if input == nil {
+		return nil, apperrors.Internal("provider config not specified")
 	}
 
-	return nil, apperrors.BadRequest("provider config not specified")
+	return model.NewGardenerProviderConfigFromInput(input)
 }
 
 func (c converter) KymaConfigFromInput(runtimeID string, input gqlschema.KymaConfigInput) (model.KymaConfig, apperrors.AppError) {
@@ -653,20 +653,21 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 			description:  "regular GCP shoot upgrade",
 			upgradeInput: newGCPUpgradeShootInput(testingPurpose),
 			initialConfig: model.GardenerConfig{
-				KubernetesVersion:      "1.19",
-				VolumeSizeGB:           util.IntPtr(1),
-				DiskType:               util.StringPtr("ssd"),
-				MachineType:            "1",
-				MachineImage:           util.StringPtr("gardenlinux"),
-				MachineImageVersion:    util.StringPtr("25.0.0"),
-				Purpose:                &evaluationPurpose,
-				AutoScalerMin:          1,
-				AutoScalerMax:          2,
-				MaxSurge:               1,
-				MaxUnavailable:         1,
-				GardenerProviderConfig: initialGCPProviderConfig,
-				OIDCConfig:             oidcConfig(),
-				ExposureClassName:      util.StringPtr("internet"),
+				KubernetesVersion:            "1.19",
+				VolumeSizeGB:                 util.IntPtr(1),
+				DiskType:                     util.StringPtr("ssd"),
+				MachineType:                  "1",
+				MachineImage:                 util.StringPtr("gardenlinux"),
+				MachineImageVersion:          util.StringPtr("25.0.0"),
+				Purpose:                      &evaluationPurpose,
+				AutoScalerMin:                1,
+				AutoScalerMax:                2,
+				MaxSurge:                     1,
+				MaxUnavailable:               1,
+				GardenerProviderConfig:       initialGCPProviderConfig,
+				OIDCConfig:                   oidcConfig(),
+				ExposureClassName:            util.StringPtr("internet"),
+				ControlPlaneFailureTolerance: util.StringPtr("zone"),
 			},
 			upgradedConfig: model.GardenerConfig{
 				KubernetesVersion:             "1.19",
@@ -684,6 +685,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    upgradedOidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(true),
+				ControlPlaneFailureTolerance:  util.StringPtr("zone"),
 			},
 		},
 		{
@@ -810,6 +812,7 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    oidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
+				HibernationSchedules:          hibernationSchedules(),
 			},
 			upgradedConfig: model.GardenerConfig{
 				KubernetesVersion:             "1.20.7",
@@ -826,6 +829,118 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 				OIDCConfig:                    upgradedOidcConfig(),
 				ExposureClassName:             util.StringPtr("internet"),
 				ShootNetworkingFilterDisabled: util.BoolPtr(false),
+				HibernationSchedules:          hibernationSchedules(),
+			},
+		},
+		{
+			description:  "shoot upgrade with hibernation schedules",
+			upgradeInput: newUpgradeShootInputWithHibernationSchedules(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:    "1.20.7",
+				MachineType:          "1",
+				OIDCConfig:           oidcConfig(),
+				HibernationSchedules: hibernationSchedules(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				HibernationSchedules: []model.HibernationSchedule{
+					{Start: util.StringPtr("00 22 * * *"), End: util.StringPtr("00 06 * * *")},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade not changing additional worker pools when not provided",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            oidcConfig(),
+				AdditionalWorkerPools: workerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+			},
+		},
+		{
+			description:  "shoot upgrade with additional worker pools",
+			upgradeInput: newUpgradeShootInputWithWorkerPools(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion:     "1.20.7",
+				MachineType:           "1",
+				OIDCConfig:            oidcConfig(),
+				AdditionalWorkerPools: workerPools(),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				AdditionalWorkerPools: []model.WorkerPool{
+					{
+						Name:          "gpu-pool",
+						MachineType:   "gpu-machine",
+						AutoScalerMin: 0,
+						AutoScalerMax: 2,
+						MaxSurge:      1,
+						Labels:        map[string]string{"workload": "gpu"},
+						Taints:        []model.Taint{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+					},
+				},
+			},
+		},
+		{
+			description:  "shoot upgrade keeping worker settings",
+			upgradeInput: newUpgradeShootInputWithNilValues(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        oidcConfig(),
+				WorkerLabels:      map[string]string{"workload": "general"},
+				WorkerTaints:      []model.Taint{{Key: "dedicated", Effect: "NoSchedule"}},
+				KubeletConfig:     &model.KubeletConfig{MaxPods: util.IntPtr(50)},
+				CRIName:           util.StringPtr("docker"),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerLabels:      map[string]string{"workload": "general"},
+				WorkerTaints:      []model.Taint{{Key: "dedicated", Effect: "NoSchedule"}},
+				KubeletConfig:     &model.KubeletConfig{MaxPods: util.IntPtr(50)},
+				CRIName:           util.StringPtr("docker"),
+			},
+		},
+		{
+			description:  "shoot upgrade with worker settings",
+			upgradeInput: newUpgradeShootInputWithWorkerSettings(),
+			initialConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        oidcConfig(),
+				WorkerLabels:      map[string]string{"workload": "general"},
+				WorkerTaints:      []model.Taint{{Key: "dedicated", Effect: "NoSchedule"}},
+				KubeletConfig: &model.KubeletConfig{
+					MaxPods:      util.IntPtr(50),
+					EvictionHard: &model.EvictionThresholds{NodeFSAvailable: util.StringPtr("10%")},
+					EvictionSoft: &model.EvictionThresholds{MemoryAvailable: util.StringPtr("200Mi")},
+				},
+				CRIName: util.StringPtr("docker"),
+			},
+			upgradedConfig: model.GardenerConfig{
+				KubernetesVersion: "1.20.7",
+				MachineType:       "1",
+				OIDCConfig:        upgradedOidcConfig(),
+				WorkerLabels:      map[string]string{"workload": "batch"},
+				WorkerTaints:      []model.Taint{},
+				KubeletConfig: &model.KubeletConfig{
+					MaxPods:      util.IntPtr(110),
+					EvictionHard: &model.EvictionThresholds{MemoryAvailable: util.StringPtr("100Mi"), NodeFSAvailable: util.StringPtr("10%")},
+					EvictionSoft: &model.EvictionThresholds{MemoryAvailable: util.StringPtr("200Mi")},
+				},
+				CRIName: util.StringPtr("containerd"),
 			},
 		},
 	}
@@ -896,6 +1011,67 @@ func Test_UpgradeShootInputToGardenerConfig(t *testing.T) {
 			uuidGeneratorMock.AssertExpectations(t)
 		})
 	}
+
+	t.Run("should replace extensions and remove the ones not provided", func(t *testing.T) {
+		// given
+		inputConverter := NewInputConverter(
+			&mocks.UUIDGenerator{},
+			gardenerProject,
+			defaultEnableKubernetesVersionAutoUpdate,
+			defaultEnableMachineImageVersionAutoUpdate,
+		)
+
+		upgradeInput := newGCPUpgradeShootInput(testingPurpose)
+		upgradeInput.GardenerConfig.Extensions = []*gqlschema.ExtensionInput{
+			{Type: "shoot-oidc-service", Disabled: util.BoolPtr(true)},
+			{Type: "shoot-lakom-service", ProviderConfig: util.StringPtr(`{"kind":"LakomConfig"}`)},
+		}
+		initialConfig := model.GardenerConfig{
+			KubernetesVersion:      "1.19",
+			GardenerProviderConfig: initialGCPProviderConfig,
+			Extensions: []model.Extension{
+				{Type: "shoot-oidc-service"},
+				{Type: "shoot-example-service"},
+			},
+		}
+
+		// when
+		shootConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(*upgradeInput.GardenerConfig, initialConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.Extension{
+			{Type: "shoot-oidc-service", Disabled: util.BoolPtr(true)},
+			{Type: "shoot-lakom-service", ProviderConfig: util.StringPtr(`{"kind":"LakomConfig"}`)},
+		}, shootConfig.Extensions)
+		assert.Equal(t, []string{"shoot-example-service"}, shootConfig.RemovedExtensionTypes)
+	})
+
+	t.Run("should leave extensions untouched when not provided", func(t *testing.T) {
+		// given
+		inputConverter := NewInputConverter(
+			&mocks.UUIDGenerator{},
+			gardenerProject,
+			defaultEnableKubernetesVersionAutoUpdate,
+			defaultEnableMachineImageVersionAutoUpdate,
+		)
+
+		upgradeInput := newGCPUpgradeShootInput(testingPurpose)
+		upgradeInput.GardenerConfig.Extensions = nil
+		initialConfig := model.GardenerConfig{
+			KubernetesVersion:      "1.19",
+			GardenerProviderConfig: initialGCPProviderConfig,
+			Extensions:             []model.Extension{{Type: "shoot-oidc-service"}},
+		}
+
+		// when
+		shootConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(*upgradeInput.GardenerConfig, initialConfig)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, shootConfig.Extensions)
+		assert.Nil(t, shootConfig.RemovedExtensionTypes)
+	})
 }
 
 func newUpgradeShootInputAwsAzureGCP(newPurpose string) gqlschema.UpgradeShootInput {
@@ -963,6 +1139,57 @@ func newUpgradeShootInputWithNilValues() gqlschema.UpgradeShootInput {
 	}
 }
 
+func newUpgradeShootInputWithHibernationSchedules() gqlschema.UpgradeShootInput {
+	input := newUpgradeShootInputWithNilValues()
+	input.GardenerConfig.HibernationSchedules = []*gqlschema.HibernationScheduleInput{
+		{Start: util.StringPtr("00 22 * * *"), End: util.StringPtr("00 06 * * *")},
+	}
+
+	return input
+}
+
+func newUpgradeShootInputWithWorkerPools() gqlschema.UpgradeShootInput {
+	input := newUpgradeShootInputWithNilValues()
+	input.GardenerConfig.AdditionalWorkerPools = []*gqlschema.WorkerPoolInput{
+		{
+			Name:          "gpu-pool",
+			MachineType:   "gpu-machine",
+			AutoScalerMin: 0,
+			AutoScalerMax: 2,
+			MaxSurge:      1,
+			Labels:        gqlschema.Labels{"workload": "gpu"},
+			Taints:        []*gqlschema.TaintInput{{Key: "nvidia.com/gpu", Effect: "NoSchedule"}},
+		},
+	}
+
+	return input
+}
+
+func newUpgradeShootInputWithWorkerSettings() gqlschema.UpgradeShootInput {
+	input := newUpgradeShootInputWithNilValues()
+	input.GardenerConfig.WorkerLabels = gqlschema.Labels{"workload": "batch"}
+	input.GardenerConfig.WorkerTaints = []*gqlschema.TaintInput{}
+	input.GardenerConfig.KubeletConfig = &gqlschema.KubeletConfigInput{
+		MaxPods:      util.IntPtr(110),
+		EvictionHard: &gqlschema.EvictionThresholdsInput{MemoryAvailable: util.StringPtr("100Mi")},
+	}
+	input.GardenerConfig.CriName = util.StringPtr("containerd")
+
+	return input
+}
+
+func workerPools() []model.WorkerPool {
+	return []model.WorkerPool{
+		{Name: "memory-pool", MachineType: "memory-machine", AutoScalerMin: 1, AutoScalerMax: 3, MaxSurge: 1, Zones: []string{"europe-west1-a"}},
+	}
+}
+
+func hibernationSchedules() []model.HibernationSchedule {
+	return []model.HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 07 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+	}
+}
+
 func newGCPUpgradeShootInput(newPurpose string) gqlschema.UpgradeShootInput {
 	input := newUpgradeShootInputAwsAzureGCP(newPurpose)
 	input.GardenerConfig.ProviderSpecificConfig = &gqlschema.ProviderSpecificInput{
@@ -0,0 +1,266 @@
+package provisioning
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/google/uuid"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+const (
+	defaultPageSize = 50
+	maxPageSize     = 200
+)
+
+func (r *service) ListRuntimes(filter *gqlschema.RuntimesFilter, pageInput *gqlschema.PageInput) (*gqlschema.RuntimePage, apperrors.AppError) {
+	runtimeFilter, appErr := runtimeFilterFromGraphQL(filter)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	page, appErr := pageFromGraphQL(pageInput)
+	if appErr != nil {
+		return nil, appErr
+	}
+	pageSize := page.Limit
+	// One more item is fetched to find out if there is a next page
+	page.Limit++
+
+	runtimes, dberr := r.dbSessionFactory.NewReadSession().ListRuntimes(runtimeFilter, page)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list Runtimes")
+	}
+
+	result := &gqlschema.RuntimePage{
+		Data:     make([]*gqlschema.RuntimeSummary, 0, pageSize),
+		PageInfo: &gqlschema.PageInfo{HasNextPage: len(runtimes) > pageSize},
+	}
+	if result.PageInfo.HasNextPage {
+		runtimes = runtimes[:pageSize]
+	}
+
+	for _, runtime := range runtimes {
+		summary := &gqlschema.RuntimeSummary{
+			RuntimeID:         runtime.ID,
+			Tenant:            runtime.Tenant,
+			SubAccountID:      runtime.SubAccountID,
+			ShootName:         runtime.ShootName,
+			Provider:          runtime.Provider,
+			Region:            runtime.Region,
+			KubernetesVersion: runtime.KubernetesVersion,
+			CreationTimestamp: runtime.CreationTimestamp,
+			Deleted:           runtime.Deleted,
+		}
+		if runtime.LastOperation != nil {
+			summary.LastOperation = r.listedOperationToGraphQL(*runtime.LastOperation)
+		}
+		result.Data = append(result.Data, summary)
+	}
+
+	if len(runtimes) > 0 {
+		last := runtimes[len(runtimes)-1]
+		result.PageInfo.EndCursor = encodeCursor(model.PageCursor{Timestamp: last.CreationTimestamp, ID: last.ID})
+	}
+
+	return result, nil
+}
+
+func (r *service) ListOperations(filter *gqlschema.OperationsFilter, pageInput *gqlschema.PageInput) (*gqlschema.OperationPage, apperrors.AppError) {
+	operationFilter, appErr := operationFilterFromGraphQL(filter)
+	if appErr != nil {
+		return nil, appErr
+	}
+
+	page, appErr := pageFromGraphQL(pageInput)
+	if appErr != nil {
+		return nil, appErr
+	}
+	pageSize := page.Limit
+	// One more item is fetched to find out if there is a next page
+	page.Limit++
+
+	operations, dberr := r.dbSessionFactory.NewReadSession().ListOperations(operationFilter, page)
+	if dberr != nil {
+		return nil, dberr.Append("failed to list operations")
+	}
+
+	result := &gqlschema.OperationPage{
+		Data:     make([]*gqlschema.OperationStatus, 0, pageSize),
+		PageInfo: &gqlschema.PageInfo{HasNextPage: len(operations) > pageSize},
+	}
+	if result.PageInfo.HasNextPage {
+		operations = operations[:pageSize]
+	}
+
+	for _, operation := range operations {
+		result.Data = append(result.Data, r.listedOperationToGraphQL(operation))
+	}
+
+	if len(operations) > 0 {
+		last := operations[len(operations)-1]
+		result.PageInfo.EndCursor = encodeCursor(model.PageCursor{Timestamp: last.StartTimestamp, ID: last.ID})
+	}
+
+	return result, nil
+}
+
+func (r *service) listedOperationToGraphQL(operation model.Operation) *gqlschema.OperationStatus {
+	status := r.graphQLConverter.OperationStatusToGQLOperationStatus(operation)
+	status.StartTimestamp = &operation.StartTimestamp
+	status.EndTimestamp = operation.EndTimestamp
+
+	return status
+}
+
+func runtimeFilterFromGraphQL(filter *gqlschema.RuntimesFilter) (model.RuntimeFilter, apperrors.AppError) {
+	if filter == nil {
+		return model.RuntimeFilter{}, nil
+	}
+
+	state, appErr := operationStateFromGraphQL(filter.State)
+	if appErr != nil {
+		return model.RuntimeFilter{}, appErr
+	}
+
+	return model.RuntimeFilter{
+		Tenant:          util.UnwrapStr(filter.Tenant),
+		SubAccountID:    util.UnwrapStr(filter.SubAccountID),
+		Provider:        util.UnwrapStr(filter.Provider),
+		Region:          util.UnwrapStr(filter.Region),
+		State:           state,
+		OperationTypes:  operationTypesFromGraphQL(filter.OperationType),
+		CreatedAfter:    filter.CreatedAfter,
+		CreatedBefore:   filter.CreatedBefore,
+		LastErrorReason: util.UnwrapStr(filter.LastErrorReason),
+		IncludeDeleted:  util.UnwrapBoolOrDefault(filter.IncludeDeleted, false),
+	}, nil
+}
+
+func operationFilterFromGraphQL(filter *gqlschema.OperationsFilter) (model.OperationFilter, apperrors.AppError) {
+	if filter == nil {
+		return model.OperationFilter{}, nil
+	}
+
+	state, appErr := operationStateFromGraphQL(filter.State)
+	if appErr != nil {
+		return model.OperationFilter{}, appErr
+	}
+
+	return model.OperationFilter{
+		RuntimeID:       util.UnwrapStr(filter.RuntimeID),
+		Tenant:          util.UnwrapStr(filter.Tenant),
+		SubAccountID:    util.UnwrapStr(filter.SubAccountID),
+		Provider:        util.UnwrapStr(filter.Provider),
+		Region:          util.UnwrapStr(filter.Region),
+		State:           state,
+		Types:           operationTypesFromGraphQL(filter.Type),
+		StartedAfter:    filter.StartedAfter,
+		StartedBefore:   filter.StartedBefore,
+		LastErrorReason: util.UnwrapStr(filter.LastErrorReason),
+	}, nil
+}
+
+func operationStateFromGraphQL(state *gqlschema.OperationState) (model.OperationState, apperrors.AppError) {
+	if state == nil {
+		return "", nil
+	}
+
+	switch *state {
+	case gqlschema.OperationStateInProgress:
+		return model.InProgress, nil
+	case gqlschema.OperationStateSucceeded:
+		return model.Succeeded, nil
+	case gqlschema.OperationStateFailed:
+		return model.Failed, nil
+	case gqlschema.OperationStateCancelled:
+		return model.Cancelled, nil
+	default:
+		return "", apperrors.BadRequest("operations in %s state are not stored and cannot be listed", *state)
+	}
+}
+
+// operationTypesFromGraphQL returns the stored operation types reported with the given GraphQL type
+func operationTypesFromGraphQL(operationType *gqlschema.OperationType) []model.OperationType {
+	if operationType == nil {
+		return nil
+	}
+
+	switch *operationType {
+	case gqlschema.OperationTypeProvision:
+		return []model.OperationType{model.Provision, model.ProvisionNoInstall}
+	case gqlschema.OperationTypeProvisionNoInstall:
+		return []model.OperationType{model.ProvisionNoInstall}
+	case gqlschema.OperationTypeUpgrade:
+		return []model.OperationType{model.Upgrade}
+	case gqlschema.OperationTypeUpgradeShoot:
+		return []model.OperationType{model.UpgradeShoot}
+	case gqlschema.OperationTypeDeprovision:
+		return []model.OperationType{model.Deprovision}
+	case gqlschema.OperationTypeDeprovisionNoInstall:
+		return []model.OperationType{model.DeprovisionNoInstall}
+	case gqlschema.OperationTypeReconnectRuntime:
+		return []model.OperationType{model.ReconnectRuntime}
+	case gqlschema.OperationTypeHibernate:
+		return []model.OperationType{model.Hibernate}
+	case gqlschema.OperationTypeWakeUp:
+		return []model.OperationType{model.WakeUp}
+	default:
+		return nil
+	}
+}
+
+func pageFromGraphQL(page *gqlschema.PageInput) (model.Page, apperrors.AppError) {
+	if page == nil {
+		return model.Page{Limit: defaultPageSize}, nil
+	}
+
+	limit := util.UnwrapIntOrDefault(page.First, defaultPageSize)
+	if limit < 1 || limit > maxPageSize {
+		return model.Page{}, apperrors.BadRequest("page size must be between 1 and %d", maxPageSize)
+	}
+
+	result := model.Page{Limit: limit}
+	if page.After != nil && *page.After != "" {
+		cursor, appErr := decodeCursor(*page.After)
+		if appErr != nil {
+			return model.Page{}, appErr
+		}
+		result.After = &cursor
+	}
+
+	return result, nil
+}
+
+func encodeCursor(cursor model.PageCursor) *string {
+	encoded, err := json.Marshal(cursor)
+	if err != nil {
+		return nil
+	}
+
+	return util.StringPtr(base64.RawURLEncoding.EncodeToString(encoded))
+}
+
+func decodeCursor(encoded string) (model.PageCursor, apperrors.AppError) {
+	var cursor model.PageCursor
+
+	decoded, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return model.PageCursor{}, apperrors.BadRequest("invalid page cursor: %s", err.Error())
+	}
+
+	err = json.Unmarshal(decoded, &cursor)
+	if err != nil {
+		return model.PageCursor{}, apperrors.BadRequest("invalid page cursor: %s", err.Error())
+	}
+
+	if _, err := uuid.Parse(cursor.ID); err != nil {
+		return model.PageCursor{}, apperrors.BadRequest("invalid page cursor: %s", err.Error())
+	}
+
+	return cursor, nil
+}
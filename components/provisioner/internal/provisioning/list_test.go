@@ -0,0 +1,256 @@
+package provisioning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
+)
+
+func TestService_ListRuntimes(t *testing.T) {
+	graphQLConverter := NewGraphQLConverter()
+	created := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	finished := created.Add(time.Hour)
+
+	runtimeSummary := func(id string, createdAt time.Time) model.RuntimeSummary {
+		return model.RuntimeSummary{
+			ID:                id,
+			Tenant:            tenant,
+			SubAccountID:      util.StringPtr(subAccountId),
+			CreationTimestamp: createdAt,
+			ShootName:         "shoot",
+			Provider:          "aws",
+			Region:            "eu-central-1",
+			KubernetesVersion: "1.27.4",
+		}
+	}
+
+	t.Run("Should list Runtimes with last operations and cursor of the next page", func(t *testing.T) {
+		// given
+		first := runtimeSummary("0e4a3a0b-8f5a-4a5b-9b0e-3f1e2f6a7c01", created)
+		first.LastOperation = &model.Operation{
+			ID:             "5b0c9b6e-1f7c-4c52-9a53-2d2f4c7e9a10",
+			Type:           model.Provision,
+			State:          model.Failed,
+			ClusterID:      first.ID,
+			StartTimestamp: created,
+			EndTimestamp:   &finished,
+			LastError:      model.LastError{ErrMessage: "quota exceeded", Reason: "ERR_INFRA_QUOTA_EXCEEDED", Component: "gardener"},
+		}
+		second := runtimeSummary("0e4a3a0b-8f5a-4a5b-9b0e-3f1e2f6a7c02", created.Add(-time.Hour))
+		third := runtimeSummary("0e4a3a0b-8f5a-4a5b-9b0e-3f1e2f6a7c03", created.Add(-2*time.Hour))
+
+		expectedFilter := model.RuntimeFilter{
+			Tenant:          tenant,
+			Provider:        "aws",
+			State:           model.Failed,
+			OperationTypes:  []model.OperationType{model.Provision, model.ProvisionNoInstall},
+			LastErrorReason: "ERR_INFRA_QUOTA_EXCEEDED",
+		}
+
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListRuntimes", expectedFilter, model.Page{Limit: 3}).Return([]model.RuntimeSummary{first, second, third}, nil)
+		sessionFactory := &sessionMocks.Factory{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		state := gqlschema.OperationStateFailed
+		operationType := gqlschema.OperationTypeProvision
+
+		// when
+		page, err := service.ListRuntimes(&gqlschema.RuntimesFilter{
+			Tenant:          util.StringPtr(tenant),
+			Provider:        util.StringPtr("aws"),
+			State:           &state,
+			OperationType:   &operationType,
+			LastErrorReason: util.StringPtr("ERR_INFRA_QUOTA_EXCEEDED"),
+		}, &gqlschema.PageInput{First: util.IntPtr(2)})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 2)
+		assert.Equal(t, first.ID, page.Data[0].RuntimeID)
+		assert.Equal(t, subAccountId, *page.Data[0].SubAccountID)
+		require.NotNil(t, page.Data[0].LastOperation)
+		assert.Equal(t, gqlschema.OperationStateFailed, page.Data[0].LastOperation.State)
+		assert.Equal(t, "ERR_INFRA_QUOTA_EXCEEDED", page.Data[0].LastOperation.LastError.Reason)
+		assert.Equal(t, &created, page.Data[0].LastOperation.StartTimestamp)
+		assert.Equal(t, &finished, page.Data[0].LastOperation.EndTimestamp)
+		assert.Equal(t, second.ID, page.Data[1].RuntimeID)
+		assert.Nil(t, page.Data[1].LastOperation)
+		assert.True(t, page.PageInfo.HasNextPage)
+		require.NotNil(t, page.PageInfo.EndCursor)
+
+		cursor, err := decodeCursor(*page.PageInfo.EndCursor)
+		require.NoError(t, err)
+		assert.Equal(t, model.PageCursor{Timestamp: second.CreationTimestamp, ID: second.ID}, cursor)
+	})
+
+	t.Run("Should list next page after the cursor", func(t *testing.T) {
+		// given
+		runtime := runtimeSummary("0e4a3a0b-8f5a-4a5b-9b0e-3f1e2f6a7c03", created)
+		cursor := model.PageCursor{Timestamp: created.Add(time.Hour), ID: "0e4a3a0b-8f5a-4a5b-9b0e-3f1e2f6a7c02"}
+
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListRuntimes", model.RuntimeFilter{IncludeDeleted: true}, model.Page{Limit: defaultPageSize + 1, After: &cursor}).Return([]model.RuntimeSummary{runtime}, nil)
+		sessionFactory := &sessionMocks.Factory{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(&gqlschema.RuntimesFilter{IncludeDeleted: util.BoolPtr(true)}, &gqlschema.PageInput{After: encodeCursor(cursor)})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Equal(t, encodeCursor(model.PageCursor{Timestamp: created, ID: runtime.ID}), page.PageInfo.EndCursor)
+	})
+
+	t.Run("Should return empty page", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListRuntimes", model.RuntimeFilter{}, model.Page{Limit: defaultPageSize + 1}).Return([]model.RuntimeSummary{}, nil)
+		sessionFactory := &sessionMocks.Factory{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		page, err := service.ListRuntimes(nil, nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, page.Data)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Nil(t, page.PageInfo.EndCursor)
+	})
+
+	t.Run("Should return error when failed to list Runtimes", func(t *testing.T) {
+		// given
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListRuntimes", mock.Anything, mock.Anything).Return(nil, dberrors.Internal("error"))
+		sessionFactory := &sessionMocks.Factory{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.ListRuntimes(nil, nil)
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func TestService_ListOperations(t *testing.T) {
+	graphQLConverter := NewGraphQLConverter()
+	started := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	after := started.Add(-24 * time.Hour)
+
+	t.Run("Should list operations with cursor of the last one", func(t *testing.T) {
+		// given
+		operation := model.Operation{
+			ID:             "5b0c9b6e-1f7c-4c52-9a53-2d2f4c7e9a10",
+			Type:           model.Hibernate,
+			State:          model.InProgress,
+			ClusterID:      runtimeID,
+			StartTimestamp: started,
+		}
+
+		expectedFilter := model.OperationFilter{
+			RuntimeID:    runtimeID,
+			Region:       "eu-central-1",
+			State:        model.InProgress,
+			Types:        []model.OperationType{model.Hibernate},
+			StartedAfter: &after,
+		}
+
+		readSession := &sessionMocks.ReadSession{}
+		readSession.On("ListOperations", expectedFilter, model.Page{Limit: 11}).Return([]model.Operation{operation}, nil)
+		sessionFactory := &sessionMocks.Factory{}
+		sessionFactory.On("NewReadSession").Return(readSession)
+
+		service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		state := gqlschema.OperationStateInProgress
+		operationType := gqlschema.OperationTypeHibernate
+
+		// when
+		page, err := service.ListOperations(&gqlschema.OperationsFilter{
+			RuntimeID:    util.StringPtr(runtimeID),
+			Region:       util.StringPtr("eu-central-1"),
+			State:        &state,
+			Type:         &operationType,
+			StartedAfter: &after,
+		}, &gqlschema.PageInput{First: util.IntPtr(10)})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, page.Data, 1)
+		assert.Equal(t, operation.ID, *page.Data[0].ID)
+		assert.Equal(t, gqlschema.OperationTypeHibernate, page.Data[0].Operation)
+		assert.Equal(t, &started, page.Data[0].StartTimestamp)
+		assert.Nil(t, page.Data[0].EndTimestamp)
+		assert.False(t, page.PageInfo.HasNextPage)
+		assert.Equal(t, encodeCursor(model.PageCursor{Timestamp: started, ID: operation.ID}), page.PageInfo.EndCursor)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		filter      *gqlschema.OperationsFilter
+		page        *gqlschema.PageInput
+	}{
+		{
+			description: "page size is too big",
+			page:        &gqlschema.PageInput{First: util.IntPtr(maxPageSize + 1)},
+		},
+		{
+			description: "page size is not positive",
+			page:        &gqlschema.PageInput{First: util.IntPtr(0)},
+		},
+		{
+			description: "cursor is not valid",
+			page:        &gqlschema.PageInput{After: util.StringPtr("not-a-cursor")},
+		},
+		{
+			description: "cursor does not point at an operation",
+			page:        &gqlschema.PageInput{After: encodeCursor(model.PageCursor{Timestamp: started, ID: "' OR 1=1 --"})},
+		},
+		{
+			description: "state is not stored",
+			filter: func() *gqlschema.OperationsFilter {
+				state := gqlschema.OperationStatePending
+				return &gqlschema.OperationsFilter{State: &state}
+			}(),
+		},
+	} {
+		t.Run("Should return bad request error when "+testCase.description, func(t *testing.T) {
+			// given
+			readSession := &sessionMocks.ReadSession{}
+			sessionFactory := &sessionMocks.Factory{}
+			sessionFactory.On("NewReadSession").Return(readSession)
+
+			service := NewProvisioningService(nil, graphQLConverter, nil, sessionFactory, nil, nil, nil, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			_, err := service.ListOperations(testCase.filter, testCase.page)
+
+			// then
+			require.Error(t, err)
+			util.CheckErrorType(t, err, apperrors.CodeBadRequest)
+			readSession.AssertNotCalled(t, "ListOperations", mock.Anything, mock.Anything)
+		})
+	}
+}
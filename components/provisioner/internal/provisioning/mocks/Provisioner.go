@@ -3,10 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 )
 
 // Provisioner is an autogenerated mock type for the Provisioner type
@@ -14,23 +19,51 @@ type Provisioner struct {
 	mock.Mock
 }
 
-// DeprovisionCluster provides a mock function with given fields: cluster, operationId
-func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
-	ret := _m.Called(cluster, operationId)
+// DefaultHibernationSchedules provides a mock function with given fields: purpose
+func (_m *Provisioner) DefaultHibernationSchedules(purpose string) ([]model.HibernationSchedule, apperrors.AppError) {
+	ret := _m.Called(purpose)
+
+	var r0 []model.HibernationSchedule
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.HibernationSchedule, apperrors.AppError)); ok {
+		return rf(purpose)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.HibernationSchedule); ok {
+		r0 = rf(purpose)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.HibernationSchedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(purpose)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeprovisionCluster provides a mock function with given fields: ctx, cluster, operationId
+func (_m *Provisioner) DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError) {
+	ret := _m.Called(ctx, cluster, operationId)
 
 	var r0 model.Operation
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) (model.Operation, apperrors.AppError)); ok {
-		return rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) (model.Operation, apperrors.AppError)); ok {
+		return rf(ctx, cluster, operationId)
 	}
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) model.Operation); ok {
-		r0 = rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) model.Operation); ok {
+		r0 = rf(ctx, cluster, operationId)
 	} else {
 		r0 = ret.Get(0).(model.Operation)
 	}
 
-	if rf, ok := ret.Get(1).(func(model.Cluster, string) apperrors.AppError); ok {
-		r1 = rf(cluster, operationId)
+	if rf, ok := ret.Get(1).(func(context.Context, model.Cluster, string) apperrors.AppError); ok {
+		r1 = rf(ctx, cluster, operationId)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -40,23 +73,25 @@ func (_m *Provisioner) DeprovisionCluster(cluster model.Cluster, operationId str
 	return r0, r1
 }
 
-// GetHibernationStatus provides a mock function with given fields: clusterID, gardenerConfig
-func (_m *Provisioner) GetHibernationStatus(clusterID string, gardenerConfig model.GardenerConfig) (model.HibernationStatus, apperrors.AppError) {
-	ret := _m.Called(clusterID, gardenerConfig)
+// DryRunProvisionCluster provides a mock function with given fields: cluster
+func (_m *Provisioner) DryRunProvisionCluster(cluster model.Cluster) (*v1beta1.Shoot, apperrors.AppError) {
+	ret := _m.Called(cluster)
 
-	var r0 model.HibernationStatus
+	var r0 *v1beta1.Shoot
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) (model.HibernationStatus, apperrors.AppError)); ok {
-		return rf(clusterID, gardenerConfig)
+	if rf, ok := ret.Get(0).(func(model.Cluster) (*v1beta1.Shoot, apperrors.AppError)); ok {
+		return rf(cluster)
 	}
-	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) model.HibernationStatus); ok {
-		r0 = rf(clusterID, gardenerConfig)
+	if rf, ok := ret.Get(0).(func(model.Cluster) *v1beta1.Shoot); ok {
+		r0 = rf(cluster)
 	} else {
-		r0 = ret.Get(0).(model.HibernationStatus)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, model.GardenerConfig) apperrors.AppError); ok {
-		r1 = rf(clusterID, gardenerConfig)
+	if rf, ok := ret.Get(1).(func(model.Cluster) apperrors.AppError); ok {
+		r1 = rf(cluster)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -66,13 +101,50 @@ func (_m *Provisioner) GetHibernationStatus(clusterID string, gardenerConfig mod
 	return r0, r1
 }
 
-// ProvisionCluster provides a mock function with given fields: cluster, operationId
-func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError {
-	ret := _m.Called(cluster, operationId)
+// DryRunUpgradeCluster provides a mock function with given fields: clusterID, upgradeConfig
+func (_m *Provisioner) DryRunUpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) (*v1beta1.Shoot, *v1beta1.Shoot, apperrors.AppError) {
+	ret := _m.Called(clusterID, upgradeConfig)
+
+	var r0 *v1beta1.Shoot
+	var r1 *v1beta1.Shoot
+	var r2 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) (*v1beta1.Shoot, *v1beta1.Shoot, apperrors.AppError)); ok {
+		return rf(clusterID, upgradeConfig)
+	}
+	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) *v1beta1.Shoot); ok {
+		r0 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1beta1.Shoot)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, model.GardenerConfig) *v1beta1.Shoot); ok {
+		r1 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*v1beta1.Shoot)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string, model.GardenerConfig) apperrors.AppError); ok {
+		r2 = rf(clusterID, upgradeConfig)
+	} else {
+		if ret.Get(2) != nil {
+			r2 = ret.Get(2).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1, r2
+}
+
+// HibernateCluster provides a mock function with given fields: ctx, clusterID, gardenerConfig, operationID
+func (_m *Provisioner) HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, gardenerConfig, operationID)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) apperrors.AppError); ok {
-		r0 = rf(cluster, operationId)
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig, string) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, gardenerConfig, operationID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -82,13 +154,45 @@ func (_m *Provisioner) ProvisionCluster(cluster model.Cluster, operationId strin
 	return r0
 }
 
-// UpgradeCluster provides a mock function with given fields: clusterID, upgradeConfig
-func (_m *Provisioner) UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError {
-	ret := _m.Called(clusterID, upgradeConfig)
+// ProvisionCluster provides a mock function with given fields: ctx, cluster, operationId
+func (_m *Provisioner) ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError {
+	ret := _m.Called(ctx, cluster, operationId)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, model.GardenerConfig) apperrors.AppError); ok {
-		r0 = rf(clusterID, upgradeConfig)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) apperrors.AppError); ok {
+		r0 = rf(ctx, cluster, operationId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// UpgradeCluster provides a mock function with given fields: ctx, clusterID, upgradeConfig, operationID
+func (_m *Provisioner) UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, upgradeConfig, operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig, string) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, upgradeConfig, operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// WakeUpCluster provides a mock function with given fields: ctx, clusterID, gardenerConfig, operationID
+func (_m *Provisioner) WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError {
+	ret := _m.Called(ctx, clusterID, gardenerConfig, operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string, model.GardenerConfig, string) apperrors.AppError); ok {
+		r0 = rf(ctx, clusterID, gardenerConfig, operationID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
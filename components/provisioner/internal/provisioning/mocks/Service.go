@@ -3,10 +3,15 @@
 package mocks
 
 import (
+	context "context"
+
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
 	gqlschema "github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // Service is an autogenerated mock type for the Service type
@@ -14,19 +19,21 @@ type Service struct {
 	mock.Mock
 }
 
-// DeprovisionRuntime provides a mock function with given fields: id
-func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
+// CancelOperation provides a mock function with given fields: id
+func (_m *Service) CancelOperation(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(id)
 
-	var r0 string
+	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string) (string, apperrors.AppError)); ok {
+	if rf, ok := ret.Get(0).(func(string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
 		return rf(id)
 	}
-	if rf, ok := ret.Get(0).(func(string) string); ok {
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
 		r0 = rf(id)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
 	}
 
 	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
@@ -40,8 +47,34 @@ func (_m *Service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return r0, r1
 }
 
-// ProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
-func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+// DeprovisionRuntime provides a mock function with given fields: ctx, id
+func (_m *Service) DeprovisionRuntime(ctx context.Context, id string) (string, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
+
+	var r0 string
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, apperrors.AppError)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// DryRunProvisionRuntime provides a mock function with given fields: config, tenant, subAccount
+func (_m *Service) DryRunProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(config, tenant, subAccount)
 
 	var r0 *gqlschema.OperationStatus
@@ -68,6 +101,146 @@ func (_m *Service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tena
 	return r0, r1
 }
 
+// DryRunUpgradeGardenerShoot provides a mock function with given fields: id, input
+func (_m *Service) DryRunUpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(id, input)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(id, input)
+	}
+	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
+		r0 = rf(id, input)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
+		r1 = rf(id, input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// HibernateCluster provides a mock function with given fields: ctx, id
+func (_m *Service) HibernateCluster(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOperations provides a mock function with given fields: filter, page
+func (_m *Service) ListOperations(filter *gqlschema.OperationsFilter, page *gqlschema.PageInput) (*gqlschema.OperationPage, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 *gqlschema.OperationPage
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(*gqlschema.OperationsFilter, *gqlschema.PageInput) (*gqlschema.OperationPage, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(*gqlschema.OperationsFilter, *gqlschema.PageInput) *gqlschema.OperationPage); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gqlschema.OperationsFilter, *gqlschema.PageInput) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, page
+func (_m *Service) ListRuntimes(filter *gqlschema.RuntimesFilter, page *gqlschema.PageInput) (*gqlschema.RuntimePage, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 *gqlschema.RuntimePage
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(*gqlschema.RuntimesFilter, *gqlschema.PageInput) (*gqlschema.RuntimePage, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(*gqlschema.RuntimesFilter, *gqlschema.PageInput) *gqlschema.RuntimePage); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.RuntimePage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*gqlschema.RuntimesFilter, *gqlschema.PageInput) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ProvisionRuntime provides a mock function with given fields: ctx, config, tenant, subAccount
+func (_m *Service) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant string, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, config, tenant, subAccount)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, config, tenant, subAccount)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, config, tenant, subAccount)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, gqlschema.ProvisionRuntimeInput, string, string) apperrors.AppError); ok {
+		r1 = rf(ctx, config, tenant, subAccount)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ReconnectRuntimeAgent provides a mock function with given fields: id
 func (_m *Service) ReconnectRuntimeAgent(id string) (string, apperrors.AppError) {
 	ret := _m.Called(id)
@@ -94,6 +267,34 @@ func (_m *Service) ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	return r0, r1
 }
 
+// RetryOperation provides a mock function with given fields: id
+func (_m *Service) RetryOperation(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *gqlschema.OperationStatus); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // RuntimeOperationStatus provides a mock function with given fields: id
 func (_m *Service) RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	ret := _m.Called(id)
@@ -150,25 +351,81 @@ func (_m *Service) RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors
 	return r0, r1
 }
 
-// UpgradeGardenerShoot provides a mock function with given fields: id, input
-func (_m *Service) UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
-	ret := _m.Called(id, input)
+// UpgradeGardenerShoot provides a mock function with given fields: ctx, id, input
+func (_m *Service) UpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id, input)
 
 	var r0 *gqlschema.OperationStatus
 	var r1 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
-		return rf(id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id, input)
 	}
-	if rf, ok := ret.Get(0).(func(string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
-		r0 = rf(id, input)
+	if rf, ok := ret.Get(0).(func(context.Context, string, gqlschema.UpgradeShootInput) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id, input)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*gqlschema.OperationStatus)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
-		r1 = rf(id, input)
+	if rf, ok := ret.Get(1).(func(context.Context, string, gqlschema.UpgradeShootInput) apperrors.AppError); ok {
+		r1 = rf(ctx, id, input)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// VersionDrift provides a mock function with given fields: provider, region, expiringWithin
+func (_m *Service) VersionDrift(provider string, region string, expiringWithin time.Duration) ([]*gqlschema.VersionDriftGroup, apperrors.AppError) {
+	ret := _m.Called(provider, region, expiringWithin)
+
+	var r0 []*gqlschema.VersionDriftGroup
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) ([]*gqlschema.VersionDriftGroup, apperrors.AppError)); ok {
+		return rf(provider, region, expiringWithin)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) []*gqlschema.VersionDriftGroup); ok {
+		r0 = rf(provider, region, expiringWithin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*gqlschema.VersionDriftGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r1 = rf(provider, region, expiringWithin)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// WakeUpCluster provides a mock function with given fields: ctx, id
+func (_m *Service) WakeUpCluster(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	ret := _m.Called(ctx, id)
+
+	var r0 *gqlschema.OperationStatus
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*gqlschema.OperationStatus, apperrors.AppError)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *gqlschema.OperationStatus); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*gqlschema.OperationStatus)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) apperrors.AppError); ok {
+		r1 = rf(ctx, id)
 	} else {
 		if ret.Get(1) != nil {
 			r1 = ret.Get(1).(apperrors.AppError)
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+// UpgradeChecker is an autogenerated mock type for the UpgradeChecker type
+type UpgradeChecker struct {
+	mock.Mock
+}
+
+// CheckUpgrade provides a mock function with given fields: shoot, config, kubeconfig
+func (_m *UpgradeChecker) CheckUpgrade(shoot v1beta1.Shoot, config model.GardenerConfig, kubeconfig *string) ([]model.UpgradeWarning, apperrors.AppError) {
+	ret := _m.Called(shoot, config, kubeconfig)
+
+	var r0 []model.UpgradeWarning
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(v1beta1.Shoot, model.GardenerConfig, *string) ([]model.UpgradeWarning, apperrors.AppError)); ok {
+		return rf(shoot, config, kubeconfig)
+	}
+	if rf, ok := ret.Get(0).(func(v1beta1.Shoot, model.GardenerConfig, *string) []model.UpgradeWarning); ok {
+		r0 = rf(shoot, config, kubeconfig)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UpgradeWarning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(v1beta1.Shoot, model.GardenerConfig, *string) apperrors.AppError); ok {
+		r1 = rf(shoot, config, kubeconfig)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewUpgradeChecker creates a new instance of UpgradeChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUpgradeChecker(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UpgradeChecker {
+	mock := &UpgradeChecker{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	mock "github.com/stretchr/testify/mock"
+
+	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	time "time"
+)
+
+// VersionDriftReporter is an autogenerated mock type for the VersionDriftReporter type
+type VersionDriftReporter struct {
+	mock.Mock
+}
+
+// Report provides a mock function with given fields: provider, region, expiringWithin
+func (_m *VersionDriftReporter) Report(provider string, region string, expiringWithin time.Duration) ([]model.VersionDriftGroup, apperrors.AppError) {
+	ret := _m.Called(provider, region, expiringWithin)
+
+	var r0 []model.VersionDriftGroup
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) ([]model.VersionDriftGroup, apperrors.AppError)); ok {
+		return rf(provider, region, expiringWithin)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) []model.VersionDriftGroup); ok {
+		r0 = rf(provider, region, expiringWithin)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.VersionDriftGroup)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) apperrors.AppError); ok {
+		r1 = rf(provider, region, expiringWithin)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// NewVersionDriftReporter creates a new instance of VersionDriftReporter. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVersionDriftReporter(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VersionDriftReporter {
+	mock := &VersionDriftReporter{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -7,19 +7,153 @@ import (
 	"encoding/base64"
 	"fmt"
 	"io"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultEncryptionKeyID is the ID of the legacy secret key when no keyring is configured
+	DefaultEncryptionKeyID = "default"
+
+	// keyIDSeparator separates the key ID from the ciphertext. It is not a part of the base64 alphabet,
+	// so the values encrypted in the legacy format without a key ID can be recognized.
+	keyIDSeparator = ":"
 )
 
+var keyIDPattern = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
 type encryptFunc func([]byte) ([]byte, error)
 type decryptFunc func([]byte) ([]byte, error)
 
-func newEncryptFunc(key []byte) encryptFunc {
-	return func(obj []byte) ([]byte, error) { return encrypt(key, obj) }
+// Keyring holds the keys used to encrypt kubeconfigs and cluster administrators. New values are encrypted with AES-GCM
+// using the active key and prefixed with its ID. Retired keys are only used to decrypt the values encrypted with them.
+// Values without a key ID were encrypted with the legacy key using AES-CFB.
+type Keyring struct {
+	activeKeyID string
+	keys        map[string][]byte
+	legacyKey   []byte
+}
+
+// NewKeyring creates a Keyring from the keys given in the "<key ID>:<key>" format. If no keys are given,
+// the legacy key becomes the active key with the DefaultEncryptionKeyID ID.
+func NewKeyring(activeKeyID string, keys []string, legacyKey string) (*Keyring, error) {
+	if len(keys) == 0 {
+		if legacyKey == "" {
+			return nil, errors.New("empty encryption key provided")
+		}
+		if activeKeyID == "" {
+			activeKeyID = DefaultEncryptionKeyID
+		}
+		keys = []string{activeKeyID + keyIDSeparator + legacyKey}
+	}
+
+	keyring := &Keyring{
+		activeKeyID: activeKeyID,
+		keys:        make(map[string][]byte, len(keys)),
+		legacyKey:   []byte(legacyKey),
+	}
+
+	for _, entry := range keys {
+		keyID, key, found := strings.Cut(entry, keyIDSeparator)
+		if !found || !keyIDPattern.MatchString(keyID) {
+			return nil, errors.Errorf("invalid encryption key entry, expected <key ID>:<key> where the key ID consists of letters, digits, '-' and '_'")
+		}
+		if _, exists := keyring.keys[keyID]; exists {
+			return nil, errors.Errorf("encryption key %s is not unique", keyID)
+		}
+		if _, err := aes.NewCipher([]byte(key)); err != nil {
+			return nil, errors.Wrapf(err, "invalid encryption key %s", keyID)
+		}
+		keyring.keys[keyID] = []byte(key)
+	}
+
+	if _, found := keyring.keys[activeKeyID]; !found {
+		return nil, errors.Errorf("active encryption key %q not found in the keyring", activeKeyID)
+	}
+
+	return keyring, nil
+}
+
+// ActiveKeyPrefix returns the prefix of the values encrypted with the active key
+func (k *Keyring) ActiveKeyPrefix() string {
+	return k.activeKeyID + keyIDSeparator
+}
+
+// Encrypt encrypts the object with the active key
+func (k *Keyring) Encrypt(obj []byte) ([]byte, error) {
+	encrypted, err := encryptGCM(k.keys[k.activeKeyID], obj)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(k.ActiveKeyPrefix() + base64.StdEncoding.EncodeToString(encrypted)), nil
+}
+
+// Decrypt decrypts the object with the key it was encrypted with
+func (k *Keyring) Decrypt(obj []byte) ([]byte, error) {
+	keyID, data, found := strings.Cut(string(obj), keyIDSeparator)
+	if !found {
+		if len(k.legacyKey) == 0 {
+			return nil, errors.New("legacy encryption key not configured")
+		}
+		return decrypt(k.legacyKey, obj)
+	}
+
+	key, found := k.keys[keyID]
+	if !found {
+		return nil, errors.Errorf("encryption key %s not found in the keyring", keyID)
+	}
+
+	encrypted, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("while decoding object: %w", err)
+	}
+
+	return decryptGCM(key, encrypted)
 }
 
-func newDecryptFunc(key []byte) decryptFunc {
-	return func(obj []byte) ([]byte, error) { return decrypt(key, obj) }
+func encryptGCM(key, obj []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	return gcm.Seal(nonce, nonce, obj, nil), nil
+}
+
+func decryptGCM(key, obj []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(obj) < gcm.NonceSize() {
+		return nil, fmt.Errorf("cipher text is too short")
+	}
+
+	data, err := gcm.Open(nil, obj[:gcm.NonceSize()], obj[gcm.NonceSize():], nil)
+	if err != nil {
+		return nil, fmt.Errorf("while decrypting object: %w", err)
+	}
+	return data, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
 }
 
+// encrypt encrypts the object in the legacy format using AES-CFB, it is only used to test decryption of the legacy values
 func encrypt(key, obj []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key)
 	if err != nil {
@@ -37,6 +171,7 @@ func encrypt(key, obj []byte) ([]byte, error) {
 	return []byte(base64.StdEncoding.EncodeToString(bytes)), nil
 }
 
+// decrypt decrypts the object encrypted in the legacy format using AES-CFB
 func decrypt(key, obj []byte) ([]byte, error) {
 	obj, err := base64.StdEncoding.DecodeString(string(obj))
 	if err != nil {
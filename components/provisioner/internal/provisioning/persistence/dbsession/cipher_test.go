@@ -1,26 +1,36 @@
 package dbsession
 
 import (
+	"encoding/base64"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestCipher(t *testing.T) {
+const (
+	text       = "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
+	legacyKey  = "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
+	retiredKey = "7uyiqd8vy3t2m6qhw3a4ccp0f6ko5d1x"
+	activeKey  = "jw2d0x4nq1m8zyv7crkfbp5e3h6tgs9a"
+)
+
+func TestKeyring(t *testing.T) {
 	t.Run("should encrypt and decrypt the text correctly", func(t *testing.T) {
 		// given
-		text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
-		secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-		e := newEncryptFunc([]byte(secretKey))
-		d := newDecryptFunc([]byte(secretKey))
+		keyring, err := NewKeyring("key-2", []string{"key-1:" + retiredKey, "key-2:" + activeKey}, legacyKey)
+		require.NoError(t, err)
 
 		// when
-		encryptedText, err := e([]byte(text))
+		encryptedText, err := keyring.Encrypt([]byte(text))
 		require.NoError(t, err)
 
 		// then
-		decryptedText, err := d(encryptedText)
+		assert.True(t, strings.HasPrefix(string(encryptedText), "key-2:"))
+		assert.Equal(t, "key-2:", keyring.ActiveKeyPrefix())
+
+		decryptedText, err := keyring.Decrypt(encryptedText)
 		require.NoError(t, err)
 
 		assert.Equal(t, text, string(decryptedText))
@@ -28,32 +38,145 @@ func TestCipher(t *testing.T) {
 
 	t.Run("should not fail to encrypt when the text is empty", func(t *testing.T) {
 		// given
-		text := ""
-		secretKey := "qbl92bqtl6zshtjb4bvbwwc2qk7vtw2d"
-		e := newEncryptFunc([]byte(secretKey))
-		d := newDecryptFunc([]byte(secretKey))
+		keyring, err := NewKeyring("", nil, legacyKey)
+		require.NoError(t, err)
+
+		// when
+		encryptedText, err := keyring.Encrypt([]byte(""))
+		require.NoError(t, err)
+
+		// then
+		decryptedText, err := keyring.Decrypt(encryptedText)
+		require.NoError(t, err)
+
+		assert.Empty(t, decryptedText)
+	})
+
+	t.Run("should use the legacy key as the default active key when no keys are configured", func(t *testing.T) {
+		// given
+		keyring, err := NewKeyring("", nil, legacyKey)
+		require.NoError(t, err)
 
 		// when
-		encryptedText, err := e([]byte(text))
+		encryptedText, err := keyring.Encrypt([]byte(text))
 		require.NoError(t, err)
 
 		// then
-		decryptedText, err := d(encryptedText)
+		assert.True(t, strings.HasPrefix(string(encryptedText), DefaultEncryptionKeyID+":"))
+
+		decryptedText, err := keyring.Decrypt(encryptedText)
+		require.NoError(t, err)
+
+		assert.Equal(t, text, string(decryptedText))
+	})
+
+	t.Run("should decrypt the text encrypted with the retired key", func(t *testing.T) {
+		// given
+		oldKeyring, err := NewKeyring("key-1", []string{"key-1:" + retiredKey}, legacyKey)
 		require.NoError(t, err)
+		keyring, err := NewKeyring("key-2", []string{"key-1:" + retiredKey, "key-2:" + activeKey}, legacyKey)
+		require.NoError(t, err)
+
+		encryptedText, err := oldKeyring.Encrypt([]byte(text))
+		require.NoError(t, err)
+
+		// when
+		decryptedText, err := keyring.Decrypt(encryptedText)
 
+		// then
+		require.NoError(t, err)
 		assert.Equal(t, text, string(decryptedText))
 	})
 
-	t.Run("should fail to encrypt when the secretKey is empty", func(t *testing.T) {
+	t.Run("should decrypt the text encrypted in the legacy format", func(t *testing.T) {
 		// given
-		text := "Lorem ipsum dolor sit amet, consectetur adipiscing elit, sed do eiusmod tempor incididunt ut labore..."
-		secretKey := ""
-		e := newEncryptFunc([]byte(secretKey))
+		keyring, err := NewKeyring("key-2", []string{"key-2:" + activeKey}, legacyKey)
+		require.NoError(t, err)
+
+		encryptedText, err := encrypt([]byte(legacyKey), []byte(text))
+		require.NoError(t, err)
 
 		// when
-		_, err := e([]byte(text))
+		decryptedText, err := keyring.Decrypt(encryptedText)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, text, string(decryptedText))
+	})
+
+	t.Run("should fail to decrypt the text in the legacy format when the legacy key is not configured", func(t *testing.T) {
+		// given
+		keyring, err := NewKeyring("key-2", []string{"key-2:" + activeKey}, "")
+		require.NoError(t, err)
+
+		encryptedText, err := encrypt([]byte(legacyKey), []byte(text))
+		require.NoError(t, err)
+
+		// when
+		_, err = keyring.Decrypt(encryptedText)
 
 		// then
 		assert.Error(t, err)
 	})
+
+	t.Run("should fail to decrypt the text encrypted with the key removed from the keyring", func(t *testing.T) {
+		// given
+		oldKeyring, err := NewKeyring("key-1", []string{"key-1:" + retiredKey}, legacyKey)
+		require.NoError(t, err)
+		keyring, err := NewKeyring("key-2", []string{"key-2:" + activeKey}, legacyKey)
+		require.NoError(t, err)
+
+		encryptedText, err := oldKeyring.Encrypt([]byte(text))
+		require.NoError(t, err)
+
+		// when
+		_, err = keyring.Decrypt(encryptedText)
+
+		// then
+		assert.ErrorContains(t, err, "key-1 not found")
+	})
+
+	t.Run("should fail to decrypt the modified text", func(t *testing.T) {
+		// given
+		keyring, err := NewKeyring("key-2", []string{"key-2:" + activeKey}, legacyKey)
+		require.NoError(t, err)
+
+		encryptedText, err := keyring.Encrypt([]byte(text))
+		require.NoError(t, err)
+
+		// when
+		sealed, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(string(encryptedText), keyring.ActiveKeyPrefix()))
+		require.NoError(t, err)
+		sealed[len(sealed)-1] ^= 0xff
+		tampered := keyring.ActiveKeyPrefix() + base64.StdEncoding.EncodeToString(sealed)
+
+		_, err = keyring.Decrypt([]byte(tampered))
+
+		// then
+		assert.Error(t, err)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		activeKeyID string
+		keys        []string
+		legacyKey   string
+	}{
+		{description: "no keys are configured", activeKeyID: "", keys: nil, legacyKey: ""},
+		{description: "the key ID is missing", activeKeyID: "key-1", keys: []string{activeKey}},
+		{description: "the key ID is invalid", activeKeyID: "key 1", keys: []string{"key 1:" + activeKey}},
+		{description: "the key ID is not unique", activeKeyID: "key-1", keys: []string{"key-1:" + activeKey, "key-1:" + retiredKey}},
+		{description: "the key has invalid length", activeKeyID: "key-1", keys: []string{"key-1:short"}},
+		{description: "the active key is not in the keyring", activeKeyID: "key-2", keys: []string{"key-1:" + activeKey}},
+		{description: "the active key is not set", activeKeyID: "", keys: []string{"key-1:" + activeKey}},
+		{description: "the legacy key has invalid length", activeKeyID: "", keys: nil, legacyKey: "short"},
+	} {
+		t.Run("should fail to create the keyring when "+testCase.description, func(t *testing.T) {
+			// when
+			_, err := NewKeyring(testCase.activeKeyID, testCase.keys, testCase.legacyKey)
+
+			// then
+			assert.Error(t, err)
+		})
+	}
 }
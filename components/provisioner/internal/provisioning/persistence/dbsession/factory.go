@@ -26,9 +26,18 @@ type ReadSession interface {
 	GetGardenerClusterByName(name string) (model.Cluster, dberrors.Error)
 	GetTenant(runtimeID string) (string, dberrors.Error)
 	ListInProgressOperations() ([]model.Operation, dberrors.Error)
+	ListOrphanedInProgressOperations(now time.Time) ([]model.Operation, dberrors.Error)
 	GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error)
+	GetShootUpgrade(operationID string) (model.ShootUpgrade, dberrors.Error)
 	GetTenantForOperation(operationID string) (string, dberrors.Error)
 	InProgressOperationsCount() (model.OperationsCount, dberrors.Error)
+	GetOperationStageHistory(operationID string) ([]model.OperationStageHistoryEntry, dberrors.Error)
+	GetOperationWarnings(operationID string) ([]model.UpgradeWarning, dberrors.Error)
+	ListGardenerConfigsWithoutNetworkingCIDRs() ([]model.GardenerConfig, dberrors.Error)
+	ListGardenerConfigs(provider, region string) ([]model.GardenerConfig, dberrors.Error)
+	CountRecordsToReEncrypt(includeLegacy bool) (model.ReEncryptionProgress, dberrors.Error)
+	ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.RuntimeSummary, dberrors.Error)
+	ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, dberrors.Error)
 }
 
 //go:generate mockery --name=WriteSession
@@ -36,17 +45,30 @@ type WriteSession interface {
 	InsertCluster(cluster model.Cluster) dberrors.Error
 	InsertGardenerConfig(config model.GardenerConfig) dberrors.Error
 	UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error
+	InsertShootUpgrade(shootUpgrade model.ShootUpgrade) dberrors.Error
 	InsertAdministrators(clusterId string, administrators []string) dberrors.Error
 	InsertOperation(operation model.Operation) dberrors.Error
 	UpdateOperationState(operationID string, message string, state model.OperationState, endTime time.Time) dberrors.Error
 	UpdateOperationLastError(operationID, msg, reason, component string) dberrors.Error
 	TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) dberrors.Error
+	InsertOperationStageHistoryEntry(entry model.OperationStageHistoryEntry) dberrors.Error
+	RecordStageRetry(operationID string, stage model.OperationStage, lastError model.LastError) dberrors.Error
+	UpdateOperationWarnings(operationID string, warnings []model.UpgradeWarning) dberrors.Error
+	AcquireOperationLease(operationID, owner string, heartbeat time.Time, leaseDuration time.Duration) (bool, dberrors.Error)
+	ReleaseOperationLease(operationID, owner string) dberrors.Error
+	RequestOperationCancellation(operationID string) dberrors.Error
+	RetryOperation(operationID string, message string, transitionTime time.Time) dberrors.Error
+	MarkOperationResourcesReverted(operationID string) dberrors.Error
 	UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error
 	DeleteCluster(runtimeID string) dberrors.Error
 	MarkClusterAsDeleted(runtimeID string) dberrors.Error
 	UpdateTenant(runtimeID string, tenant string) dberrors.Error
 	UpdateKubernetesVersion(runtimeID string, version string) dberrors.Error
 	UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) dberrors.Error
+	UpdateNetworkingCIDRs(runtimeID string, podsCIDR, servicesCIDR *string) dberrors.Error
+	MarkNetworkingCIDRsChecked(runtimeID string) dberrors.Error
+	ReEncryptKubeconfigs(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error)
+	ReEncryptAdministrators(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error)
 }
 
 //go:generate mockery --name=ReadWriteSession
@@ -68,40 +90,50 @@ type WriteSessionWithinTransaction interface {
 
 type factory struct {
 	connection *dbr.Connection
-	encrypt    encryptFunc
-	decrypt    decryptFunc
+	keyring    *Keyring
 }
 
-func NewFactory(connection *dbr.Connection, secretKey string) (Factory, error) {
-	if len(secretKey) == 0 {
-		return nil, errors.New("empty encryption key provided")
+func NewFactory(connection *dbr.Connection, keyring *Keyring) (Factory, error) {
+	if keyring == nil {
+		return nil, errors.New("no encryption keyring provided")
 	}
 	return &factory{
 		connection: connection,
-		encrypt:    newEncryptFunc([]byte(secretKey)),
-		decrypt:    newDecryptFunc([]byte(secretKey)),
+		keyring:    keyring,
 	}, nil
 }
 
 func (sf *factory) NewReadSession() ReadSession {
-	return readSession{
-		session: sf.connection.NewSession(nil),
-		decrypt: sf.decrypt,
-	}
+	return sf.newReadSession(sf.connection.NewSession(nil))
 }
 
 func (sf *factory) NewWriteSession() WriteSession {
-	return writeSession{
-		session: sf.connection.NewSession(nil),
-		encrypt: sf.encrypt,
-	}
+	return sf.newWriteSession(sf.connection.NewSession(nil), nil)
 }
 
 func (sf *factory) NewReadWriteSession() ReadWriteSession {
 	session := sf.connection.NewSession(nil)
 	return readWriteSession{
-		readSession:  readSession{session: session, decrypt: sf.decrypt},
-		writeSession: writeSession{session: session, encrypt: sf.encrypt},
+		readSession:  sf.newReadSession(session),
+		writeSession: sf.newWriteSession(session, nil),
+	}
+}
+
+func (sf *factory) newReadSession(session *dbr.Session) readSession {
+	return readSession{
+		session:         session,
+		decrypt:         sf.keyring.Decrypt,
+		activeKeyPrefix: sf.keyring.ActiveKeyPrefix(),
+	}
+}
+
+func (sf *factory) newWriteSession(session *dbr.Session, transaction *dbr.Tx) writeSession {
+	return writeSession{
+		session:         session,
+		transaction:     transaction,
+		encrypt:         sf.keyring.Encrypt,
+		decrypt:         sf.keyring.Decrypt,
+		activeKeyPrefix: sf.keyring.ActiveKeyPrefix(),
 	}
 }
 
@@ -118,9 +150,5 @@ func (sf *factory) NewSessionWithinTransaction() (WriteSessionWithinTransaction,
 		return nil, dberrors.Internal("Failed to start transaction: %s", err)
 	}
 
-	return writeSession{
-		session:     dbSession,
-		transaction: dbTransaction,
-		encrypt:     sf.encrypt,
-	}, nil
+	return sf.newWriteSession(dbSession, dbTransaction), nil
 }
@@ -0,0 +1,184 @@
+package dbsession
+
+import (
+	"github.com/gocraft/dbr/v2"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+// lastOperationCondition matches the operation with the latest start timestamp of its cluster, as GetLastOperation does.
+// Operations started at the same time are ordered by ID, so that exactly one operation of every cluster is matched.
+const lastOperationCondition = "operation.id = (SELECT latest.id FROM operation latest WHERE latest.cluster_id = operation.cluster_id " +
+	"ORDER BY latest.start_timestamp DESC, latest.id DESC LIMIT 1)"
+
+var runtimeSummaryColumns = []string{
+	"cluster.id", "cluster.tenant", "cluster.sub_account_id", "cluster.creation_timestamp", "cluster.deleted",
+	"gardener_config.name", "gardener_config.provider", "gardener_config.region", "gardener_config.kubernetes_version",
+}
+
+// ListRuntimes returns a page of Runtimes matching the filter, ordered from the most recently created one.
+// The last operation of every Runtime is loaded as well.
+func (r readSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.RuntimeSummary, dberrors.Error) {
+	var conditions []dbr.Builder
+	if !filter.IncludeDeleted {
+		conditions = append(conditions, dbr.Eq("cluster.deleted", false))
+	}
+	conditions = appendEqIfSet(conditions, "cluster.tenant", filter.Tenant)
+	conditions = appendEqIfSet(conditions, "cluster.sub_account_id", filter.SubAccountID)
+	conditions = appendEqIfSet(conditions, "gardener_config.provider", filter.Provider)
+	conditions = appendEqIfSet(conditions, "gardener_config.region", filter.Region)
+	conditions = appendEqIfSet(conditions, "operation.state", string(filter.State))
+	conditions = appendEqIfSet(conditions, "operation.reason", filter.LastErrorReason)
+	if len(filter.OperationTypes) > 0 {
+		conditions = append(conditions, dbr.Eq("operation.type", operationTypesToStrings(filter.OperationTypes)))
+	}
+	if filter.CreatedAfter != nil {
+		conditions = append(conditions, dbr.Gte("cluster.creation_timestamp", *filter.CreatedAfter))
+	}
+	if filter.CreatedBefore != nil {
+		conditions = append(conditions, dbr.Lt("cluster.creation_timestamp", *filter.CreatedBefore))
+	}
+	if page.After != nil {
+		conditions = append(conditions, afterCursor("cluster.creation_timestamp", "cluster.id", *page.After))
+	}
+
+	var runtimes []model.RuntimeSummary
+
+	stmt := r.session.
+		Select(runtimeSummaryColumns...).
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		LeftJoin("operation", "operation.cluster_id=cluster.id AND "+lastOperationCondition).
+		OrderDesc("cluster.creation_timestamp").
+		OrderDesc("cluster.id").
+		Limit(uint64(page.Limit))
+	if len(conditions) > 0 {
+		stmt = stmt.Where(dbr.And(conditions...))
+	}
+
+	_, err := stmt.Load(&runtimes)
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list Runtimes: %s", err)
+	}
+
+	if len(runtimes) == 0 {
+		return []model.RuntimeSummary{}, nil
+	}
+
+	runtimeIDs := make([]string, 0, len(runtimes))
+	for _, runtime := range runtimes {
+		runtimeIDs = append(runtimeIDs, runtime.ID)
+	}
+
+	var operations []model.Operation
+
+	_, err = r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.And(
+			dbr.Eq("operation.cluster_id", runtimeIDs),
+			dbr.Expr(lastOperationCondition),
+		)).
+		Load(&operations)
+
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list last operations of Runtimes: %s", err)
+	}
+
+	lastOperations := make(map[string]model.Operation, len(operations))
+	for _, operation := range operations {
+		lastOperations[operation.ClusterID] = operation
+	}
+
+	for i := range runtimes {
+		if operation, found := lastOperations[runtimes[i].ID]; found {
+			runtimes[i].LastOperation = &operation
+		}
+	}
+
+	return runtimes, nil
+}
+
+// ListOperations returns a page of operations matching the filter, ordered from the most recently started one.
+func (r readSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, dberrors.Error) {
+	var conditions []dbr.Builder
+	conditions = appendEqIfSet(conditions, "operation.cluster_id", filter.RuntimeID)
+	conditions = appendEqIfSet(conditions, "cluster.tenant", filter.Tenant)
+	conditions = appendEqIfSet(conditions, "cluster.sub_account_id", filter.SubAccountID)
+	conditions = appendEqIfSet(conditions, "gardener_config.provider", filter.Provider)
+	conditions = appendEqIfSet(conditions, "gardener_config.region", filter.Region)
+	conditions = appendEqIfSet(conditions, "operation.state", string(filter.State))
+	conditions = appendEqIfSet(conditions, "operation.reason", filter.LastErrorReason)
+	if len(filter.Types) > 0 {
+		conditions = append(conditions, dbr.Eq("operation.type", operationTypesToStrings(filter.Types)))
+	}
+	if filter.StartedAfter != nil {
+		conditions = append(conditions, dbr.Gte("operation.start_timestamp", *filter.StartedAfter))
+	}
+	if filter.StartedBefore != nil {
+		conditions = append(conditions, dbr.Lt("operation.start_timestamp", *filter.StartedBefore))
+	}
+	if page.After != nil {
+		conditions = append(conditions, afterCursor("operation.start_timestamp", "operation.id", *page.After))
+	}
+
+	var operations []model.Operation
+
+	stmt := r.session.
+		Select(qualifiedOperationColumns()...).
+		From("operation").
+		Join("cluster", "cluster.id=operation.cluster_id").
+		Join("gardener_config", "gardener_config.cluster_id=operation.cluster_id").
+		OrderDesc("operation.start_timestamp").
+		OrderDesc("operation.id").
+		Limit(uint64(page.Limit))
+	if len(conditions) > 0 {
+		stmt = stmt.Where(dbr.And(conditions...))
+	}
+
+	_, err := stmt.Load(&operations)
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list operations: %s", err)
+	}
+
+	if operations == nil {
+		return []model.Operation{}, nil
+	}
+
+	return operations, nil
+}
+
+func appendEqIfSet(conditions []dbr.Builder, column, value string) []dbr.Builder {
+	if value == "" {
+		return conditions
+	}
+	return append(conditions, dbr.Eq(column, value))
+}
+
+// afterCursor matches the rows listed after the cursor when ordering by the timestamp and ID in descending order
+func afterCursor(timestampColumn, idColumn string, cursor model.PageCursor) dbr.Builder {
+	return dbr.Or(
+		dbr.Lt(timestampColumn, cursor.Timestamp),
+		dbr.And(
+			dbr.Eq(timestampColumn, cursor.Timestamp),
+			dbr.Lt(idColumn, cursor.ID),
+		),
+	)
+}
+
+func qualifiedOperationColumns() []string {
+	columns := make([]string, 0, len(operationColumns))
+	for _, column := range operationColumns {
+		columns = append(columns, "operation."+column)
+	}
+	return columns
+}
+
+func operationTypesToStrings(types []model.OperationType) []string {
+	result := make([]string, 0, len(types))
+	for _, operationType := range types {
+		result = append(result, string(operationType))
+	}
+	return result
+}
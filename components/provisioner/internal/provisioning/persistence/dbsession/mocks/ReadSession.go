@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
+
+	time "time"
 )
 
 // ReadSession is an autogenerated mock type for the ReadSession type
@@ -15,6 +17,32 @@ type ReadSession struct {
 	mock.Mock
 }
 
+// CountRecordsToReEncrypt provides a mock function with given fields: includeLegacy
+func (_m *ReadSession) CountRecordsToReEncrypt(includeLegacy bool) (model.ReEncryptionProgress, apperrors.AppError) {
+	ret := _m.Called(includeLegacy)
+
+	var r0 model.ReEncryptionProgress
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(bool) (model.ReEncryptionProgress, apperrors.AppError)); ok {
+		return rf(includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(bool) model.ReEncryptionProgress); ok {
+		r0 = rf(includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) apperrors.AppError); ok {
+		r1 = rf(includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetCluster provides a mock function with given fields: runtimeID
 func (_m *ReadSession) GetCluster(runtimeID string) (model.Cluster, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -119,6 +147,62 @@ func (_m *ReadSession) GetOperation(operationID string) (model.Operation, apperr
 	return r0, r1
 }
 
+// GetOperationStageHistory provides a mock function with given fields: operationID
+func (_m *ReadSession) GetOperationStageHistory(operationID string) ([]model.OperationStageHistoryEntry, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 []model.OperationStageHistoryEntry
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.OperationStageHistoryEntry, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.OperationStageHistoryEntry); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OperationStageHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetOperationWarnings provides a mock function with given fields: operationID
+func (_m *ReadSession) GetOperationWarnings(operationID string) ([]model.UpgradeWarning, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 []model.UpgradeWarning
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.UpgradeWarning, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.UpgradeWarning); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UpgradeWarning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetRuntimeUpgrade provides a mock function with given fields: operationId
 func (_m *ReadSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, apperrors.AppError) {
 	ret := _m.Called(operationId)
@@ -145,6 +229,32 @@ func (_m *ReadSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgra
 	return r0, r1
 }
 
+// GetShootUpgrade provides a mock function with given fields: operationID
+func (_m *ReadSession) GetShootUpgrade(operationID string) (model.ShootUpgrade, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.ShootUpgrade
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.ShootUpgrade, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.ShootUpgrade); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.ShootUpgrade)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetTenant provides a mock function with given fields: runtimeID
 func (_m *ReadSession) GetTenant(runtimeID string) (string, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -223,6 +333,62 @@ func (_m *ReadSession) InProgressOperationsCount() (model.OperationsCount, apper
 	return r0, r1
 }
 
+// ListGardenerConfigs provides a mock function with given fields: provider, region
+func (_m *ReadSession) ListGardenerConfigs(provider string, region string) ([]model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(provider, region)
+
+	var r0 []model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) ([]model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(provider, region)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []model.GardenerConfig); ok {
+		r0 = rf(provider, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(provider, region)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGardenerConfigsWithoutNetworkingCIDRs provides a mock function with given fields:
+func (_m *ReadSession) ListGardenerConfigsWithoutNetworkingCIDRs() ([]model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func() ([]model.GardenerConfig, apperrors.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.GardenerConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -251,6 +417,90 @@ func (_m *ReadSession) ListInProgressOperations() ([]model.Operation, apperrors.
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, page
+func (_m *ReadSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) []model.Operation); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOrphanedInProgressOperations provides a mock function with given fields: now
+func (_m *ReadSession) ListOrphanedInProgressOperations(now time.Time) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(now)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(time.Time) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []model.Operation); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) apperrors.AppError); ok {
+		r1 = rf(now)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, page
+func (_m *ReadSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.RuntimeSummary, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.RuntimeSummary
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) ([]model.RuntimeSummary, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) []model.RuntimeSummary); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RuntimeSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // NewReadSession creates a new instance of ReadSession. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewReadSession(t interface {
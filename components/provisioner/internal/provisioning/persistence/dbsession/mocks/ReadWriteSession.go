@@ -17,6 +17,58 @@ type ReadWriteSession struct {
 	mock.Mock
 }
 
+// AcquireOperationLease provides a mock function with given fields: operationID, owner, heartbeat, leaseDuration
+func (_m *ReadWriteSession) AcquireOperationLease(operationID string, owner string, heartbeat time.Time, leaseDuration time.Duration) (bool, apperrors.AppError) {
+	ret := _m.Called(operationID, owner, heartbeat, leaseDuration)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Duration) (bool, apperrors.AppError)); ok {
+		return rf(operationID, owner, heartbeat, leaseDuration)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Duration) bool); ok {
+		r0 = rf(operationID, owner, heartbeat, leaseDuration)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Duration) apperrors.AppError); ok {
+		r1 = rf(operationID, owner, heartbeat, leaseDuration)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// CountRecordsToReEncrypt provides a mock function with given fields: includeLegacy
+func (_m *ReadWriteSession) CountRecordsToReEncrypt(includeLegacy bool) (model.ReEncryptionProgress, apperrors.AppError) {
+	ret := _m.Called(includeLegacy)
+
+	var r0 model.ReEncryptionProgress
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(bool) (model.ReEncryptionProgress, apperrors.AppError)); ok {
+		return rf(includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(bool) model.ReEncryptionProgress); ok {
+		r0 = rf(includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionProgress)
+	}
+
+	if rf, ok := ret.Get(1).(func(bool) apperrors.AppError); ok {
+		r1 = rf(includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // DeleteCluster provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) DeleteCluster(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -137,6 +189,62 @@ func (_m *ReadWriteSession) GetOperation(operationID string) (model.Operation, a
 	return r0, r1
 }
 
+// GetOperationStageHistory provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetOperationStageHistory(operationID string) ([]model.OperationStageHistoryEntry, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 []model.OperationStageHistoryEntry
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.OperationStageHistoryEntry, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.OperationStageHistoryEntry); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OperationStageHistoryEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetOperationWarnings provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetOperationWarnings(operationID string) ([]model.UpgradeWarning, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 []model.UpgradeWarning
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) ([]model.UpgradeWarning, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.UpgradeWarning); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.UpgradeWarning)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetRuntimeUpgrade provides a mock function with given fields: operationId
 func (_m *ReadWriteSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, apperrors.AppError) {
 	ret := _m.Called(operationId)
@@ -163,6 +271,32 @@ func (_m *ReadWriteSession) GetRuntimeUpgrade(operationId string) (model.Runtime
 	return r0, r1
 }
 
+// GetShootUpgrade provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) GetShootUpgrade(operationID string) (model.ShootUpgrade, apperrors.AppError) {
+	ret := _m.Called(operationID)
+
+	var r0 model.ShootUpgrade
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) (model.ShootUpgrade, apperrors.AppError)); ok {
+		return rf(operationID)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.ShootUpgrade); ok {
+		r0 = rf(operationID)
+	} else {
+		r0 = ret.Get(0).(model.ShootUpgrade)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) apperrors.AppError); ok {
+		r1 = rf(operationID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // GetTenant provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) GetTenant(runtimeID string) (string, apperrors.AppError) {
 	ret := _m.Called(runtimeID)
@@ -305,6 +439,94 @@ func (_m *ReadWriteSession) InsertOperation(operation model.Operation) apperrors
 	return r0
 }
 
+// InsertOperationStageHistoryEntry provides a mock function with given fields: entry
+func (_m *ReadWriteSession) InsertOperationStageHistoryEntry(entry model.OperationStageHistoryEntry) apperrors.AppError {
+	ret := _m.Called(entry)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationStageHistoryEntry) apperrors.AppError); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertShootUpgrade provides a mock function with given fields: shootUpgrade
+func (_m *ReadWriteSession) InsertShootUpgrade(shootUpgrade model.ShootUpgrade) apperrors.AppError {
+	ret := _m.Called(shootUpgrade)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.ShootUpgrade) apperrors.AppError); ok {
+		r0 = rf(shootUpgrade)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ListGardenerConfigs provides a mock function with given fields: provider, region
+func (_m *ReadWriteSession) ListGardenerConfigs(provider string, region string) ([]model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called(provider, region)
+
+	var r0 []model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) ([]model.GardenerConfig, apperrors.AppError)); ok {
+		return rf(provider, region)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []model.GardenerConfig); ok {
+		r0 = rf(provider, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) apperrors.AppError); ok {
+		r1 = rf(provider, region)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListGardenerConfigsWithoutNetworkingCIDRs provides a mock function with given fields:
+func (_m *ReadWriteSession) ListGardenerConfigsWithoutNetworkingCIDRs() ([]model.GardenerConfig, apperrors.AppError) {
+	ret := _m.Called()
+
+	var r0 []model.GardenerConfig
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func() ([]model.GardenerConfig, apperrors.AppError)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.GardenerConfig); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.GardenerConfig)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() apperrors.AppError); ok {
+		r1 = rf()
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // ListInProgressOperations provides a mock function with given fields:
 func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apperrors.AppError) {
 	ret := _m.Called()
@@ -333,6 +555,90 @@ func (_m *ReadWriteSession) ListInProgressOperations() ([]model.Operation, apper
 	return r0, r1
 }
 
+// ListOperations provides a mock function with given fields: filter, page
+func (_m *ReadWriteSession) ListOperations(filter model.OperationFilter, page model.Page) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(model.OperationFilter, model.Page) []model.Operation); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.OperationFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOrphanedInProgressOperations provides a mock function with given fields: now
+func (_m *ReadWriteSession) ListOrphanedInProgressOperations(now time.Time) ([]model.Operation, apperrors.AppError) {
+	ret := _m.Called(now)
+
+	var r0 []model.Operation
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(time.Time) ([]model.Operation, apperrors.AppError)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []model.Operation); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) apperrors.AppError); ok {
+		r1 = rf(now)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRuntimes provides a mock function with given fields: filter, page
+func (_m *ReadWriteSession) ListRuntimes(filter model.RuntimeFilter, page model.Page) ([]model.RuntimeSummary, apperrors.AppError) {
+	ret := _m.Called(filter, page)
+
+	var r0 []model.RuntimeSummary
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) ([]model.RuntimeSummary, apperrors.AppError)); ok {
+		return rf(filter, page)
+	}
+	if rf, ok := ret.Get(0).(func(model.RuntimeFilter, model.Page) []model.RuntimeSummary); ok {
+		r0 = rf(filter, page)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.RuntimeSummary)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(model.RuntimeFilter, model.Page) apperrors.AppError); ok {
+		r1 = rf(filter, page)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -349,6 +655,154 @@ func (_m *ReadWriteSession) MarkClusterAsDeleted(runtimeID string) apperrors.App
 	return r0
 }
 
+// MarkNetworkingCIDRsChecked provides a mock function with given fields: runtimeID
+func (_m *ReadWriteSession) MarkNetworkingCIDRsChecked(runtimeID string) apperrors.AppError {
+	ret := _m.Called(runtimeID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// MarkOperationResourcesReverted provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) MarkOperationResourcesReverted(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReEncryptAdministrators provides a mock function with given fields: afterID, limit, includeLegacy
+func (_m *ReadWriteSession) ReEncryptAdministrators(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, apperrors.AppError) {
+	ret := _m.Called(afterID, limit, includeLegacy)
+
+	var r0 model.ReEncryptionBatch
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int, bool) (model.ReEncryptionBatch, apperrors.AppError)); ok {
+		return rf(afterID, limit, includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, bool) model.ReEncryptionBatch); ok {
+		r0 = rf(afterID, limit, includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, bool) apperrors.AppError); ok {
+		r1 = rf(afterID, limit, includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReEncryptKubeconfigs provides a mock function with given fields: afterID, limit, includeLegacy
+func (_m *ReadWriteSession) ReEncryptKubeconfigs(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, apperrors.AppError) {
+	ret := _m.Called(afterID, limit, includeLegacy)
+
+	var r0 model.ReEncryptionBatch
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int, bool) (model.ReEncryptionBatch, apperrors.AppError)); ok {
+		return rf(afterID, limit, includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, bool) model.ReEncryptionBatch); ok {
+		r0 = rf(afterID, limit, includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, bool) apperrors.AppError); ok {
+		r1 = rf(afterID, limit, includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RecordStageRetry provides a mock function with given fields: operationID, stage, lastError
+func (_m *ReadWriteSession) RecordStageRetry(operationID string, stage model.OperationStage, lastError model.LastError) apperrors.AppError {
+	ret := _m.Called(operationID, stage, lastError)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.OperationStage, model.LastError) apperrors.AppError); ok {
+		r0 = rf(operationID, stage, lastError)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReleaseOperationLease provides a mock function with given fields: operationID, owner
+func (_m *ReadWriteSession) ReleaseOperationLease(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RequestOperationCancellation provides a mock function with given fields: operationID
+func (_m *ReadWriteSession) RequestOperationCancellation(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RetryOperation provides a mock function with given fields: operationID, message, transitionTime
+func (_m *ReadWriteSession) RetryOperation(operationID string, message string, transitionTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, transitionTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, transitionTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // TransitionOperation provides a mock function with given fields: operationID, message, stage, transitionTime
 func (_m *ReadWriteSession) TransitionOperation(operationID string, message string, stage model.OperationStage, transitionTime time.Time) apperrors.AppError {
 	ret := _m.Called(operationID, message, stage, transitionTime)
@@ -413,6 +867,22 @@ func (_m *ReadWriteSession) UpdateKubernetesVersion(runtimeID string, version st
 	return r0
 }
 
+// UpdateNetworkingCIDRs provides a mock function with given fields: runtimeID, podsCIDR, servicesCIDR
+func (_m *ReadWriteSession) UpdateNetworkingCIDRs(runtimeID string, podsCIDR *string, servicesCIDR *string) apperrors.AppError {
+	ret := _m.Called(runtimeID, podsCIDR, servicesCIDR)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *string, *string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, podsCIDR, servicesCIDR)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component
 func (_m *ReadWriteSession) UpdateOperationLastError(operationID string, msg string, reason string, component string) apperrors.AppError {
 	ret := _m.Called(operationID, msg, reason, component)
@@ -445,6 +915,22 @@ func (_m *ReadWriteSession) UpdateOperationState(operationID string, message str
 	return r0
 }
 
+// UpdateOperationWarnings provides a mock function with given fields: operationID, warnings
+func (_m *ReadWriteSession) UpdateOperationWarnings(operationID string, warnings []model.UpgradeWarning) apperrors.AppError {
+	ret := _m.Called(operationID, warnings)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.UpgradeWarning) apperrors.AppError); ok {
+		r0 = rf(operationID, warnings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateShootNetworkingFilterDisabled provides a mock function with given fields: runtimeID, shootNetworkingFilterDisabled
 func (_m *ReadWriteSession) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) apperrors.AppError {
 	ret := _m.Called(runtimeID, shootNetworkingFilterDisabled)
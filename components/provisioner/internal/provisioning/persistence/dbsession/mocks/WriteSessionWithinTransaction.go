@@ -17,6 +17,32 @@ type WriteSessionWithinTransaction struct {
 	mock.Mock
 }
 
+// AcquireOperationLease provides a mock function with given fields: operationID, owner, heartbeat, leaseDuration
+func (_m *WriteSessionWithinTransaction) AcquireOperationLease(operationID string, owner string, heartbeat time.Time, leaseDuration time.Duration) (bool, apperrors.AppError) {
+	ret := _m.Called(operationID, owner, heartbeat, leaseDuration)
+
+	var r0 bool
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Duration) (bool, apperrors.AppError)); ok {
+		return rf(operationID, owner, heartbeat, leaseDuration)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time, time.Duration) bool); ok {
+		r0 = rf(operationID, owner, heartbeat, leaseDuration)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time, time.Duration) apperrors.AppError); ok {
+		r1 = rf(operationID, owner, heartbeat, leaseDuration)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
 // Commit provides a mock function with given fields:
 func (_m *WriteSessionWithinTransaction) Commit() apperrors.AppError {
 	ret := _m.Called()
@@ -113,6 +139,38 @@ func (_m *WriteSessionWithinTransaction) InsertOperation(operation model.Operati
 	return r0
 }
 
+// InsertOperationStageHistoryEntry provides a mock function with given fields: entry
+func (_m *WriteSessionWithinTransaction) InsertOperationStageHistoryEntry(entry model.OperationStageHistoryEntry) apperrors.AppError {
+	ret := _m.Called(entry)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.OperationStageHistoryEntry) apperrors.AppError); ok {
+		r0 = rf(entry)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// InsertShootUpgrade provides a mock function with given fields: shootUpgrade
+func (_m *WriteSessionWithinTransaction) InsertShootUpgrade(shootUpgrade model.ShootUpgrade) apperrors.AppError {
+	ret := _m.Called(shootUpgrade)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(model.ShootUpgrade) apperrors.AppError); ok {
+		r0 = rf(shootUpgrade)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // MarkClusterAsDeleted provides a mock function with given fields: runtimeID
 func (_m *WriteSessionWithinTransaction) MarkClusterAsDeleted(runtimeID string) apperrors.AppError {
 	ret := _m.Called(runtimeID)
@@ -129,6 +187,154 @@ func (_m *WriteSessionWithinTransaction) MarkClusterAsDeleted(runtimeID string)
 	return r0
 }
 
+// MarkNetworkingCIDRsChecked provides a mock function with given fields: runtimeID
+func (_m *WriteSessionWithinTransaction) MarkNetworkingCIDRsChecked(runtimeID string) apperrors.AppError {
+	ret := _m.Called(runtimeID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(runtimeID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// MarkOperationResourcesReverted provides a mock function with given fields: operationID
+func (_m *WriteSessionWithinTransaction) MarkOperationResourcesReverted(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReEncryptAdministrators provides a mock function with given fields: afterID, limit, includeLegacy
+func (_m *WriteSessionWithinTransaction) ReEncryptAdministrators(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, apperrors.AppError) {
+	ret := _m.Called(afterID, limit, includeLegacy)
+
+	var r0 model.ReEncryptionBatch
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int, bool) (model.ReEncryptionBatch, apperrors.AppError)); ok {
+		return rf(afterID, limit, includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, bool) model.ReEncryptionBatch); ok {
+		r0 = rf(afterID, limit, includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, bool) apperrors.AppError); ok {
+		r1 = rf(afterID, limit, includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// ReEncryptKubeconfigs provides a mock function with given fields: afterID, limit, includeLegacy
+func (_m *WriteSessionWithinTransaction) ReEncryptKubeconfigs(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, apperrors.AppError) {
+	ret := _m.Called(afterID, limit, includeLegacy)
+
+	var r0 model.ReEncryptionBatch
+	var r1 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, int, bool) (model.ReEncryptionBatch, apperrors.AppError)); ok {
+		return rf(afterID, limit, includeLegacy)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, bool) model.ReEncryptionBatch); ok {
+		r0 = rf(afterID, limit, includeLegacy)
+	} else {
+		r0 = ret.Get(0).(model.ReEncryptionBatch)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, bool) apperrors.AppError); ok {
+		r1 = rf(afterID, limit, includeLegacy)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(apperrors.AppError)
+		}
+	}
+
+	return r0, r1
+}
+
+// RecordStageRetry provides a mock function with given fields: operationID, stage, lastError
+func (_m *WriteSessionWithinTransaction) RecordStageRetry(operationID string, stage model.OperationStage, lastError model.LastError) apperrors.AppError {
+	ret := _m.Called(operationID, stage, lastError)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, model.OperationStage, model.LastError) apperrors.AppError); ok {
+		r0 = rf(operationID, stage, lastError)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// ReleaseOperationLease provides a mock function with given fields: operationID, owner
+func (_m *WriteSessionWithinTransaction) ReleaseOperationLease(operationID string, owner string) apperrors.AppError {
+	ret := _m.Called(operationID, owner)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string) apperrors.AppError); ok {
+		r0 = rf(operationID, owner)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RequestOperationCancellation provides a mock function with given fields: operationID
+func (_m *WriteSessionWithinTransaction) RequestOperationCancellation(operationID string) apperrors.AppError {
+	ret := _m.Called(operationID)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string) apperrors.AppError); ok {
+		r0 = rf(operationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
+// RetryOperation provides a mock function with given fields: operationID, message, transitionTime
+func (_m *WriteSessionWithinTransaction) RetryOperation(operationID string, message string, transitionTime time.Time) apperrors.AppError {
+	ret := _m.Called(operationID, message, transitionTime)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) apperrors.AppError); ok {
+		r0 = rf(operationID, message, transitionTime)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // RollbackUnlessCommitted provides a mock function with given fields:
 func (_m *WriteSessionWithinTransaction) RollbackUnlessCommitted() {
 	_m.Called()
@@ -198,6 +404,22 @@ func (_m *WriteSessionWithinTransaction) UpdateKubernetesVersion(runtimeID strin
 	return r0
 }
 
+// UpdateNetworkingCIDRs provides a mock function with given fields: runtimeID, podsCIDR, servicesCIDR
+func (_m *WriteSessionWithinTransaction) UpdateNetworkingCIDRs(runtimeID string, podsCIDR *string, servicesCIDR *string) apperrors.AppError {
+	ret := _m.Called(runtimeID, podsCIDR, servicesCIDR)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, *string, *string) apperrors.AppError); ok {
+		r0 = rf(runtimeID, podsCIDR, servicesCIDR)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateOperationLastError provides a mock function with given fields: operationID, msg, reason, component
 func (_m *WriteSessionWithinTransaction) UpdateOperationLastError(operationID string, msg string, reason string, component string) apperrors.AppError {
 	ret := _m.Called(operationID, msg, reason, component)
@@ -230,6 +452,22 @@ func (_m *WriteSessionWithinTransaction) UpdateOperationState(operationID string
 	return r0
 }
 
+// UpdateOperationWarnings provides a mock function with given fields: operationID, warnings
+func (_m *WriteSessionWithinTransaction) UpdateOperationWarnings(operationID string, warnings []model.UpgradeWarning) apperrors.AppError {
+	ret := _m.Called(operationID, warnings)
+
+	var r0 apperrors.AppError
+	if rf, ok := ret.Get(0).(func(string, []model.UpgradeWarning) apperrors.AppError); ok {
+		r0 = rf(operationID, warnings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(apperrors.AppError)
+		}
+	}
+
+	return r0
+}
+
 // UpdateShootNetworkingFilterDisabled provides a mock function with given fields: runtimeID, shootNetworkingFilterDisabled
 func (_m *WriteSessionWithinTransaction) UpdateShootNetworkingFilterDisabled(runtimeID string, shootNetworkingFilterDisabled *bool) apperrors.AppError {
 	ret := _m.Called(runtimeID, shootNetworkingFilterDisabled)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/gocraft/dbr/v2"
 
@@ -15,8 +16,9 @@ import (
 )
 
 type readSession struct {
-	session *dbr.Session
-	decrypt decryptFunc
+	session         *dbr.Session
+	decrypt         decryptFunc
+	activeKeyPrefix string
 }
 
 func (r readSession) GetTenant(runtimeID string) (string, dberrors.Error) {
@@ -103,6 +105,12 @@ func (r readSession) GetCluster(runtimeID string) (model.Cluster, dberrors.Error
 	}
 	cluster.ClusterConfig.DNSConfig = dnsConfig
 
+	workerPools, dberr := r.getWorkerPools(providerConfig.ID)
+	if dberr != nil {
+		return model.Cluster{}, dberr.Append("Cannot get worker pools for runtimeID: %s", runtimeID)
+	}
+	cluster.ClusterConfig.AdditionalWorkerPools = workerPools
+
 	if cluster.ActiveKymaConfigId != nil {
 		kymaConfig, dberr := r.getKymaConfig(runtimeID, *cluster.ActiveKymaConfigId)
 		if dberr != nil {
@@ -138,7 +146,8 @@ func (r readSession) GetGardenerClusterByName(name string) (model.Cluster, dberr
 			"provider", "purpose", "seed", "target_secret", "worker_cidr", "pods_cidr", "services_cidr", "region", "auto_scaler_min",
 			"auto_scaler_max", "max_surge", "max_unavailable", "enable_kubernetes_version_auto_update",
 			"enable_machine_image_version_auto_update", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "hibernation_schedules",
+			"worker_labels", "worker_taints", "kubelet_config", "cri_name", "extensions").
 		From("gardener_config").
 		Join("cluster", "gardener_config.cluster_id=cluster.id").
 		Where(dbr.Eq("name", name)).
@@ -294,7 +303,12 @@ func (r readSession) getClusterAdministrators(runtimeID string) ([]model.Cluster
 
 type gardenerConfigRead struct {
 	model.GardenerConfig
-	ProviderSpecificConfig string `db:"provider_specific_config"`
+	ProviderSpecificConfig   string  `db:"provider_specific_config"`
+	HibernationSchedulesJSON *string `db:"hibernation_schedules"`
+	WorkerLabelsJSON         *string `db:"worker_labels"`
+	WorkerTaintsJSON         *string `db:"worker_taints"`
+	KubeletConfigJSON        *string `db:"kubelet_config"`
+	ExtensionsJSON           *string `db:"extensions"`
 }
 
 func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
@@ -304,9 +318,87 @@ func (gcr *gardenerConfigRead) DecodeProviderConfig() error {
 	}
 
 	gcr.GardenerProviderConfig = gardenerConfigProviderConfig
+
+	if gcr.HibernationSchedulesJSON != nil {
+		err := json.Unmarshal([]byte(*gcr.HibernationSchedulesJSON), &gcr.HibernationSchedules)
+		if err != nil {
+			return fmt.Errorf("error decoding hibernation schedules: %s", err.Error())
+		}
+	}
+
+	decodingErr := decodeJSONColumns(
+		jsonColumn{data: gcr.WorkerLabelsJSON, target: &gcr.WorkerLabels},
+		jsonColumn{data: gcr.WorkerTaintsJSON, target: &gcr.WorkerTaints},
+		jsonColumn{data: gcr.KubeletConfigJSON, target: &gcr.KubeletConfig},
+	)
+	if decodingErr != nil {
+		return fmt.Errorf("error decoding worker settings: %s", decodingErr.Error())
+	}
+
+	decodingErr = decodeJSONColumns(jsonColumn{data: gcr.ExtensionsJSON, target: &gcr.Extensions})
+	if decodingErr != nil {
+		return fmt.Errorf("error decoding extensions: %s", decodingErr.Error())
+	}
+
+	return nil
+}
+
+type jsonColumn struct {
+	data   *string
+	target interface{}
+}
+
+// decodeJSONColumns decodes the jsonb columns into their targets, columns with NULL values are skipped.
+func decodeJSONColumns(columns ...jsonColumn) error {
+	for _, column := range columns {
+		if column.data == nil {
+			continue
+		}
+		if err := json.Unmarshal([]byte(*column.data), column.target); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
+// gardenerConfigSnapshot is the JSON representation of the Gardener config stored together with the Shoot upgrade.
+// The provider specific config shadows the GardenerProviderConfig interface, which cannot be decoded directly.
+type gardenerConfigSnapshot struct {
+	model.GardenerConfig
+	GardenerProviderConfig string
+}
+
+func encodeGardenerConfigSnapshot(config model.GardenerConfig) (string, dberrors.Error) {
+	snapshot := gardenerConfigSnapshot{GardenerConfig: config}
+	if config.GardenerProviderConfig != nil {
+		snapshot.GardenerProviderConfig = config.GardenerProviderConfig.RawJSON()
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return "", dberrors.Internal("Failed to encode Gardener config snapshot: %s", err.Error())
+	}
+
+	return string(data), nil
+}
+
+func decodeGardenerConfigSnapshot(data string) (model.GardenerConfig, dberrors.Error) {
+	var snapshot gardenerConfigSnapshot
+
+	err := json.Unmarshal([]byte(data), &snapshot)
+	if err != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener config snapshot: %s", err.Error())
+	}
+
+	providerConfig, appErr := model.NewGardenerProviderConfigFromJSON(snapshot.GardenerProviderConfig)
+	if appErr != nil {
+		return model.GardenerConfig{}, dberrors.Internal("Failed to decode Gardener provider config of snapshot: %s", appErr.Error())
+	}
+	snapshot.GardenerConfig.GardenerProviderConfig = providerConfig
+
+	return snapshot.GardenerConfig, nil
+}
+
 func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig, dberrors.Error) {
 	gardenerConfig := gardenerConfigRead{}
 
@@ -317,7 +409,8 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable",
 			"enable_kubernetes_version_auto_update", "enable_machine_image_version_auto_update",
 			"exposure_class_name", "provider_specific_config",
-			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access").
+			"shoot_networking_filter_disabled", "control_plane_failure_tolerance", "eu_access", "hibernation_schedules",
+			"worker_labels", "worker_taints", "kubelet_config", "cri_name", "extensions").
 		From("cluster").
 		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
 		Where(dbr.Eq("cluster.id", runtimeID)).
@@ -341,7 +434,7 @@ func (r readSession) getGardenerConfig(runtimeID string) (model.GardenerConfig,
 
 var (
 	operationColumns = []string{
-		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component",
+		"id", "type", "start_timestamp", "stage", "end_timestamp", "state", "message", "cluster_id", "last_transition", "err_message", "reason", "component", "cancel_requested", "trace_context", "resources_reverted",
 	}
 )
 
@@ -407,6 +500,129 @@ func (r readSession) ListInProgressOperations() ([]model.Operation, dberrors.Err
 	return operations, nil
 }
 
+// ListOrphanedInProgressOperations returns In Progress operations which are not leased by any provisioner instance or whose lease expired.
+func (r readSession) ListOrphanedInProgressOperations(now time.Time) ([]model.Operation, dberrors.Error) {
+	var operations []model.Operation
+
+	_, err := r.session.
+		Select(operationColumns...).
+		From("operation").
+		Where(dbr.And(
+			dbr.Eq("state", model.InProgress),
+			dbr.Or(
+				dbr.Eq("lease_owner", nil),
+				dbr.Lt("lease_expiration", now),
+			),
+		)).
+		Load(&operations)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return []model.Operation{}, nil
+		}
+		return nil, dberrors.Internal("Failed to list orphaned In Progress operations: %s", err)
+	}
+
+	return operations, nil
+}
+
+// ListGardenerConfigsWithoutNetworkingCIDRs returns the cluster ID and Shoot name of existing clusters for which pods or services CIDR is not stored
+// and which were not yet checked by the networking backfill.
+func (r readSession) ListGardenerConfigsWithoutNetworkingCIDRs() ([]model.GardenerConfig, dberrors.Error) {
+	var configs []model.GardenerConfig
+
+	_, err := r.session.
+		Select("gardener_config.cluster_id", "gardener_config.name").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.And(
+			dbr.Eq("cluster.deleted", false),
+			dbr.Eq("gardener_config.networking_cidrs_checked", false),
+			dbr.Or(
+				dbr.Eq("gardener_config.pods_cidr", nil),
+				dbr.Eq("gardener_config.services_cidr", nil),
+			),
+		)).
+		Load(&configs)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return []model.GardenerConfig{}, nil
+		}
+		return nil, dberrors.Internal("Failed to list Gardener configs without networking CIDRs: %s", err)
+	}
+
+	return configs, nil
+}
+
+// ListGardenerConfigs returns versions, machine images and provider configs of existing clusters, optionally filtered by provider and region.
+// Only the name and machine image of additional worker pools are loaded.
+func (r readSession) ListGardenerConfigs(provider, region string) ([]model.GardenerConfig, dberrors.Error) {
+	var configsRead []gardenerConfigRead
+
+	conditions := []dbr.Builder{dbr.Eq("cluster.deleted", false)}
+	if provider != "" {
+		conditions = append(conditions, dbr.Eq("gardener_config.provider", provider))
+	}
+	if region != "" {
+		conditions = append(conditions, dbr.Eq("gardener_config.region", region))
+	}
+
+	_, err := r.session.
+		Select("gardener_config.id", "cluster_id", "gardener_config.name", "kubernetes_version", "machine_image",
+			"machine_image_version", "provider", "region", "provider_specific_config").
+		From("cluster").
+		Join("gardener_config", "cluster.id=gardener_config.cluster_id").
+		Where(dbr.And(conditions...)).
+		OrderBy("cluster_id").
+		Load(&configsRead)
+
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list Gardener configs: %s", err)
+	}
+	if len(configsRead) == 0 {
+		return []model.GardenerConfig{}, nil
+	}
+
+	gardenerConfigIDs := make([]string, 0, len(configsRead))
+	for _, configRead := range configsRead {
+		gardenerConfigIDs = append(gardenerConfigIDs, configRead.ID)
+	}
+
+	var poolsRead []struct {
+		GardenerConfigID string `db:"gardener_config_id"`
+		model.WorkerPool
+	}
+
+	_, err = r.session.
+		Select("gardener_config_id", "name", "machine_image", "machine_image_version").
+		From("worker_pool").
+		Where(dbr.Eq("gardener_config_id", gardenerConfigIDs)).
+		OrderBy("pool_order").
+		Load(&poolsRead)
+
+	if err != nil && err != dbr.ErrNotFound {
+		return nil, dberrors.Internal("Failed to list worker pools: %s", err)
+	}
+
+	pools := make(map[string][]model.WorkerPool)
+	for _, poolRead := range poolsRead {
+		pools[poolRead.GardenerConfigID] = append(pools[poolRead.GardenerConfigID], poolRead.WorkerPool)
+	}
+
+	configs := make([]model.GardenerConfig, 0, len(configsRead))
+	for _, configRead := range configsRead {
+		err := configRead.DecodeProviderConfig()
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode Gardener provider config of %s Runtime: %s", configRead.ClusterID, err.Error())
+		}
+		configRead.AdditionalWorkerPools = pools[configRead.ID]
+		configs = append(configs, configRead.GardenerConfig)
+	}
+
+	return configs, nil
+}
+
 func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade, dberrors.Error) {
 	var runtimeUpgrade model.RuntimeUpgrade
 
@@ -426,6 +642,38 @@ func (r readSession) GetRuntimeUpgrade(operationId string) (model.RuntimeUpgrade
 	return runtimeUpgrade, nil
 }
 
+func (r readSession) GetShootUpgrade(operationID string) (model.ShootUpgrade, dberrors.Error) {
+	var shootUpgrade struct {
+		Id                       string
+		OperationId              string
+		PreUpgradeGardenerConfig string
+	}
+
+	err := r.session.
+		Select("id", "operation_id", "pre_upgrade_gardener_config").
+		From("shoot_upgrade").
+		Where(dbr.Eq("operation_id", operationID)).
+		LoadOne(&shootUpgrade)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return model.ShootUpgrade{}, dberrors.NotFound("Shoot upgrade not found for operation with %s id", operationID)
+		}
+		return model.ShootUpgrade{}, dberrors.Internal("Failed to get Shoot upgrade for operation %s: %s", operationID, err)
+	}
+
+	preUpgradeConfig, dberr := decodeGardenerConfigSnapshot(shootUpgrade.PreUpgradeGardenerConfig)
+	if dberr != nil {
+		return model.ShootUpgrade{}, dberr.Append("Failed to get Shoot upgrade for operation %s", operationID)
+	}
+
+	return model.ShootUpgrade{
+		Id:                       shootUpgrade.Id,
+		OperationId:              shootUpgrade.OperationId,
+		PreUpgradeGardenerConfig: preUpgradeConfig,
+	}, nil
+}
+
 func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberrors.Error) {
 	var opsCount []struct {
 		Type  model.OperationType
@@ -455,6 +703,50 @@ func (r readSession) InProgressOperationsCount() (model.OperationsCount, dberror
 	return operationsCount, nil
 }
 
+func (r readSession) GetOperationStageHistory(operationID string) ([]model.OperationStageHistoryEntry, dberrors.Error) {
+	var entries []model.OperationStageHistoryEntry
+
+	_, err := r.session.
+		Select("id", "operation_id", "stage", "message", "event_timestamp", "err_message", "reason", "component", "retries").
+		From("operation_stage_history").
+		Where(dbr.Eq("operation_id", operationID)).
+		OrderBy("event_timestamp").
+		Load(&entries)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return []model.OperationStageHistoryEntry{}, nil
+		}
+		return nil, dberrors.Internal("Failed to get stage history of operation %s: %s", operationID, err)
+	}
+
+	return entries, nil
+}
+
+func (r readSession) GetOperationWarnings(operationID string) ([]model.UpgradeWarning, dberrors.Error) {
+	var warningsJSON string
+
+	err := r.session.
+		Select("COALESCE(warnings, '[]')").
+		From("operation").
+		Where(dbr.Eq("id", operationID)).
+		LoadOne(&warningsJSON)
+
+	if err != nil {
+		if err == dbr.ErrNotFound {
+			return nil, dberrors.NotFound("Operation not found for id: %s", operationID)
+		}
+		return nil, dberrors.Internal("Failed to get warnings of operation %s: %s", operationID, err)
+	}
+
+	var warnings []model.UpgradeWarning
+	if err := json.Unmarshal([]byte(warningsJSON), &warnings); err != nil {
+		return nil, dberrors.Internal("Failed to decode warnings of operation %s: %s", operationID, err)
+	}
+
+	return warnings, nil
+}
+
 func (r readSession) getOidcConfig(gardenerConfigID string) (model.OIDCConfig, dberrors.Error) {
 	var oidc model.OIDCConfig
 	var algorithms []string
@@ -527,6 +819,52 @@ func (r readSession) getDNSConfig(gardenerConfigID string) (*model.DNSConfig, db
 	return &dnsConfig, nil
 }
 
+// getWorkerPools never returns nil, so that the stored worker pools always replace the ones present in the Shoot.
+func (r readSession) getWorkerPools(gardenerConfigID string) ([]model.WorkerPool, dberrors.Error) {
+	var poolsRead []struct {
+		model.WorkerPool
+		ZonesJSON         *string `db:"zones"`
+		LabelsJSON        *string `db:"labels"`
+		TaintsJSON        *string `db:"taints"`
+		KubeletConfigJSON *string `db:"kubelet_config"`
+	}
+
+	_, err := r.session.
+		Select("name", "machine_type", "machine_image", "machine_image_version", "disk_type", "volume_size_gb",
+			"auto_scaler_min", "auto_scaler_max", "max_surge", "max_unavailable", "zones", "labels", "taints",
+			"kubelet_config", "cri_name").
+		From("worker_pool").
+		Where(dbr.Eq("gardener_config_id", gardenerConfigID)).
+		OrderBy("pool_order").
+		Load(&poolsRead)
+
+	if err != nil {
+		return nil, dberrors.Internal("Failed to get worker pools: %s", err)
+	}
+
+	// No stored pools are returned as nil, so that the worker pools of the Shoot are not synchronized with an empty list
+	if len(poolsRead) == 0 {
+		return nil, nil
+	}
+
+	pools := make([]model.WorkerPool, 0, len(poolsRead))
+	for _, poolRead := range poolsRead {
+		pool := poolRead.WorkerPool
+		err := decodeJSONColumns(
+			jsonColumn{data: poolRead.ZonesJSON, target: &pool.Zones},
+			jsonColumn{data: poolRead.LabelsJSON, target: &pool.Labels},
+			jsonColumn{data: poolRead.TaintsJSON, target: &pool.Taints},
+			jsonColumn{data: poolRead.KubeletConfigJSON, target: &pool.KubeletConfig},
+		)
+		if err != nil {
+			return nil, dberrors.Internal("Failed to decode worker pool %s: %s", pool.Name, err)
+		}
+		pools = append(pools, pool)
+	}
+
+	return pools, nil
+}
+
 func (r readSession) decryptKubeconfig(encryptedKubeconfig *string) (*string, dberrors.Error) {
 	if encryptedKubeconfig == nil {
 		return nil, nil
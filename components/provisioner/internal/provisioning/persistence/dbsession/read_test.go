@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -133,3 +135,40 @@ func Test_parseToKymaConfig(t *testing.T) {
 	}
 
 }
+
+func Test_gardenerConfigSnapshot(t *testing.T) {
+	// given
+	providerConfig, err := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	require.NoError(t, err)
+
+	config := model.GardenerConfig{
+		ID:                     "gardener-config-id",
+		ClusterID:              "runtime-id",
+		Name:                   "shoot",
+		KubernetesVersion:      "1.26.5",
+		MachineType:            "n2-standard-4",
+		Purpose:                util.StringPtr("evaluation"),
+		AutoScalerMin:          1,
+		AutoScalerMax:          3,
+		GardenerProviderConfig: providerConfig,
+		OIDCConfig: &model.OIDCConfig{
+			ClientID:    "client",
+			SigningAlgs: []string{"RS256"},
+		},
+	}
+
+	// when
+	encoded, dberr := encodeGardenerConfigSnapshot(config)
+	require.NoError(t, dberr)
+
+	decoded, dberr := decodeGardenerConfigSnapshot(encoded)
+	require.NoError(t, dberr)
+
+	// then
+	assert.Equal(t, config.GardenerProviderConfig.RawJSON(), decoded.GardenerProviderConfig.RawJSON())
+	assert.Equal(t, config.GardenerProviderConfig.AsProviderSpecificConfig(), decoded.GardenerProviderConfig.AsProviderSpecificConfig())
+
+	decoded.GardenerProviderConfig = nil
+	config.GardenerProviderConfig = nil
+	assert.Equal(t, config, decoded)
+}
@@ -0,0 +1,142 @@
+package dbsession
+
+import (
+	"fmt"
+
+	dbr "github.com/gocraft/dbr/v2"
+
+	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
+)
+
+// encryptedColumn is a column holding encrypted values together with the column indicating whether the value is encrypted
+type encryptedColumn struct {
+	table           string
+	column          string
+	encryptedColumn string
+}
+
+var (
+	kubeconfigColumn    = encryptedColumn{table: "cluster", column: "kubeconfig", encryptedColumn: "is_kubeconfig_encrypted"}
+	administratorColumn = encryptedColumn{table: "cluster_administrator", column: "user_id", encryptedColumn: "is_user_id_encrypted"}
+)
+
+// notEncryptedWithActiveKey matches the values encrypted with other than the active key.
+// If includeLegacy is set, it also matches the values stored in plain text or encrypted in the legacy format without a key ID.
+func (c encryptedColumn) notEncryptedWithActiveKey(activeKeyPrefix string, includeLegacy bool) dbr.Builder {
+	if includeLegacy {
+		return dbr.And(
+			dbr.Neq(c.column, nil),
+			dbr.Expr(fmt.Sprintf("(NOT %s OR left(%s, ?) <> ?)", c.encryptedColumn, c.column), len(activeKeyPrefix), activeKeyPrefix),
+		)
+	}
+
+	return dbr.And(
+		dbr.Neq(c.column, nil),
+		dbr.Eq(c.encryptedColumn, true),
+		dbr.Expr(fmt.Sprintf("position(':' in %s) > 0", c.column)),
+		dbr.Expr(fmt.Sprintf("left(%s, ?) <> ?", c.column), len(activeKeyPrefix), activeKeyPrefix),
+	)
+}
+
+func (r readSession) CountRecordsToReEncrypt(includeLegacy bool) (model.ReEncryptionProgress, dberrors.Error) {
+	kubeconfigs, dberr := r.countRecordsToReEncrypt(kubeconfigColumn, includeLegacy)
+	if dberr != nil {
+		return model.ReEncryptionProgress{}, dberr
+	}
+
+	administrators, dberr := r.countRecordsToReEncrypt(administratorColumn, includeLegacy)
+	if dberr != nil {
+		return model.ReEncryptionProgress{}, dberr
+	}
+
+	return model.ReEncryptionProgress{Kubeconfigs: kubeconfigs, Administrators: administrators}, nil
+}
+
+func (r readSession) countRecordsToReEncrypt(column encryptedColumn, includeLegacy bool) (int, dberrors.Error) {
+	var count int
+
+	err := r.session.
+		Select("count(*)").
+		From(column.table).
+		Where(column.notEncryptedWithActiveKey(r.activeKeyPrefix, includeLegacy)).
+		LoadOne(&count)
+
+	if err != nil {
+		return 0, dberrors.Internal("Failed to count records of %s table to re-encrypt: %s", column.table, err)
+	}
+
+	return count, nil
+}
+
+func (ws writeSession) ReEncryptKubeconfigs(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error) {
+	return ws.reEncrypt(kubeconfigColumn, afterID, limit, includeLegacy)
+}
+
+func (ws writeSession) ReEncryptAdministrators(afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error) {
+	return ws.reEncrypt(administratorColumn, afterID, limit, includeLegacy)
+}
+
+// reEncrypt re-encrypts the values of the records following the given ID with the active key, records are processed in the order of their IDs.
+// A record is updated only if its value did not change in the meantime, so concurrent updates are never overwritten.
+func (ws writeSession) reEncrypt(column encryptedColumn, afterID string, limit int, includeLegacy bool) (model.ReEncryptionBatch, dberrors.Error) {
+	var records []struct {
+		ID        string `db:"id"`
+		Value     string `db:"value"`
+		Encrypted bool   `db:"encrypted"`
+	}
+
+	conditions := []dbr.Builder{column.notEncryptedWithActiveKey(ws.activeKeyPrefix, includeLegacy)}
+	if afterID != "" {
+		conditions = append(conditions, dbr.Gt("id", afterID))
+	}
+
+	_, err := ws.session.
+		Select("id", column.column+" AS value", column.encryptedColumn+" AS encrypted").
+		From(column.table).
+		Where(dbr.And(conditions...)).
+		OrderBy("id").
+		Limit(uint64(limit)).
+		Load(&records)
+
+	if err != nil {
+		return model.ReEncryptionBatch{}, dberrors.Internal("Failed to list records of %s table to re-encrypt: %s", column.table, err)
+	}
+
+	batch := model.ReEncryptionBatch{LastID: afterID}
+	for _, record := range records {
+		batch.LastID = record.ID
+		batch.Processed++
+
+		value := []byte(record.Value)
+		if record.Encrypted {
+			value, err = ws.decrypt(value)
+			if err != nil {
+				batch.Failed++
+				continue
+			}
+		}
+
+		encrypted, err := ws.encrypt(value)
+		if err != nil {
+			batch.Failed++
+			continue
+		}
+
+		res, err := ws.update(column.table).
+			Where(dbr.And(dbr.Eq("id", record.ID), dbr.Eq(column.column, record.Value))).
+			Set(column.column, string(encrypted)).
+			Set(column.encryptedColumn, true).
+			Exec()
+
+		if err != nil {
+			return batch, dberrors.Internal("Failed to update re-encrypted record %s of %s table: %s", record.ID, column.table, err)
+		}
+
+		if rows, err := res.RowsAffected(); err == nil && rows == 1 {
+			batch.ReEncrypted++
+		}
+	}
+
+	return batch, nil
+}
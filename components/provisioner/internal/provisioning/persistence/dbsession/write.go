@@ -15,9 +15,11 @@ import (
 )
 
 type writeSession struct {
-	session     *dbr.Session
-	transaction *dbr.Tx
-	encrypt     encryptFunc
+	session         *dbr.Session
+	transaction     *dbr.Tx
+	encrypt         encryptFunc
+	decrypt         decryptFunc
+	activeKeyPrefix string
 }
 
 func (ws writeSession) InsertCluster(cluster model.Cluster) dberrors.Error {
@@ -78,6 +80,21 @@ func (ws writeSession) InsertAdministrators(clusterId string, administrators []s
 }
 
 func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberrors.Error {
+	hibernationSchedules, dberr := encodeHibernationSchedules(config.HibernationSchedules)
+	if dberr != nil {
+		return dberr.Append("Failed to insert record to GardenerConfig table")
+	}
+
+	workerSettings, encodingErr := encodeJSONColumns(config.WorkerLabels, config.WorkerTaints, config.KubeletConfig)
+	if encodingErr != nil {
+		return dberrors.Internal("Failed to insert record to GardenerConfig table: failed to encode worker settings: %s", encodingErr)
+	}
+
+	extensions, encodingErr := encodeJSONColumns(config.Extensions)
+	if encodingErr != nil {
+		return dberrors.Internal("Failed to insert record to GardenerConfig table: failed to encode extensions: %s", encodingErr)
+	}
+
 	_, err := ws.insertInto("gardener_config").
 		Pair("id", config.ID).
 		Pair("cluster_id", config.ClusterID).
@@ -109,6 +126,12 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		Pair("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Pair("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
 		Pair("eu_access", config.EuAccess).
+		Pair("hibernation_schedules", hibernationSchedules).
+		Pair("worker_labels", workerSettings[0]).
+		Pair("worker_taints", workerSettings[1]).
+		Pair("kubelet_config", workerSettings[2]).
+		Pair("cri_name", config.CRIName).
+		Pair("extensions", extensions[0]).
 		Exec()
 
 	if err != nil {
@@ -129,7 +152,7 @@ func (ws writeSession) InsertGardenerConfig(config model.GardenerConfig) dberror
 		}
 	}
 
-	return nil
+	return ws.insertWorkerPools(config)
 }
 
 func (ws writeSession) insertOidcConfig(config model.GardenerConfig) dberrors.Error {
@@ -192,7 +215,26 @@ func (ws writeSession) insertDNSConfig(config model.GardenerConfig) dberrors.Err
 }
 
 func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig) dberrors.Error {
-	res, err := ws.update("gardener_config").
+	hibernationSchedules, dberr := encodeHibernationSchedules(config.HibernationSchedules)
+	if dberr != nil {
+		return dberr.Append("Failed to update record of configuration for gardener shoot cluster '%s'", config.Name)
+	}
+
+	workerSettings, err := encodeJSONColumns(config.WorkerLabels, config.WorkerTaints, config.KubeletConfig)
+	if err != nil {
+		return dberrors.Internal("Failed to update record of configuration for gardener shoot cluster '%s': failed to encode worker settings: %s", config.Name, err)
+	}
+
+	update := ws.update("gardener_config")
+	if config.Extensions != nil {
+		extensions, err := encodeJSONColumns(config.Extensions)
+		if err != nil {
+			return dberrors.Internal("Failed to update record of configuration for gardener shoot cluster '%s': failed to encode extensions: %s", config.Name, err)
+		}
+		update = update.Set("extensions", extensions[0])
+	}
+
+	res, err := update.
 		Where(dbr.Eq("cluster_id", config.ClusterID)).
 		Set("kubernetes_version", config.KubernetesVersion).
 		Set("purpose", config.Purpose).
@@ -212,6 +254,11 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		Set("provider_specific_config", config.GardenerProviderConfig.RawJSON()).
 		Set("shoot_networking_filter_disabled", config.ShootNetworkingFilterDisabled).
 		Set("control_plane_failure_tolerance", config.ControlPlaneFailureTolerance).
+		Set("hibernation_schedules", hibernationSchedules).
+		Set("worker_labels", workerSettings[0]).
+		Set("worker_taints", workerSettings[1]).
+		Set("kubelet_config", workerSettings[2]).
+		Set("cri_name", config.CRIName).
 		Exec()
 
 	if config.OIDCConfig != nil {
@@ -225,9 +272,35 @@ func (ws writeSession) UpdateGardenerClusterConfig(config model.GardenerConfig)
 		return dberrors.Internal("Failed to update record of configuration for gardener shoot cluster '%s': %s", config.Name, err)
 	}
 
+	if config.AdditionalWorkerPools != nil {
+		dberr = ws.updateWorkerPools(config)
+		if dberr != nil {
+			return dberr.Append("Failed to update worker pools of gardener shoot cluster '%s'", config.Name)
+		}
+	}
+
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update record of configuration for gardener shoot cluster '%s' state: %s", config.Name, err))
 }
 
+func (ws writeSession) InsertShootUpgrade(shootUpgrade model.ShootUpgrade) dberrors.Error {
+	preUpgradeConfig, dberr := encodeGardenerConfigSnapshot(shootUpgrade.PreUpgradeGardenerConfig)
+	if dberr != nil {
+		return dberr.Append("Failed to insert Shoot upgrade for operation %s", shootUpgrade.OperationId)
+	}
+
+	_, err := ws.insertInto("shoot_upgrade").
+		Pair("id", shootUpgrade.Id).
+		Pair("operation_id", shootUpgrade.OperationId).
+		Pair("pre_upgrade_gardener_config", preUpgradeConfig).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert Shoot upgrade for operation %s: %s", shootUpgrade.OperationId, err)
+	}
+
+	return nil
+}
+
 func (ws writeSession) updateOidcConfig(config model.GardenerConfig) dberrors.Error {
 	_, err := ws.deleteFrom("oidc_config").
 		Where(dbr.Eq("gardener_config_id", config.ID)).
@@ -274,6 +347,66 @@ func (ws writeSession) updateOidcConfig(config model.GardenerConfig) dberrors.Er
 	return nil
 }
 
+func (ws writeSession) updateWorkerPools(config model.GardenerConfig) dberrors.Error {
+	_, err := ws.deleteFrom("worker_pool").
+		Where(dbr.Eq("gardener_config_id", config.ID)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to delete records from WorkerPool table: %s", err)
+	}
+
+	return ws.insertWorkerPools(config)
+}
+
+func (ws writeSession) insertWorkerPools(config model.GardenerConfig) dberrors.Error {
+	for i, pool := range config.AdditionalWorkerPools {
+		attributes, err := encodeJSONColumns(pool.Zones, pool.Labels, pool.Taints, pool.KubeletConfig)
+		if err != nil {
+			return dberrors.Internal("Failed to encode worker pool %s: %s", pool.Name, err)
+		}
+
+		_, err = ws.insertInto("worker_pool").
+			Pair("id", uuid.New().String()).
+			Pair("gardener_config_id", config.ID).
+			Pair("name", pool.Name).
+			Pair("machine_type", pool.MachineType).
+			Pair("machine_image", pool.MachineImage).
+			Pair("machine_image_version", pool.MachineImageVersion).
+			Pair("disk_type", pool.DiskType).
+			Pair("volume_size_gb", pool.VolumeSizeGB).
+			Pair("auto_scaler_min", pool.AutoScalerMin).
+			Pair("auto_scaler_max", pool.AutoScalerMax).
+			Pair("max_surge", pool.MaxSurge).
+			Pair("max_unavailable", pool.MaxUnavailable).
+			Pair("zones", attributes[0]).
+			Pair("labels", attributes[1]).
+			Pair("taints", attributes[2]).
+			Pair("kubelet_config", attributes[3]).
+			Pair("cri_name", pool.CRIName).
+			Pair("pool_order", i).
+			Exec()
+
+		if err != nil {
+			return dberrors.Internal("Failed to insert record to WorkerPool table: %s", err)
+		}
+	}
+	return nil
+}
+
+// encodeJSONColumns encodes the values stored in jsonb columns, nil values are encoded as JSON null.
+func encodeJSONColumns(values ...interface{}) ([]string, error) {
+	encoded := make([]string, 0, len(values))
+	for _, value := range values {
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, string(data))
+	}
+	return encoded, nil
+}
+
 func (ws writeSession) insertKymaComponentConfig(kymaConfigModule model.KymaComponentConfig) dberrors.Error {
 	jsonConfig, err := json.Marshal(kymaConfigModule.Configuration)
 	if err != nil {
@@ -307,7 +440,13 @@ func (ws writeSession) InsertOperation(operation model.Operation) dberrors.Error
 		return dberrors.Internal("Failed to insert record to Type table: %s", err)
 	}
 
-	return nil
+	return ws.InsertOperationStageHistoryEntry(model.OperationStageHistoryEntry{
+		ID:             uuid.New().String(),
+		OperationID:    operation.ID,
+		Stage:          operation.Stage,
+		Message:        operation.Message,
+		EventTimestamp: operation.StartTimestamp,
+	})
 }
 
 func (ws writeSession) DeleteCluster(runtimeID string) dberrors.Error {
@@ -377,6 +516,155 @@ func (ws writeSession) TransitionOperation(operationID string, message string, s
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update operation %s state: %s", operationID, err))
 }
 
+// AcquireOperationLease claims or renews the operation lease for the given owner.
+// The lease can be taken only if it is free, already held by the owner or expired.
+func (ws writeSession) AcquireOperationLease(operationID, owner string, heartbeat time.Time, leaseDuration time.Duration) (bool, dberrors.Error) {
+	res, err := ws.update("operation").
+		Where(dbr.And(
+			dbr.Eq("id", operationID),
+			dbr.Or(
+				dbr.Eq("lease_owner", nil),
+				dbr.Eq("lease_owner", owner),
+				dbr.Lt("lease_expiration", heartbeat),
+			),
+		)).
+		Set("lease_owner", owner).
+		Set("lease_heartbeat", heartbeat).
+		Set("lease_expiration", heartbeat.Add(leaseDuration)).
+		Exec()
+
+	if err != nil {
+		return false, dberrors.Internal("Failed to acquire lease for operation %s: %s", operationID, err)
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, dberrors.Internal("Failed to get number of rows affected: %s", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (ws writeSession) ReleaseOperationLease(operationID, owner string) dberrors.Error {
+	_, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("lease_owner", owner))).
+		Set("lease_owner", nil).
+		Set("lease_heartbeat", nil).
+		Set("lease_expiration", nil).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to release lease for operation %s: %s", operationID, err)
+	}
+
+	return nil
+}
+
+func (ws writeSession) RequestOperationCancellation(operationID string) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.InProgress))).
+		Set("cancel_requested", true).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to request cancellation of operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to request cancellation of operation %s: operation not found or not in progress", operationID))
+}
+
+// RetryOperation moves the failed operation back to the In Progress state keeping its stage.
+// Operations whose resources were reverted after the failure are not retried.
+func (ws writeSession) RetryOperation(operationID string, message string, transitionTime time.Time) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.And(dbr.Eq("id", operationID), dbr.Eq("state", model.Failed), dbr.Eq("resources_reverted", false))).
+		Set("state", model.InProgress).
+		Set("message", message).
+		Set("end_timestamp", nil).
+		Set("last_transition", transitionTime).
+		Set("err_message", "").
+		Set("reason", "").
+		Set("component", "").
+		Set("cancel_requested", false).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to retry operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to retry operation %s: operation not found, not failed or its resources reverted", operationID))
+}
+
+// MarkOperationResourcesReverted records that the failure handler removed or rolled back the resources of the failed operation.
+func (ws writeSession) MarkOperationResourcesReverted(operationID string) dberrors.Error {
+	res, err := ws.update("operation").
+		Where(dbr.Eq("id", operationID)).
+		Set("resources_reverted", true).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to mark resources of operation %s as reverted: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to mark resources of operation %s as reverted: operation not found", operationID))
+}
+
+func (ws writeSession) InsertOperationStageHistoryEntry(entry model.OperationStageHistoryEntry) dberrors.Error {
+	_, err := ws.insertInto("operation_stage_history").
+		Pair("id", entry.ID).
+		Pair("operation_id", entry.OperationID).
+		Pair("stage", entry.Stage).
+		Pair("message", entry.Message).
+		Pair("event_timestamp", entry.EventTimestamp).
+		Pair("err_message", entry.ErrMessage).
+		Pair("reason", entry.Reason).
+		Pair("component", entry.Component).
+		Pair("retries", entry.Retries).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to insert stage history entry of operation %s: %s", entry.OperationID, err)
+	}
+
+	return nil
+}
+
+// RecordStageRetry counts the retry of the stage in its latest stage history entry and stores the error which caused it
+func (ws writeSession) RecordStageRetry(operationID string, stage model.OperationStage, lastError model.LastError) dberrors.Error {
+	res, err := ws.update("operation_stage_history").
+		Where(dbr.Expr("id = (SELECT latest.id FROM operation_stage_history latest WHERE latest.operation_id = ? AND latest.stage = ? "+
+			"ORDER BY latest.event_timestamp DESC LIMIT 1)", operationID, stage)).
+		Set("retries", dbr.Expr("retries + 1")).
+		Set("err_message", lastError.ErrMessage).
+		Set("reason", lastError.Reason).
+		Set("component", lastError.Component).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to record retry of stage %s of operation %s: %s", stage, operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to record retry of stage %s of operation %s: stage history entry not found", stage, operationID))
+}
+
+func (ws writeSession) UpdateOperationWarnings(operationID string, warnings []model.UpgradeWarning) dberrors.Error {
+	warningsJSON, err := json.Marshal(warnings)
+	if err != nil {
+		return dberrors.Internal("Failed to encode warnings of operation %s: %s", operationID, err)
+	}
+
+	res, err := ws.update("operation").
+		Where(dbr.Eq("id", operationID)).
+		Set("warnings", string(warningsJSON)).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update warnings of operation %s: %s", operationID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update warnings of operation %s: %s", operationID, err))
+}
+
 func (ws writeSession) UpdateKubeconfig(runtimeID string, kubeconfig string) dberrors.Error {
 	encryptedKubeconfig, dberr := ws.encryptString(kubeconfig)
 	if dberr != nil {
@@ -422,6 +710,37 @@ func (ws writeSession) UpdateShootNetworkingFilterDisabled(runtimeID string, sho
 	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update shoot networking filter disabled in %s cluster: %s", runtimeID, err))
 }
 
+// UpdateNetworkingCIDRs stores pods and services CIDR of the cluster without overwriting values that are already set.
+// The cluster is marked as checked, so it is not returned by ListGardenerConfigsWithoutNetworkingCIDRs again.
+func (ws writeSession) UpdateNetworkingCIDRs(runtimeID string, podsCIDR, servicesCIDR *string) dberrors.Error {
+	res, err := ws.update("gardener_config").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		Set("pods_cidr", dbr.Expr("COALESCE(pods_cidr, ?)", podsCIDR)).
+		Set("services_cidr", dbr.Expr("COALESCE(services_cidr, ?)", servicesCIDR)).
+		Set("networking_cidrs_checked", true).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to update networking CIDRs in %s cluster: %s", runtimeID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to update networking CIDRs in %s cluster: %s", runtimeID, err))
+}
+
+// MarkNetworkingCIDRsChecked marks the cluster as checked by the networking backfill, which had no CIDRs to store.
+func (ws writeSession) MarkNetworkingCIDRsChecked(runtimeID string) dberrors.Error {
+	res, err := ws.update("gardener_config").
+		Where(dbr.Eq("cluster_id", runtimeID)).
+		Set("networking_cidrs_checked", true).
+		Exec()
+
+	if err != nil {
+		return dberrors.Internal("Failed to mark networking CIDRs of %s cluster as checked: %s", runtimeID, err)
+	}
+
+	return ws.updateSucceeded(res, fmt.Sprintf("Failed to mark networking CIDRs of %s cluster as checked: %s", runtimeID, err))
+}
+
 func (ws writeSession) MarkClusterAsDeleted(runtimeID string) dberrors.Error {
 	res, err := ws.update("cluster").
 		Where(dbr.Eq("id", runtimeID)).
@@ -504,3 +823,17 @@ func (ws writeSession) encryptString(s string) (string, dberrors.Error) {
 	}
 	return string(encrypted), nil
 }
+
+func encodeHibernationSchedules(schedules []model.HibernationSchedule) (*string, dberrors.Error) {
+	if schedules == nil {
+		return nil, nil
+	}
+
+	data, err := json.Marshal(schedules)
+	if err != nil {
+		return nil, dberrors.Internal("Failed to encode hibernation schedules: %s", err.Error())
+	}
+
+	encoded := string(data)
+	return &encoded, nil
+}
@@ -1,6 +1,8 @@
 package provisioning
 
 import (
+	"context"
+	"fmt"
 	"time"
 
 	gardener_Types "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -11,6 +13,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/operations/queue"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/persistence/dberrors"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 	uuid "github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -19,19 +22,33 @@ import (
 
 //go:generate mockery --name=Service
 type Service interface {
-	ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
-	DeprovisionRuntime(id string) (string, apperrors.AppError)
-	UpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
+	DryRunProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError)
+	DeprovisionRuntime(ctx context.Context, id string) (string, apperrors.AppError)
+	UpgradeGardenerShoot(ctx context.Context, id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
+	DryRunUpgradeGardenerShoot(id string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError)
 	ReconnectRuntimeAgent(id string) (string, apperrors.AppError)
 	RuntimeStatus(id string) (*gqlschema.RuntimeStatus, apperrors.AppError)
 	RuntimeOperationStatus(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	CancelOperation(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	RetryOperation(id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	HibernateCluster(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	WakeUpCluster(ctx context.Context, id string) (*gqlschema.OperationStatus, apperrors.AppError)
+	VersionDrift(provider, region string, expiringWithin time.Duration) ([]*gqlschema.VersionDriftGroup, apperrors.AppError)
+	ListRuntimes(filter *gqlschema.RuntimesFilter, page *gqlschema.PageInput) (*gqlschema.RuntimePage, apperrors.AppError)
+	ListOperations(filter *gqlschema.OperationsFilter, page *gqlschema.PageInput) (*gqlschema.OperationPage, apperrors.AppError)
 }
 
 //go:generate mockery --name=Provisioner
 type Provisioner interface {
-	ProvisionCluster(cluster model.Cluster, operationId string) apperrors.AppError
-	DeprovisionCluster(cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
-	UpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) apperrors.AppError
+	ProvisionCluster(ctx context.Context, cluster model.Cluster, operationId string) apperrors.AppError
+	DryRunProvisionCluster(cluster model.Cluster) (*gardener_Types.Shoot, apperrors.AppError)
+	DeprovisionCluster(ctx context.Context, cluster model.Cluster, operationId string) (model.Operation, apperrors.AppError)
+	UpgradeCluster(ctx context.Context, clusterID string, upgradeConfig model.GardenerConfig, operationID string) apperrors.AppError
+	DryRunUpgradeCluster(clusterID string, upgradeConfig model.GardenerConfig) (*gardener_Types.Shoot, *gardener_Types.Shoot, apperrors.AppError)
+	HibernateCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError
+	WakeUpCluster(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError
+	DefaultHibernationSchedules(purpose string) ([]model.HibernationSchedule, apperrors.AppError)
 }
 
 //go:generate mockery --name=ShootProvider
@@ -39,11 +56,23 @@ type ShootProvider interface {
 	Get(runtimeID string, tenant string) (gardener_Types.Shoot, apperrors.AppError)
 }
 
+//go:generate mockery --name=UpgradeChecker
+type UpgradeChecker interface {
+	CheckUpgrade(shoot gardener_Types.Shoot, config model.GardenerConfig, kubeconfig *string) ([]model.UpgradeWarning, apperrors.AppError)
+}
+
+//go:generate mockery --name=VersionDriftReporter
+type VersionDriftReporter interface {
+	Report(provider, region string, expiringWithin time.Duration) ([]model.VersionDriftGroup, apperrors.AppError)
+}
+
 type service struct {
 	inputConverter   InputConverter
 	graphQLConverter GraphQLConverter
 	directorService  director.DirectorClient
 	shootProvider    ShootProvider
+	upgradeChecker   UpgradeChecker
+	driftReporter    VersionDriftReporter
 
 	dbSessionFactory dbsession.Factory
 	provisioner      Provisioner
@@ -54,6 +83,7 @@ type service struct {
 	upgradeQueue        queue.OperationQueue
 	shootUpgradeQueue   queue.OperationQueue
 	hibernationQueue    queue.OperationQueue
+	wakeUpQueue         queue.OperationQueue
 }
 
 func NewProvisioningService(
@@ -64,10 +94,13 @@ func NewProvisioningService(
 	provisioner Provisioner,
 	generator uuid.UUIDGenerator,
 	shootProvider ShootProvider,
+	upgradeChecker UpgradeChecker,
+	driftReporter VersionDriftReporter,
 	provisioningQueue queue.OperationQueue,
 	deprovisioningQueue queue.OperationQueue,
 	shootUpgradeQueue queue.OperationQueue,
-
+	hibernationQueue queue.OperationQueue,
+	wakeUpQueue queue.OperationQueue,
 ) Service {
 	return &service{
 		inputConverter:      inputConverter,
@@ -79,17 +112,21 @@ func NewProvisioningService(
 		provisioningQueue:   provisioningQueue,
 		deprovisioningQueue: deprovisioningQueue,
 		shootUpgradeQueue:   shootUpgradeQueue,
+		hibernationQueue:    hibernationQueue,
+		wakeUpQueue:         wakeUpQueue,
 		shootProvider:       shootProvider,
+		upgradeChecker:      upgradeChecker,
+		driftReporter:       driftReporter,
 	}
 }
 
-func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) ProvisionRuntime(ctx context.Context, config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
 	runtimeInput := config.RuntimeInput
 
 	var runtimeID string
 
 	err := util.RetryOnError(5*time.Second, 3, "Error while registering runtime in Director: %s", func() (err apperrors.AppError) {
-		runtimeID, err = r.directorService.CreateRuntime(runtimeInput, tenant)
+		runtimeID, err = r.directorService.CreateRuntime(ctx, runtimeInput, tenant)
 		return
 	})
 
@@ -99,7 +136,13 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 
 	cluster, err := r.inputConverter.ProvisioningInputToCluster(runtimeID, config, tenant, subAccount)
 	if err != nil {
-		r.unregisterFailedRuntime(runtimeID, tenant)
+		r.unregisterFailedRuntime(ctx, runtimeID, tenant)
+		return nil, err
+	}
+
+	err = r.setDefaultHibernationSchedules(&cluster.ClusterConfig)
+	if err != nil {
+		r.unregisterFailedRuntime(ctx, runtimeID, tenant)
 		return nil, err
 	}
 
@@ -110,21 +153,21 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 	defer dbSession.RollbackUnlessCommitted()
 
 	// Try to set provisioning started before triggering it (which is hard to interrupt) to verify all unique constraints
-	operation, dberr := r.setProvisioningStarted(dbSession, runtimeID, cluster)
+	operation, dberr := r.setProvisioningStarted(ctx, dbSession, runtimeID, cluster)
 	if dberr != nil {
-		r.unregisterFailedRuntime(runtimeID, tenant)
+		r.unregisterFailedRuntime(ctx, runtimeID, tenant)
 		return nil, dberr
 	}
 
-	err = r.provisioner.ProvisionCluster(cluster, operation.ID)
+	err = r.provisioner.ProvisionCluster(ctx, cluster, operation.ID)
 	if err != nil {
-		r.unregisterFailedRuntime(runtimeID, tenant)
+		r.unregisterFailedRuntime(ctx, runtimeID, tenant)
 		return nil, err.Append("Failed to start provisioning")
 	}
 
 	dberr = dbSession.Commit()
 	if dberr != nil {
-		r.unregisterFailedRuntime(runtimeID, tenant)
+		r.unregisterFailedRuntime(ctx, runtimeID, tenant)
 		return nil, dberr
 	}
 
@@ -134,10 +177,44 @@ func (r *service) ProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenan
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
-func (r *service) unregisterFailedRuntime(id, tenant string) {
+// DryRunProvisionRuntime renders the Shoot for the provisioning input without registering the Runtime in Director,
+// storing anything in the database, or creating the Shoot.
+func (r *service) DryRunProvisionRuntime(config gqlschema.ProvisionRuntimeInput, tenant, subAccount string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	// The Runtime is not registered in Director, so a placeholder ID is used to render the Shoot
+	runtimeID := r.uuidGenerator.New()
+
+	cluster, err := r.inputConverter.ProvisioningInputToCluster(runtimeID, config, tenant, subAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.setDefaultHibernationSchedules(&cluster.ClusterConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	shoot, err := r.provisioner.DryRunProvisionCluster(cluster)
+	if err != nil {
+		return nil, err.Append("Failed to dry run provisioning")
+	}
+
+	result, err := newDryRunResult(nil, shoot)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gqlschema.OperationStatus{
+		Operation:    gqlschema.OperationTypeProvision,
+		State:        gqlschema.OperationStateSucceeded,
+		Message:      util.StringPtr("Dry run of provisioning succeeded"),
+		DryRunResult: result,
+	}, nil
+}
+
+func (r *service) unregisterFailedRuntime(ctx context.Context, id, tenant string) {
 	log.Infof("Starting provisioning failed. Unregistering Runtime %s...", id)
 	err := util.RetryOnError(10*time.Second, 3, "Error while unregistering runtime in Director: %s", func() (err apperrors.AppError) {
-		err = r.directorService.DeleteRuntime(id, tenant)
+		err = r.directorService.DeleteRuntime(ctx, id, tenant)
 		return
 	})
 	if err != nil {
@@ -145,7 +222,7 @@ func (r *service) unregisterFailedRuntime(id, tenant string) {
 	}
 }
 
-func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
+func (r *service) DeprovisionRuntime(ctx context.Context, id string) (string, apperrors.AppError) {
 	session := r.dbSessionFactory.NewReadWriteSession()
 
 	appErr := r.verifyLastOperationFinished(session, id)
@@ -158,10 +235,11 @@ func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 		return "", dberr
 	}
 
-	operation, appErr := r.provisioner.DeprovisionCluster(cluster, r.uuidGenerator.New())
+	operation, appErr := r.provisioner.DeprovisionCluster(ctx, cluster, r.uuidGenerator.New())
 	if appErr != nil {
 		return "", apperrors.Internal("Failed to start deprovisioning: %s", appErr.Error()).SetComponent(appErr.Component()).SetReason(appErr.Reason())
 	}
+	operation.TraceContext = tracing.Inject(ctx)
 
 	dberr = session.InsertOperation(operation)
 	if dberr != nil {
@@ -174,39 +252,111 @@ func (r *service) DeprovisionRuntime(id string) (string, apperrors.AppError) {
 	return operation.ID, nil
 }
 
-func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+func (r *service) UpgradeGardenerShoot(ctx context.Context, runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
 	log.Infof("Starting Upgrade of Gardener Shoot for Runtime '%s'...", runtimeID)
 
+	cluster, gardenerConfig, warnings, err := r.prepareShootUpgrade(runtimeID, input)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
+	}
+	defer txSession.RollbackUnlessCommitted()
+
+	operation, gardError := r.setGardenerShootUpgradeStarted(ctx, txSession, cluster, gardenerConfig, input.Administrators)
+	if gardError != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set shoot upgrade started: %s", gardError.Error())
+	}
+
+	if len(warnings) > 0 {
+		dbErr = txSession.UpdateOperationWarnings(operation.ID, warnings)
+		if dbErr != nil {
+			return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to store upgrade warnings: %s", dbErr.Error())
+		}
+		operation.Warnings = warnings
+	}
+
+	err = r.provisioner.UpgradeCluster(ctx, cluster.ID, gardenerConfig, operation.ID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to upgrade Cluster: %s", err.Error())
+	}
+
+	dbErr = txSession.Commit()
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit upgrade transaction: %s", dbErr.Error())
+	}
+
+	r.shootUpgradeQueue.Add(operation.ID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+// DryRunUpgradeGardenerShoot renders the upgraded Shoot of the Runtime and its diff against the current Shoot without
+// storing anything in the database or changing the Shoot.
+func (r *service) DryRunUpgradeGardenerShoot(runtimeID string, input gqlschema.UpgradeShootInput) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting dry run of Upgrade of Gardener Shoot for Runtime '%s'...", runtimeID)
+
+	cluster, gardenerConfig, warnings, err := r.prepareShootUpgrade(runtimeID, input)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	currentShoot, upgradedShoot, err := r.provisioner.DryRunUpgradeCluster(cluster.ID, gardenerConfig)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err.Append("Failed to dry run upgrade of Cluster")
+	}
+
+	result, err := newDryRunResult(currentShoot, upgradedShoot)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	return &gqlschema.OperationStatus{
+		Operation:    gqlschema.OperationTypeUpgradeShoot,
+		State:        gqlschema.OperationStateSucceeded,
+		Message:      util.StringPtr("Dry run of Shoot upgrade succeeded"),
+		RuntimeID:    &cluster.ID,
+		DryRunResult: result,
+		Warnings:     upgradeWarningsToGraphQL(warnings),
+	}, nil
+}
+
+// prepareShootUpgrade validates the upgrade input against the current state of the Runtime, converts it to the upgraded Gardener config
+// and runs the upgrade pre-flight checks.
+func (r *service) prepareShootUpgrade(runtimeID string, input gqlschema.UpgradeShootInput) (model.Cluster, model.GardenerConfig, []model.UpgradeWarning, apperrors.AppError) {
 	if input.GardenerConfig == nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Error: Gardener config is nil")
+		return model.Cluster{}, model.GardenerConfig{}, nil, apperrors.Internal("Error: Gardener config is nil")
 	}
 
 	session := r.dbSessionFactory.NewReadSession()
 
 	err := r.verifyLastOperationFinished(session, runtimeID)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err
+		return model.Cluster{}, model.GardenerConfig{}, nil, err
 	}
 
 	cluster, dberr := session.GetCluster(runtimeID)
 	if dberr != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to find shoot cluster to upgrade in database: %s", dberr.Error())
+		return model.Cluster{}, model.GardenerConfig{}, nil, apperrors.Internal("Failed to find shoot cluster to upgrade in database: %s", dberr.Error())
 	}
 
 	gardenerConfig, err := r.inputConverter.UpgradeShootInputToGardenerConfig(*input.GardenerConfig, cluster.ClusterConfig)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
+		return model.Cluster{}, model.GardenerConfig{}, nil, err.Append("Failed to convert GardenerClusterUpgradeConfig: %s", err.Error())
 	}
 
 	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to get shoot")
+		return model.Cluster{}, model.GardenerConfig{}, nil, err.Append("Failed to get shoot")
 	}
 
 	// This is a workaround for a problem with Kubernetes auto upgrade. If Kubernetes gets updated the current Kubernetes version is obtained for the shoot and stored in the database.
 	shouldTakeShootKubernetesVersion, err := isVersionHigher(shoot.Spec.Kubernetes.Version, gardenerConfig.KubernetesVersion)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Failed to check if the shoot kubernetes version is higher than the config one")
+		return model.Cluster{}, model.GardenerConfig{}, nil, err.Append("Failed to check if the shoot kubernetes version is higher than the config one")
 	}
 	if shouldTakeShootKubernetesVersion {
 		log.Infof("Kubernetes version in shoot was higher than the version provided in UpgradeGardenerShoot. Version fetched from the shoot will be used :%s.", shoot.Spec.Kubernetes.Version)
@@ -223,34 +373,134 @@ func (r *service) UpgradeGardenerShoot(runtimeID string, input gqlschema.Upgrade
 	// Validate provider specific changes to the shoot
 	err = gardenerConfig.GardenerProviderConfig.ValidateShootConfigChange(&shoot)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, err.Append("Invalid gardener provider config change")
+		return model.Cluster{}, model.GardenerConfig{}, nil, err.Append("Invalid gardener provider config change")
+	}
+
+	warnings, err := r.upgradeChecker.CheckUpgrade(shoot, gardenerConfig, cluster.Kubeconfig)
+	if err != nil {
+		return model.Cluster{}, model.GardenerConfig{}, nil, err.Append("Upgrade pre-flight checks failed")
+	}
+
+	return cluster, gardenerConfig, warnings, nil
+}
+
+func (r *service) HibernateCluster(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting hibernation of Runtime '%s'...", runtimeID)
+
+	cluster, shoot, err := r.getClusterWithShoot(runtimeID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	if isHibernationEnabled(shoot) {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("Runtime %s is already hibernated", runtimeID)
+	}
+	if !getHibernationStatus(shoot).HibernationPossible {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("hibernation of Runtime %s is not possible", runtimeID)
+	}
+
+	return r.startHibernationOperation(ctx, cluster, model.Hibernate, model.WaitForHibernation, "Starting Runtime hibernation", r.provisioner.HibernateCluster, r.hibernationQueue)
+}
+
+func (r *service) WakeUpCluster(ctx context.Context, runtimeID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	log.Infof("Starting wake-up of Runtime '%s'...", runtimeID)
+
+	cluster, shoot, err := r.getClusterWithShoot(runtimeID)
+	if err != nil {
+		return &gqlschema.OperationStatus{}, err
+	}
+
+	if !isHibernationEnabled(shoot) {
+		return &gqlschema.OperationStatus{}, apperrors.BadRequest("Runtime %s is not hibernated", runtimeID)
+	}
+
+	return r.startHibernationOperation(ctx, cluster, model.WakeUp, model.WaitForWakeUp, "Starting Runtime wake-up", r.provisioner.WakeUpCluster, r.wakeUpQueue)
+}
+
+func (r *service) VersionDrift(provider, region string, expiringWithin time.Duration) ([]*gqlschema.VersionDriftGroup, apperrors.AppError) {
+	groups, err := r.driftReporter.Report(provider, region, expiringWithin)
+	if err != nil {
+		return nil, err.Append("Failed to report version drift")
 	}
+
+	return versionDriftGroupsToGraphQL(groups), nil
+}
+
+func (r *service) getClusterWithShoot(runtimeID string) (model.Cluster, gardener_Types.Shoot, apperrors.AppError) {
+	session := r.dbSessionFactory.NewReadSession()
+
+	err := r.verifyLastOperationFinished(session, runtimeID)
+	if err != nil {
+		return model.Cluster{}, gardener_Types.Shoot{}, err
+	}
+
+	cluster, dberr := session.GetCluster(runtimeID)
+	if dberr != nil {
+		return model.Cluster{}, gardener_Types.Shoot{}, apperrors.Internal("Failed to find shoot cluster in database: %s", dberr.Error())
+	}
+
+	shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
+	if err != nil {
+		return model.Cluster{}, gardener_Types.Shoot{}, err.Append("Failed to get shoot")
+	}
+
+	return cluster, shoot, nil
+}
+
+func (r *service) startHibernationOperation(
+	ctx context.Context,
+	cluster model.Cluster,
+	operationType model.OperationType,
+	stage model.OperationStage,
+	message string,
+	setHibernation func(ctx context.Context, clusterID string, gardenerConfig model.GardenerConfig, operationID string) apperrors.AppError,
+	operationQueue queue.OperationQueue) (*gqlschema.OperationStatus, apperrors.AppError) {
+
 	txSession, dbErr := r.dbSessionFactory.NewSessionWithinTransaction()
 	if dbErr != nil {
 		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to start database transaction: %s", dbErr.Error())
 	}
 	defer txSession.RollbackUnlessCommitted()
 
-	operation, gardError := r.setGardenerShootUpgradeStarted(txSession, cluster, gardenerConfig, input.Administrators)
-	if gardError != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set shoot upgrade started: %s", gardError.Error())
+	operation, dbErr := r.setOperationStarted(ctx, txSession, cluster.ID, operationType, stage, time.Now(), message)
+	if dbErr != nil {
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to set %s operation started: %s", operationType, dbErr.Error())
 	}
 
-	err = r.provisioner.UpgradeCluster(cluster.ID, gardenerConfig)
+	err := setHibernation(ctx, cluster.ID, cluster.ClusterConfig, operation.ID)
 	if err != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to upgrade Cluster: %s", err.Error())
+		return &gqlschema.OperationStatus{}, err.Append("Failed to set hibernation of Shoot")
 	}
 
 	dbErr = txSession.Commit()
 	if dbErr != nil {
-		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit upgrade transaction: %s", dbErr.Error())
+		return &gqlschema.OperationStatus{}, apperrors.Internal("Failed to commit %s transaction: %s", operationType, dbErr.Error())
 	}
 
-	r.shootUpgradeQueue.Add(operation.ID)
+	operationQueue.Add(operation.ID)
 
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func isHibernationEnabled(shoot gardener_Types.Shoot) bool {
+	hibernation := shoot.Spec.Hibernation
+	return hibernation != nil && hibernation.Enabled != nil && *hibernation.Enabled
+}
+
+func getHibernationStatus(shoot gardener_Types.Shoot) model.HibernationStatus {
+	hibernationPossible := true
+	for _, constraint := range shoot.Status.Constraints {
+		if constraint.Type == gardener_Types.ShootHibernationPossible && constraint.Status == gardener_Types.ConditionFalse {
+			hibernationPossible = false
+		}
+	}
+
+	return model.HibernationStatus{
+		Hibernated:          shoot.Status.IsHibernated,
+		HibernationPossible: hibernationPossible,
+	}
+}
+
 func (r *service) verifyLastOperationFinished(session dbsession.ReadSession, runtimeId string) apperrors.AppError {
 	lastOperation, dberr := session.GetLastOperation(runtimeId)
 	if dberr != nil {
@@ -285,9 +535,110 @@ func (r *service) RuntimeOperationStatus(operationID string) (*gqlschema.Operati
 		return nil, dberr.Append("failed to get Runtime Operation Status")
 	}
 
+	operation.StageHistory, dberr = readSession.GetOperationStageHistory(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Runtime Operation Status")
+	}
+
+	operation.Warnings, dberr = readSession.GetOperationWarnings(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get Runtime Operation Status")
+	}
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) CancelOperation(operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	session := r.dbSessionFactory.NewReadWriteSession()
+
+	operation, dberr := session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation")
+	}
+
+	if operation.State != model.InProgress {
+		return nil, apperrors.BadRequest("cannot cancel operation %s in %s state", operationID, operation.State)
+	}
+
+	dberr = session.RequestOperationCancellation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to request operation cancellation")
+	}
+
+	log.Infof("Cancellation of operation %s for Runtime %s requested", operationID, operation.ClusterID)
+	operation.CancelRequested = true
+
 	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
 }
 
+func (r *service) RetryOperation(operationID string) (*gqlschema.OperationStatus, apperrors.AppError) {
+	session := r.dbSessionFactory.NewReadWriteSession()
+
+	operation, dberr := session.GetOperation(operationID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get operation")
+	}
+
+	if operation.State != model.Failed {
+		return nil, apperrors.BadRequest("cannot retry operation %s in %s state", operationID, operation.State)
+	}
+
+	if operation.ResourcesReverted {
+		return nil, apperrors.BadRequest("cannot retry operation %s as the resources of %s Runtime were removed or rolled back after the failure", operationID, operation.ClusterID)
+	}
+
+	lastOperation, dberr := session.GetLastOperation(operation.ClusterID)
+	if dberr != nil {
+		return nil, dberr.Append("failed to get last operation")
+	}
+
+	if lastOperation.ID != operation.ID {
+		return nil, apperrors.BadRequest("cannot retry operation %s as it is not the last operation of %s Runtime", operationID, operation.ClusterID)
+	}
+
+	operationQueue, found := r.queueForOperation(operation.Type)
+	if !found {
+		return nil, apperrors.BadRequest("cannot retry operation %s of %s type", operationID, operation.Type)
+	}
+
+	transitionTime := time.Now()
+	message := fmt.Sprintf("Operation retried. Stage %s", operation.Stage)
+
+	dberr = session.RetryOperation(operationID, message, transitionTime)
+	if dberr != nil {
+		return nil, dberr.Append("failed to retry operation")
+	}
+
+	operation.State = model.InProgress
+	operation.Message = message
+	operation.EndTimestamp = nil
+	operation.LastTransition = &transitionTime
+	operation.LastError = model.LastError{}
+	operation.CancelRequested = false
+
+	log.Infof("Retrying operation %s for Runtime %s from %s stage", operationID, operation.ClusterID, operation.Stage)
+	operationQueue.Add(operationID)
+
+	return r.graphQLConverter.OperationStatusToGQLOperationStatus(operation), nil
+}
+
+func (r *service) queueForOperation(operationType model.OperationType) (queue.OperationQueue, bool) {
+	switch operationType {
+	case model.Provision:
+		return r.provisioningQueue, true
+	case model.DeprovisionNoInstall:
+		return r.deprovisioningQueue, true
+	case model.UpgradeShoot:
+		return r.shootUpgradeQueue, true
+	case model.Hibernate:
+		return r.hibernationQueue, true
+	case model.WakeUp:
+		return r.wakeUpQueue, true
+	default:
+		return nil, false
+	}
+}
+
 func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apperrors.AppError) {
 	session := r.dbSessionFactory.NewReadSession()
 
@@ -301,13 +652,41 @@ func (r *service) getRuntimeStatus(runtimeID string) (model.RuntimeStatus, apper
 		return model.RuntimeStatus{}, err
 	}
 
+	var hibernationStatus *model.HibernationStatus
+	if !cluster.Deleted {
+		shoot, err := r.shootProvider.Get(runtimeID, cluster.Tenant)
+		if err != nil {
+			log.Warnf("Failed to get Shoot of Runtime %s to check its hibernation status: %s", runtimeID, err.Error())
+		} else {
+			status := getHibernationStatus(shoot)
+			hibernationStatus = &status
+		}
+	}
+
 	return model.RuntimeStatus{
 		LastOperationStatus:  operation,
 		RuntimeConfiguration: cluster,
+		HibernationStatus:    hibernationStatus,
 	}, nil
 }
 
-func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster) (model.Operation, dberrors.Error) {
+// setDefaultHibernationSchedules sets the default hibernation schedules of the cluster purpose when no schedules were provided,
+// so that they are stored in the Runtime configuration
+func (r *service) setDefaultHibernationSchedules(config *model.GardenerConfig) apperrors.AppError {
+	if config.HibernationSchedules != nil {
+		return nil
+	}
+
+	schedules, err := r.provisioner.DefaultHibernationSchedules(util.UnwrapStr(config.Purpose))
+	if err != nil {
+		return err.Append("Failed to get default hibernation schedules")
+	}
+
+	config.HibernationSchedules = schedules
+	return nil
+}
+
+func (r *service) setProvisioningStarted(ctx context.Context, dbSession dbsession.WriteSession, runtimeID string, cluster model.Cluster) (model.Operation, dberrors.Error) {
 	timestamp := time.Now()
 	cluster.CreationTimestamp = timestamp
 
@@ -321,7 +700,7 @@ func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runti
 
 	provisioningMode := model.Provision
 
-	operation, err := r.setOperationStarted(dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, timestamp, "Provisioning started")
+	operation, err := r.setOperationStarted(ctx, dbSession, runtimeID, provisioningMode, model.WaitingForClusterDomain, timestamp, "Provisioning started")
 	if err != nil {
 		return model.Operation{}, err.Append("Failed to set provisioning started: %s")
 	}
@@ -329,7 +708,7 @@ func (r *service) setProvisioningStarted(dbSession dbsession.WriteSession, runti
 	return operation, nil
 }
 
-func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSession, currentCluster model.Cluster, gardenerConfig model.GardenerConfig, administrators []string) (model.Operation, error) {
+func (r *service) setGardenerShootUpgradeStarted(ctx context.Context, txSession dbsession.WriteSession, currentCluster model.Cluster, gardenerConfig model.GardenerConfig, administrators []string) (model.Operation, error) {
 	log.Infof("Starting Upgrade of Gardener Shoot operation")
 
 	dberr := txSession.UpdateGardenerClusterConfig(gardenerConfig)
@@ -342,16 +721,28 @@ func (r *service) setGardenerShootUpgradeStarted(txSession dbsession.WriteSessio
 		return model.Operation{}, dberrors.Internal("Failed to set Shoot Upgrade started: %s", dberr.Error())
 	}
 
-	operation, dbError := r.setOperationStarted(txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, time.Now(), "Starting Gardener Shoot upgrade")
+	operation, dbError := r.setOperationStarted(ctx, txSession, currentCluster.ID, model.UpgradeShoot, model.WaitingForShootNewVersion, time.Now(), "Starting Gardener Shoot upgrade")
 
 	if dbError != nil {
 		return model.Operation{}, dbError.Append("Failed to start operation of Gardener Shoot upgrade %s", dbError.Error())
 	}
 
+	shootUpgrade := model.ShootUpgrade{
+		Id:                       r.uuidGenerator.New(),
+		OperationId:              operation.ID,
+		PreUpgradeGardenerConfig: currentCluster.ClusterConfig,
+	}
+
+	dberr = txSession.InsertShootUpgrade(shootUpgrade)
+	if dberr != nil {
+		return model.Operation{}, dberrors.Internal("Failed to set Shoot Upgrade started: %s", dberr.Error())
+	}
+
 	return operation, nil
 }
 
 func (r *service) setOperationStarted(
+	ctx context.Context,
 	dbSession dbsession.WriteSession,
 	runtimeID string,
 	operationType model.OperationType,
@@ -369,6 +760,7 @@ func (r *service) setOperationStarted(
 		ClusterID:      runtimeID,
 		Stage:          operationStage,
 		LastTransition: &timestamp,
+		TraceContext:   tracing.Inject(ctx),
 	}
 
 	err := dbSession.InsertOperation(operation)
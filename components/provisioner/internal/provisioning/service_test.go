@@ -1,6 +1,7 @@
 package provisioning
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	mocks2 "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/mocks"
 	sessionMocks "github.com/kyma-project/control-plane/components/provisioner/internal/provisioning/persistence/dbsession/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/util/testkit"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/uuid"
 	uuidMocks "github.com/kyma-project/control-plane/components/provisioner/internal/uuid/mocks"
 	"github.com/kyma-project/control-plane/components/provisioner/pkg/gqlschema"
@@ -100,6 +102,10 @@ func TestService_ProvisionRuntime(t *testing.T) {
 	clusterMatcher := getClusterMatcher(expectedCluster)
 	operationMatcher := getOperationMatcher(expectedOperation)
 
+	defaultSchedules := []model.HibernationSchedule{
+		{Start: util.StringPtr("00 20 * * 1,2,3,4,5"), End: util.StringPtr("00 07 * * 1,2,3,4,5"), Location: util.StringPtr("Europe/Berlin")},
+	}
+
 	t.Run("Should start runtime provisioning of Gardener cluster and return operation ID ", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -109,21 +115,24 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue := &mocks.OperationQueue{}
 
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return(runtimeID, nil)
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Return(runtimeID, nil)
 		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
-		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
+		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.MatchedBy(func(config model.GardenerConfig) bool {
+			return assert.ObjectsAreEqual(defaultSchedules, config.HibernationSchedules)
+		})).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioner.On("DefaultHibernationSchedules", "").Return(defaultSchedules, nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, provisioningQueue, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
 
 		// when
-		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
+		operationStatus, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInputNoKymaConfig, tenant, subAccountId)
 		require.NoError(t, err)
 
 		// then
@@ -144,20 +153,21 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		provisioner := &mocks2.Provisioner{}
 
 		expectErr := dberrors.Internal("Failed to commit transaction: error")
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return(runtimeID, nil)
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Return(runtimeID, nil)
 		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(expectErr)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
-		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
+		provisioner.On("DefaultHibernationSchedules", "").Return(nil, nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		directorServiceMock.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.Error(t, err)
 
 		//then
@@ -176,19 +186,20 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		directorServiceMock := &directormock.DirectorClient{}
 		provisioner := &mocks2.Provisioner{}
 
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return(runtimeID, nil)
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Return(runtimeID, nil)
 		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
-		directorServiceMock.On("DeleteRuntime", runtimeID, tenant).Return(nil)
+		provisioner.On("DefaultHibernationSchedules", "").Return(nil, nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(apperrors.Internal("error"))
+		directorServiceMock.On("DeleteRuntime", mock.Anything, runtimeID, tenant).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -204,12 +215,12 @@ func TestService_ProvisionRuntime(t *testing.T) {
 		// given
 		directorServiceMock := &directormock.DirectorClient{}
 
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Return("", apperrors.Internal("registering error"))
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Return("", apperrors.Internal("registering error"))
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, nil, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		_, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -228,22 +239,23 @@ func TestService_ProvisionRuntime(t *testing.T) {
 
 		provisioningQueue := &mocks.OperationQueue{}
 
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Once().Return("", apperrors.Internal("registering error"))
-		directorServiceMock.On("CreateRuntime", mock.Anything, tenant).Once().Return(runtimeID, nil)
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Once().Return("", apperrors.Internal("registering error"))
+		directorServiceMock.On("CreateRuntime", mock.Anything, mock.Anything, tenant).Once().Return(runtimeID, nil)
 		sessionFactoryMock.On("NewSessionWithinTransaction").Return(writeSessionWithinTransactionMock, nil)
 		writeSessionWithinTransactionMock.On("InsertCluster", mock.MatchedBy(clusterMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertGardenerConfig", mock.AnythingOfType("model.GardenerConfig")).Return(nil)
 		writeSessionWithinTransactionMock.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 		writeSessionWithinTransactionMock.On("Commit").Return(nil)
 		writeSessionWithinTransactionMock.On("RollbackUnlessCommitted").Return()
-		provisioner.On("ProvisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
+		provisioner.On("DefaultHibernationSchedules", "").Return(nil, nil)
+		provisioner.On("ProvisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(nil)
 
 		provisioningQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 
-		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, provisioningQueue, nil, nil)
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
 
 		// when
-		operationStatus, err := service.ProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		operationStatus, err := service.ProvisionRuntime(context.Background(), provisionRuntimeInput, tenant, subAccountId)
 		require.NoError(t, err)
 
 		// then
@@ -296,13 +308,13 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil)
 
 		// when
-		opID, err := resolver.DeprovisionRuntime(runtimeID)
+		opID, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.NoError(t, err)
 
 		// then
@@ -342,13 +354,13 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(operation, nil)
 		readWriteSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, deprovisioningQueue, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, deprovisioningQueue, nil, nil, nil)
 
 		// when
-		opID, err := resolver.DeprovisionRuntime(runtimeID)
+		opID, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.NoError(t, err)
 
 		// then
@@ -368,12 +380,12 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(cluster, nil)
-		provisioner.On("DeprovisionCluster", mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
+		provisioner.On("DeprovisionCluster", mock.Anything, mock.MatchedBy(clusterMatcher), mock.MatchedBy(notEmptyUUIDMatcher)).Return(model.Operation{}, apperrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.Error(t, err)
 		util.CheckErrorType(t, err, apperrors.CodeInternal)
 
@@ -395,10 +407,10 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		readWriteSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
 		readWriteSession.On("GetCluster", runtimeID).Return(model.Cluster{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.Error(t, err)
 
 		// then
@@ -419,10 +431,10 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.Error(t, err)
 
 		// then
@@ -442,10 +454,10 @@ func TestService_DeprovisionRuntime(t *testing.T) {
 		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
 		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{}, dberrors.Internal("some error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuid.NewUUIDGenerator(), nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
-		_, err := resolver.DeprovisionRuntime(runtimeID)
+		_, err := resolver.DeprovisionRuntime(context.Background(), runtimeID)
 		require.Error(t, err)
 
 		// then
@@ -470,6 +482,26 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		ClusterID: runtimeID,
 	}
 
+	stageHistory := []model.OperationStageHistoryEntry{
+		{
+			ID:             "entry-1",
+			OperationID:    operationID,
+			Stage:          model.WaitingForClusterCreation,
+			Message:        "Operation in progress. Stage WaitingForClusterCreation",
+			EventTimestamp: time.Now(),
+			LastError:      model.LastError{ErrMessage: "shoot not ready"},
+			Retries:        3,
+		},
+		{
+			ID:             "entry-2",
+			OperationID:    operationID,
+			Stage:          model.WaitingForClusterCreation,
+			Message:        "Stage failed",
+			EventTimestamp: time.Now(),
+			LastError:      model.LastError{ErrMessage: "rate limits exceeded"},
+		},
+	}
+
 	t.Run("Should return operation status", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -477,13 +509,22 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(operation, nil)
+		readSession.On("GetOperationStageHistory", operationID).Return(stageHistory, nil)
+		readSession.On("GetOperationWarnings", operationID).Return([]model.UpgradeWarning{{Code: model.RemovedAPICheckSkipped, Message: "check skipped"}}, nil)
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeOperationStatus(operationID)
 		// then
 		require.NoError(t, err)
+		assert.Equal(t, []*gqlschema.UpgradeWarning{{Code: gqlschema.UpgradeWarningCodeRemovedAPICheckSkipped, Message: "check skipped"}}, status.Warnings)
+		require.Len(t, status.StageHistory, 2)
+		assert.Equal(t, string(model.WaitingForClusterCreation), status.StageHistory[0].Stage)
+		assert.Equal(t, "shoot not ready", status.StageHistory[0].LastError.ErrMessage)
+		assert.Equal(t, 3, status.StageHistory[0].Retries)
+		assert.Equal(t, 0, status.StageHistory[1].Retries)
+		assert.Equal(t, "rate limits exceeded", status.StageHistory[1].LastError.ErrMessage)
 		assert.Equal(t, gqlschema.OperationTypeProvision, status.Operation)
 		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
 		assert.Equal(t, operation.ClusterID, *status.RuntimeID)
@@ -501,7 +542,7 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeOperationStatus(operationID)
@@ -513,6 +554,193 @@ func TestService_RuntimeOperationStatus(t *testing.T) {
 	})
 }
 
+func TestService_CancelOperation(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	operation := model.Operation{
+		ID:        operationID,
+		Type:      model.UpgradeShoot,
+		State:     model.InProgress,
+		Message:   "Message",
+		ClusterID: runtimeID,
+	}
+
+	t.Run("Should request operation cancellation", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil)
+		readWriteSession.On("RequestOperationCancellation", operationID).Return(nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := resolver.CancelOperation(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationTypeUpgradeShoot, status.Operation)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		assert.Equal(t, operation.ID, *status.ID)
+		sessionFactoryMock.AssertExpectations(t)
+		readWriteSession.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when operation is not in progress", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		finishedOperation := operation
+		finishedOperation.State = model.Succeeded
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(finishedOperation, nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.CancelOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		readWriteSession.AssertNotCalled(t, "RequestOperationCancellation", operationID)
+	})
+
+	t.Run("Should return error when failed to request cancellation", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil)
+		readWriteSession.On("RequestOperationCancellation", operationID).Return(dberrors.Internal("error"))
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.CancelOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		sessionFactoryMock.AssertExpectations(t)
+		readWriteSession.AssertExpectations(t)
+	})
+}
+
+func TestService_RetryOperation(t *testing.T) {
+	uuidGenerator := &uuidMocks.UUIDGenerator{}
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	operation := model.Operation{
+		ID:        operationID,
+		Type:      model.Provision,
+		State:     model.Failed,
+		Message:   "Message",
+		ClusterID: runtimeID,
+		Stage:     model.WaitingForClusterCreation,
+		LastError: model.LastError{ErrMessage: "rate limits exceeded"},
+	}
+
+	t.Run("Should move operation to In Progress state and enqueue it", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil)
+		readWriteSession.On("GetLastOperation", runtimeID).Return(operation, nil)
+		readWriteSession.On("RetryOperation", operationID, "Operation retried. Stage WaitingForClusterCreation", mock.AnythingOfType("time.Time")).Return(nil)
+		provisioningQueue.On("Add", operationID).Return(nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
+
+		// when
+		status, err := resolver.RetryOperation(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		assert.Empty(t, status.LastError.ErrMessage)
+		readWriteSession.AssertExpectations(t)
+		provisioningQueue.AssertExpectations(t)
+	})
+
+	t.Run("Should return error when operation is not failed", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+
+		succeededOperation := operation
+		succeededOperation.State = model.Succeeded
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(succeededOperation, nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.RetryOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+	})
+
+	t.Run("Should return error when resources of operation were reverted", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		revertedOperation := operation
+		revertedOperation.ResourcesReverted = true
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(revertedOperation, nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.RetryOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		readWriteSession.AssertNotCalled(t, "RetryOperation", mock.Anything, mock.Anything, mock.Anything)
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("Should return error when operation is not the last one", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readWriteSession := &sessionMocks.ReadWriteSession{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		sessionFactoryMock.On("NewReadWriteSession").Return(readWriteSession)
+		readWriteSession.On("GetOperation", operationID).Return(operation, nil)
+		readWriteSession.On("GetLastOperation", runtimeID).Return(model.Operation{ID: "other-operation", State: model.InProgress}, nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
+
+		// when
+		_, err := resolver.RetryOperation(operationID)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		readWriteSession.AssertNotCalled(t, "RetryOperation", mock.Anything, mock.Anything, mock.Anything)
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
+
 func TestService_RuntimeStatus(t *testing.T) {
 	uuidGenerator := &uuidMocks.UUIDGenerator{}
 	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
@@ -542,7 +770,10 @@ func TestService_RuntimeStatus(t *testing.T) {
 
 		provisioner := &mocks2.Provisioner{}
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, nil)
+		shootProvider := &mocks2.ShootProvider{}
+		shootProvider.On("Get", operationID, cluster.Tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, true).ToShoot(), nil)
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		status, err := resolver.RuntimeStatus(operationID)
@@ -551,10 +782,35 @@ func TestService_RuntimeStatus(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, cluster.ID, *status.LastOperationStatus.RuntimeID)
 		assert.Equal(t, cluster.Kubeconfig, status.RuntimeConfiguration.Kubeconfig)
+		assert.True(t, *status.HibernationStatus.Hibernated)
+		assert.True(t, *status.HibernationStatus.HibernationPossible)
 		sessionFactoryMock.AssertExpectations(t)
 		readSession.AssertExpectations(t)
 	})
 
+	t.Run("Should return runtime status without hibernation status when failed to get Shoot", func(t *testing.T) {
+		// given
+		sessionFactoryMock := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+
+		sessionFactoryMock.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", operationID).Return(operation, nil)
+		readSession.On("GetCluster", operationID).Return(cluster, nil)
+
+		shootProvider := &mocks2.ShootProvider{}
+		shootProvider.On("Get", operationID, cluster.Tenant).Return(gardener_Types.Shoot{}, apperrors.Internal("error"))
+
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, nil)
+
+		// when
+		status, err := resolver.RuntimeStatus(operationID)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, status.HibernationStatus)
+		shootProvider.AssertExpectations(t)
+	})
+
 	t.Run("Should return error when failed to get cluster", func(t *testing.T) {
 		// given
 		sessionFactoryMock := &sessionMocks.Factory{}
@@ -564,7 +820,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		readSession.On("GetLastOperation", operationID).Return(operation, nil)
 		readSession.On("GetCluster", operationID).Return(model.Cluster{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -583,7 +839,7 @@ func TestService_RuntimeStatus(t *testing.T) {
 		sessionFactoryMock.On("NewReadSession").Return(readSession)
 		readSession.On("GetLastOperation", operationID).Return(model.Operation{}, dberrors.Internal("error"))
 
-		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil)
+		resolver := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactoryMock, nil, uuidGenerator, nil, nil, nil, nil, nil, nil, nil, nil)
 
 		// when
 		_, err := resolver.RuntimeStatus(operationID)
@@ -636,6 +892,12 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 
 	operationMatcher := getOperationMatcher(operation)
 
+	shootUpgradeMatcher := func(shootUpgrade model.ShootUpgrade) bool {
+		return shootUpgrade.OperationId != "" && shootUpgrade.PreUpgradeGardenerConfig.ClusterID == runtimeID
+	}
+
+	upgradeWarnings := []model.UpgradeWarning{{Code: model.RemovedAPIInUse, Message: "removed API in use"}}
+
 	for _, testCase := range []struct {
 		description string
 		mockFunc    func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider, upgradeShootQueue *mocks.OperationQueue)
@@ -656,7 +918,8 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
-				provisioner.On("UpgradeCluster", runtimeID, newUpgradedConfig).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.MatchedBy(shootUpgradeMatcher)).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, newUpgradedConfig, mock.AnythingOfType("string")).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.20"), nil)
@@ -674,7 +937,8 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.MatchedBy(shootUpgradeMatcher)).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig, mock.AnythingOfType("string")).Return(nil)
 				writeSession.On("Commit").Return(nil)
 				upgradeShootQueue.On("Add", mock.AnythingOfType("string")).Return(nil)
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
@@ -691,18 +955,22 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 			upgradeShootQueue := &mocks.OperationQueue{}
 
 			shootProvider := &mocks2.ShootProvider{}
+			upgradeChecker := &mocks2.UpgradeChecker{}
+			upgradeChecker.On("CheckUpgrade", mock.Anything, mock.Anything, cluster.Kubeconfig).Return(upgradeWarnings, nil)
+			writeSessionWithinTransaction.On("UpdateOperationWarnings", mock.AnythingOfType("string"), upgradeWarnings).Return(nil)
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider, upgradeShootQueue)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, upgradeChecker, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
-			operationStatus, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			operationStatus, err := service.UpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
 			require.NoError(t, err)
 
 			// then
 			assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
 			assert.NotEmpty(t, operationStatus.ID)
+			assert.Equal(t, []*gqlschema.UpgradeWarning{{Code: gqlschema.UpgradeWarningCodeRemovedAPIInUse, Message: "removed API in use"}}, operationStatus.Warnings)
 			sessionFactory.AssertExpectations(t)
 			readSession.AssertExpectations(t)
 			writeSessionWithinTransaction.AssertExpectations(t)
@@ -725,8 +993,9 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.MatchedBy(shootUpgradeMatcher)).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(nil)
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig, mock.AnythingOfType("string")).Return(nil)
 				writeSession.On("Commit").Return(dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
@@ -742,8 +1011,24 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
 				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
 				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.MatchedBy(shootUpgradeMatcher)).Return(nil)
 				provisioner.On("setOperationStarted", writeSession, runtimeID, model.UpgradeShoot, model.WaitingForShootNewVersion, nil, nil).Return(mock.MatchedBy(operationMatcher), nil)
-				provisioner.On("UpgradeCluster", runtimeID, upgradedConfig).Return(apperrors.Internal("error"))
+				provisioner.On("UpgradeCluster", mock.Anything, runtimeID, upgradedConfig, mock.AnythingOfType("string")).Return(apperrors.Internal("error"))
+				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+			},
+		},
+		{
+			description: "should fail to upgrade Shoot when failed to record Shoot upgrade",
+			mockFunc: func(sessionFactory *sessionMocks.Factory, readSession *sessionMocks.ReadSession, writeSession *sessionMocks.WriteSessionWithinTransaction, provisioner *mocks2.Provisioner, shootProvider *mocks2.ShootProvider) {
+				sessionFactory.On("NewReadSession").Return(readSession)
+				readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+				readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+				sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+				writeSession.On("RollbackUnlessCommitted").Return()
+				writeSession.On("UpdateGardenerClusterConfig", upgradedConfig).Return(nil)
+				writeSession.On("InsertOperation", mock.MatchedBy(operationMatcher)).Return(nil)
+				writeSession.On("InsertAdministrators", runtimeID, mock.Anything).Return(nil)
+				writeSession.On("InsertShootUpgrade", mock.MatchedBy(shootUpgradeMatcher)).Return(dberrors.Internal("error"))
 				shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
 			},
 		},
@@ -811,13 +1096,15 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 			upgradeShootQueue := &mocks.OperationQueue{}
 
 			shootProvider := &mocks2.ShootProvider{}
+			upgradeChecker := &mocks2.UpgradeChecker{}
+			upgradeChecker.On("CheckUpgrade", mock.Anything, mock.Anything, cluster.Kubeconfig).Return(nil, nil)
 
 			testCase.mockFunc(sessionFactory, readSession, writeSessionWithinTransaction, provisioner, shootProvider)
 
-			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, upgradeShootQueue)
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, upgradeChecker, nil, nil, nil, upgradeShootQueue, nil, nil)
 
 			// when
-			_, err := service.UpgradeGardenerShoot(runtimeID, upgradeShootInput)
+			_, err := service.UpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
 			require.Error(t, err)
 
 			// then
@@ -826,6 +1113,34 @@ func TestService_UpgradeGardenerShoot(t *testing.T) {
 			readSession.AssertExpectations(t)
 		})
 	}
+
+	t.Run("should fail to upgrade Shoot when pre-flight checks fail", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		shootProvider := &mocks2.ShootProvider{}
+		upgradeChecker := &mocks2.UpgradeChecker{}
+		upgradeShootQueue := &mocks.OperationQueue{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(providedShoot("1.19"), nil)
+		upgradeChecker.On("CheckUpgrade", providedShoot("1.19"), upgradedConfig, cluster.Kubeconfig).
+			Return(nil, apperrors.BadRequest("upgrade of Kubernetes from 1.19 to 1.21 skips a minor version"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, upgradeChecker, nil, nil, nil, upgradeShootQueue, nil, nil)
+
+		// when
+		_, err := service.UpgradeGardenerShoot(context.Background(), runtimeID, upgradeShootInput)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+		assert.Contains(t, err.Error(), "skips a minor version")
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		upgradeShootQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
 }
 
 func getOperationMatcher(expected model.Operation) func(model.Operation) bool {
@@ -844,3 +1159,299 @@ func getClusterMatcher(expected model.Cluster) func(model.Cluster) bool {
 func notEmptyUUIDMatcher(id string) bool {
 	return len(id) > 0
 }
+
+func TestService_DryRun(t *testing.T) {
+	inputConverter := NewInputConverter(uuid.NewUUIDGenerator(), gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+	uuidGenerator := uuid.NewUUIDGenerator()
+
+	providerConfig, _ := model.NewGCPGardenerConfig(&gqlschema.GCPProviderConfigInput{Zones: []string{"europe-west1-a"}})
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			ClusterID:              runtimeID,
+			Purpose:                util.StringPtr("evaluation"),
+			GardenerProviderConfig: providerConfig,
+		},
+	}
+
+	t.Run("Should dry run provisioning without registering Runtime or storing it", func(t *testing.T) {
+		// given
+		provisionRuntimeInput := gqlschema.ProvisionRuntimeInput{
+			RuntimeInput: &gqlschema.RuntimeInput{Name: runtimeName},
+			ClusterConfig: &gqlschema.ClusterConfigInput{
+				GardenerConfig: &gqlschema.GardenerConfigInput{
+					Name:              "shoot",
+					KubernetesVersion: "1.16",
+					ProviderSpecificConfig: &gqlschema.ProviderSpecificInput{
+						GcpConfig: &gqlschema.GCPProviderConfigInput{},
+					},
+				},
+			},
+		}
+
+		sessionFactoryMock := &sessionMocks.Factory{}
+		directorServiceMock := &directormock.DirectorClient{}
+		provisioner := &mocks2.Provisioner{}
+		provisioningQueue := &mocks.OperationQueue{}
+
+		shoot := testkit.NewTestShoot("shoot").ToShoot()
+		provisioner.On("DefaultHibernationSchedules", "").Return(nil, nil)
+		provisioner.On("DryRunProvisionCluster", mock.AnythingOfType("model.Cluster")).Return(shoot, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, directorServiceMock, sessionFactoryMock, provisioner, uuidGenerator, nil, nil, nil, provisioningQueue, nil, nil, nil, nil)
+
+		// when
+		operationStatus, err := service.DryRunProvisionRuntime(provisionRuntimeInput, tenant, subAccountId)
+		require.NoError(t, err)
+
+		// then
+		assert.Nil(t, operationStatus.ID)
+		assert.Equal(t, gqlschema.OperationTypeProvision, operationStatus.Operation)
+		assert.Equal(t, gqlschema.OperationStateSucceeded, operationStatus.State)
+		require.NotNil(t, operationStatus.DryRunResult)
+		assert.Contains(t, operationStatus.DryRunResult.Shoot, "name: shoot")
+		assert.Nil(t, operationStatus.DryRunResult.Diff)
+		provisioner.AssertExpectations(t)
+		directorServiceMock.AssertNotCalled(t, "CreateRuntime", mock.Anything, mock.Anything)
+		sessionFactoryMock.AssertNotCalled(t, "NewSessionWithinTransaction")
+		provisioningQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+
+	t.Run("Should dry run Shoot upgrade and return diff", func(t *testing.T) {
+		// given
+		upgradeShootInput := newUpgradeShootInputAwsAzureGCP("testing")
+		upgradedConfig, err := inputConverter.UpgradeShootInputToGardenerConfig(*upgradeShootInput.GardenerConfig, cluster.ClusterConfig)
+		require.NoError(t, err)
+
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		upgradeChecker := &mocks2.UpgradeChecker{}
+		upgradeShootQueue := &mocks.OperationQueue{}
+
+		currentShoot := testkit.NewTestShoot("shoot").WithKubernetesVersion("1.19").ToShoot()
+		upgradedShoot := testkit.NewTestShoot("shoot").WithKubernetesVersion("1.20.7").ToShoot()
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		readSession.On("GetLastOperation", runtimeID).Return(model.Operation{State: model.Succeeded}, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*currentShoot, nil)
+		provisioner.On("DryRunUpgradeCluster", runtimeID, upgradedConfig).Return(currentShoot, upgradedShoot, nil)
+		upgradeChecker.On("CheckUpgrade", *currentShoot, upgradedConfig, cluster.Kubeconfig).
+			Return([]model.UpgradeWarning{{Code: model.KubernetesVersionDeprecated, Message: "Kubernetes version 1.20.7 is deprecated"}}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, upgradeChecker, nil, nil, nil, upgradeShootQueue, nil, nil)
+
+		// when
+		operationStatus, err := service.DryRunUpgradeGardenerShoot(runtimeID, upgradeShootInput)
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, runtimeID, *operationStatus.RuntimeID)
+		assert.Equal(t, gqlschema.OperationTypeUpgradeShoot, operationStatus.Operation)
+		require.NotNil(t, operationStatus.DryRunResult)
+		require.NotNil(t, operationStatus.DryRunResult.Diff)
+		assert.Contains(t, *operationStatus.DryRunResult.Diff, "-    version: \"1.19\"")
+		assert.Contains(t, *operationStatus.DryRunResult.Diff, "+    version: 1.20.7")
+		assert.Equal(t, []*gqlschema.UpgradeWarning{{Code: gqlschema.UpgradeWarningCodeKubernetesVersionDeprecated, Message: "Kubernetes version 1.20.7 is deprecated"}}, operationStatus.Warnings)
+		provisioner.AssertExpectations(t)
+		sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		upgradeShootQueue.AssertNotCalled(t, "Add", mock.Anything)
+	})
+}
+
+func TestService_HibernateCluster(t *testing.T) {
+	uuidGenerator := uuid.NewUUIDGenerator()
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+
+	lastOperation := model.Operation{State: model.Succeeded}
+	cluster := model.Cluster{
+		ID:     runtimeID,
+		Tenant: tenant,
+		ClusterConfig: model.GardenerConfig{
+			Name: "shoot",
+		},
+	}
+
+	t.Run("Should hibernate Runtime and enqueue hibernation operation", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		hibernationQueue := &mocks.OperationQueue{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationState(true, false).ToShoot(), nil)
+		writeSession.On("InsertOperation", mock.MatchedBy(func(operation model.Operation) bool {
+			return operation.Type == model.Hibernate && operation.Stage == model.WaitForHibernation && operation.State == model.InProgress
+		})).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		provisioner.On("HibernateCluster", mock.Anything, runtimeID, cluster.ClusterConfig, mock.AnythingOfType("string")).Return(nil)
+		hibernationQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, hibernationQueue, nil)
+
+		// when
+		status, err := service.HibernateCluster(context.Background(), runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationTypeHibernate, status.Operation)
+		assert.Equal(t, gqlschema.OperationStateInProgress, status.State)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		hibernationQueue.AssertExpectations(t)
+	})
+
+	t.Run("Should wake up Runtime and enqueue wake-up operation", func(t *testing.T) {
+		// given
+		sessionFactory := &sessionMocks.Factory{}
+		readSession := &sessionMocks.ReadSession{}
+		writeSession := &sessionMocks.WriteSessionWithinTransaction{}
+		provisioner := &mocks2.Provisioner{}
+		shootProvider := &mocks2.ShootProvider{}
+		wakeUpQueue := &mocks.OperationQueue{}
+
+		sessionFactory.On("NewReadSession").Return(readSession)
+		sessionFactory.On("NewSessionWithinTransaction").Return(writeSession, nil)
+		readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+		readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+		shootProvider.On("Get", runtimeID, tenant).Return(*testkit.NewTestShoot("shoot").WithHibernationEnabled(true).WithHibernationState(true, true).ToShoot(), nil)
+		writeSession.On("InsertOperation", mock.MatchedBy(func(operation model.Operation) bool {
+			return operation.Type == model.WakeUp && operation.Stage == model.WaitForWakeUp && operation.State == model.InProgress
+		})).Return(nil)
+		writeSession.On("RollbackUnlessCommitted").Return()
+		writeSession.On("Commit").Return(nil)
+		provisioner.On("WakeUpCluster", mock.Anything, runtimeID, cluster.ClusterConfig, mock.AnythingOfType("string")).Return(nil)
+		wakeUpQueue.On("Add", mock.AnythingOfType("string")).Return()
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, provisioner, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, wakeUpQueue)
+
+		// when
+		status, err := service.WakeUpCluster(context.Background(), runtimeID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, gqlschema.OperationTypeWakeUp, status.Operation)
+		writeSession.AssertExpectations(t)
+		provisioner.AssertExpectations(t)
+		wakeUpQueue.AssertExpectations(t)
+	})
+
+	for _, testCase := range []struct {
+		description string
+		shoot       *gardener_Types.Shoot
+		hibernate   bool
+	}{
+		{
+			description: "Should not hibernate already hibernated Runtime",
+			shoot:       testkit.NewTestShoot("shoot").WithHibernationEnabled(true).WithHibernationState(true, true).ToShoot(),
+			hibernate:   true,
+		},
+		{
+			description: "Should not hibernate Runtime when hibernation is not possible",
+			shoot:       testkit.NewTestShoot("shoot").WithHibernationState(false, false).ToShoot(),
+			hibernate:   true,
+		},
+		{
+			description: "Should not wake up Runtime which is not hibernated",
+			shoot:       testkit.NewTestShoot("shoot").WithHibernationState(true, false).ToShoot(),
+			hibernate:   false,
+		},
+	} {
+		t.Run(testCase.description, func(t *testing.T) {
+			// given
+			sessionFactory := &sessionMocks.Factory{}
+			readSession := &sessionMocks.ReadSession{}
+			shootProvider := &mocks2.ShootProvider{}
+
+			sessionFactory.On("NewReadSession").Return(readSession)
+			readSession.On("GetLastOperation", runtimeID).Return(lastOperation, nil)
+			readSession.On("GetCluster", runtimeID).Return(cluster, nil)
+			shootProvider.On("Get", runtimeID, tenant).Return(*testCase.shoot, nil)
+
+			service := NewProvisioningService(inputConverter, graphQLConverter, nil, sessionFactory, nil, uuidGenerator, shootProvider, nil, nil, nil, nil, nil, nil, nil)
+
+			// when
+			var err apperrors.AppError
+			if testCase.hibernate {
+				_, err = service.HibernateCluster(context.Background(), runtimeID)
+			} else {
+				_, err = service.WakeUpCluster(context.Background(), runtimeID)
+			}
+
+			// then
+			require.Error(t, err)
+			assert.Equal(t, apperrors.CodeBadRequest, err.Code())
+			sessionFactory.AssertNotCalled(t, "NewSessionWithinTransaction")
+		})
+	}
+}
+
+func TestService_VersionDrift(t *testing.T) {
+	uuidGenerator := uuid.NewUUIDGenerator()
+	inputConverter := NewInputConverter(uuidGenerator, gardenerProject, defaultEnableKubernetesVersionAutoUpdate, defaultEnableMachineImageVersionAutoUpdate)
+	graphQLConverter := NewGraphQLConverter()
+	expirationDate := time.Date(2026, 11, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("Should return version drift converted to GraphQL", func(t *testing.T) {
+		// given
+		driftReporter := &mocks2.VersionDriftReporter{}
+		driftReporter.On("Report", "aws", "eu-west-1", time.Hour).Return([]model.VersionDriftGroup{
+			{
+				Provider: "aws",
+				Region:   "eu-west-1",
+				Runtimes: []model.RuntimeVersionDrift{{
+					RuntimeID:         runtimeID,
+					ShootName:         "shoot",
+					KubernetesVersion: &model.ExpiringVersion{Version: "1.25.10", ExpirationDate: &expirationDate},
+					MachineImages:     []model.ExpiringVersion{{Name: "gardenlinux", Version: "318.9.0", Expired: true}},
+				}},
+			},
+		}, nil)
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, driftReporter, nil, nil, nil, nil, nil)
+
+		// when
+		groups, err := service.VersionDrift("aws", "eu-west-1", time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []*gqlschema.VersionDriftGroup{
+			{
+				Provider: "aws",
+				Region:   "eu-west-1",
+				Runtimes: []*gqlschema.RuntimeVersionDrift{{
+					RuntimeID:         runtimeID,
+					ShootName:         "shoot",
+					KubernetesVersion: &gqlschema.ExpiringVersion{Version: "1.25.10", ExpirationDate: &expirationDate},
+					MachineImages:     []*gqlschema.ExpiringVersion{{Name: util.StringPtr("gardenlinux"), Version: "318.9.0", Expired: true}},
+				}},
+			},
+		}, groups)
+	})
+
+	t.Run("Should return error when failed to report version drift", func(t *testing.T) {
+		// given
+		driftReporter := &mocks2.VersionDriftReporter{}
+		driftReporter.On("Report", "", "", time.Hour).Return(nil, apperrors.Internal("error"))
+
+		service := NewProvisioningService(inputConverter, graphQLConverter, nil, nil, nil, uuidGenerator, nil, nil, driftReporter, nil, nil, nil, nil, nil)
+
+		// when
+		_, err := service.VersionDrift("", "", time.Hour)
+
+		// then
+		require.Error(t, err)
+	})
+}
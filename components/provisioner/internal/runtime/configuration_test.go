@@ -19,6 +19,7 @@ import (
 	"github.com/kyma-project/control-plane/components/provisioner/internal/model"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/fake"
@@ -52,12 +53,12 @@ func TestProvider_CreateConfigMapForRuntime(t *testing.T) {
 		k8sClientProvider := newMockClientProvider(t)
 		directorClient := &mocks2.DirectorClient{}
 
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Return(oneTimeToken, nil)
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Return(oneTimeToken, nil)
 
 		configProvider := NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
 		//when
-		err := configProvider.ConfigureRuntime(cluster, kubeconfig)
+		err := configProvider.ConfigureRuntime(context.Background(), cluster, kubeconfig)
 
 		//then
 		require.NoError(t, err)
@@ -91,12 +92,12 @@ func TestProvider_CreateConfigMapForRuntime(t *testing.T) {
 		require.NoError(t, k8serr)
 
 		directorClient := &mocks2.DirectorClient{}
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Return(oneTimeToken, nil)
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Return(oneTimeToken, nil)
 
 		configProvider := NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
 		//when
-		err := configProvider.ConfigureRuntime(cluster, kubeconfig)
+		err := configProvider.ConfigureRuntime(context.Background(), cluster, kubeconfig)
 
 		//then
 		require.NoError(t, err)
@@ -118,13 +119,13 @@ func TestProvider_CreateConfigMapForRuntime(t *testing.T) {
 		k8sClientProvider := newMockClientProvider(t)
 		directorClient := &mocks2.DirectorClient{}
 
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Once().Return(graphql.OneTimeTokenForRuntimeExt{}, apperrors.Internal("token error"))
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Once().Return(oneTimeToken, nil)
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Once().Return(graphql.OneTimeTokenForRuntimeExt{}, apperrors.Internal("token error"))
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Once().Return(oneTimeToken, nil)
 
 		configProvider := NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
 		//when
-		err := configProvider.ConfigureRuntime(cluster, kubeconfig)
+		err := configProvider.ConfigureRuntime(context.Background(), cluster, kubeconfig)
 
 		//then
 		require.NoError(t, err)
@@ -147,12 +148,12 @@ func TestProvider_CreateConfigMapForRuntime(t *testing.T) {
 		k8sClientProvider := newErrorClientProvider(t, apperrors.Internal("error"))
 		directorClient := &mocks2.DirectorClient{}
 
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Return(oneTimeToken, nil)
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Return(oneTimeToken, nil)
 
 		configProvider := NewRuntimeConfigurator(k8sClientProvider, directorClient)
 
 		//when
-		err := configProvider.ConfigureRuntime(cluster, kubeconfig)
+		err := configProvider.ConfigureRuntime(context.Background(), cluster, kubeconfig)
 
 		//then
 		require.Error(t, err)
@@ -163,12 +164,12 @@ func TestProvider_CreateConfigMapForRuntime(t *testing.T) {
 		//given
 		directorClient := &mocks2.DirectorClient{}
 
-		directorClient.On("GetConnectionToken", runtimeID, tenant).Return(graphql.OneTimeTokenForRuntimeExt{}, apperrors.Internal("error"))
+		directorClient.On("GetConnectionToken", mock.Anything, runtimeID, tenant).Return(graphql.OneTimeTokenForRuntimeExt{}, apperrors.Internal("error"))
 
 		configProvider := NewRuntimeConfigurator(nil, directorClient)
 
 		//when
-		err := configProvider.ConfigureRuntime(cluster, kubeconfig)
+		err := configProvider.ConfigureRuntime(context.Background(), cluster, kubeconfig)
 
 		//then
 		require.Error(t, err)
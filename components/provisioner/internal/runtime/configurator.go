@@ -29,7 +29,7 @@ const (
 
 //go:generate mockery --name=Configurator
 type Configurator interface {
-	ConfigureRuntime(cluster model.Cluster, kubeconfigRaw string) apperrors.AppError
+	ConfigureRuntime(ctx context.Context, cluster model.Cluster, kubeconfigRaw string) apperrors.AppError
 }
 
 type configurator struct {
@@ -44,14 +44,14 @@ func NewRuntimeConfigurator(builder k8s.K8sClientProvider, directorClient direct
 	}
 }
 
-func (c *configurator) ConfigureRuntime(cluster model.Cluster, kubeconfigRaw string) apperrors.AppError {
+func (c *configurator) ConfigureRuntime(ctx context.Context, cluster model.Cluster, kubeconfigRaw string) apperrors.AppError {
 
-	token, err := c.getConnectionToken(cluster)
+	token, err := c.getConnectionToken(ctx, cluster)
 	if err != nil {
 		return err.Append("error getting one time token from Director")
 	}
 
-	err = c.configureAgent(cluster, token, runtimeAgentComponentNameSpace, kubeconfigRaw)
+	err = c.configureAgent(ctx, cluster, token, runtimeAgentComponentNameSpace, kubeconfigRaw)
 	if err != nil {
 		return err.Append("error configuring Runtime Agent")
 	}
@@ -59,7 +59,7 @@ func (c *configurator) ConfigureRuntime(cluster model.Cluster, kubeconfigRaw str
 	return nil
 }
 
-func (c *configurator) configureAgent(cluster model.Cluster, token graphql.OneTimeTokenForRuntimeExt, namespace, kubeconfigRaw string) apperrors.AppError {
+func (c *configurator) configureAgent(ctx context.Context, cluster model.Cluster, token graphql.OneTimeTokenForRuntimeExt, namespace, kubeconfigRaw string) apperrors.AppError {
 	var err apperrors.AppError
 
 	k8sClient, err := c.builder.CreateK8SClient(kubeconfigRaw)
@@ -75,7 +75,7 @@ func (c *configurator) configureAgent(cluster model.Cluster, token graphql.OneTi
 	}
 
 	err = util.RetryOnError(3*time.Second, 2, "Error while creating namespace for Runtime Agent configuration: %s", func() (err apperrors.AppError) {
-		err = c.createNamespace(k8sClient.CoreV1().Namespaces(), namespace)
+		err = c.createNamespace(ctx, k8sClient.CoreV1().Namespaces(), namespace)
 		return
 	})
 
@@ -90,15 +90,15 @@ func (c *configurator) configureAgent(cluster model.Cluster, token graphql.OneTi
 		},
 		StringData: configurationData,
 	}
-	return c.upsertSecret(k8sClient.CoreV1().Secrets(namespace), secret)
+	return c.upsertSecret(ctx, k8sClient.CoreV1().Secrets(namespace), secret)
 }
 
-func (c *configurator) getConnectionToken(cluster model.Cluster) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
+func (c *configurator) getConnectionToken(ctx context.Context, cluster model.Cluster) (graphql.OneTimeTokenForRuntimeExt, apperrors.AppError) {
 	var err apperrors.AppError
 	var token graphql.OneTimeTokenForRuntimeExt
 
 	err = util.RetryOnError(10*time.Second, 3, "Error while getting one time token from Director: %s", func() (err apperrors.AppError) {
-		token, err = c.directorClient.GetConnectionToken(cluster.ID, cluster.Tenant)
+		token, err = c.directorClient.GetConnectionToken(ctx, cluster.ID, cluster.Tenant)
 		return
 	})
 
@@ -109,11 +109,11 @@ func (c *configurator) getConnectionToken(cluster model.Cluster) (graphql.OneTim
 	return token, nil
 }
 
-func (c *configurator) createNamespace(namespaceInterface v1.NamespaceInterface, namespace string) apperrors.AppError {
+func (c *configurator) createNamespace(ctx context.Context, namespaceInterface v1.NamespaceInterface, namespace string) apperrors.AppError {
 	ns := &core.Namespace{
 		ObjectMeta: meta.ObjectMeta{Name: namespace},
 	}
-	_, err := namespaceInterface.Create(context.Background(), ns, meta.CreateOptions{})
+	_, err := namespaceInterface.Create(ctx, ns, meta.CreateOptions{})
 
 	if err != nil && !k8serrors.IsAlreadyExists(err) {
 		return util.K8SErrorToAppError(errors.Wrap(err, "Failed to create namespace"))
@@ -121,8 +121,8 @@ func (c *configurator) createNamespace(namespaceInterface v1.NamespaceInterface,
 	return nil
 }
 
-func (c *configurator) upsertSecret(secretInterface v1.SecretInterface, secret *core.Secret) apperrors.AppError {
-	_, err := secretInterface.Create(context.Background(), secret, meta.CreateOptions{})
+func (c *configurator) upsertSecret(ctx context.Context, secretInterface v1.SecretInterface, secret *core.Secret) apperrors.AppError {
+	_, err := secretInterface.Create(ctx, secret, meta.CreateOptions{})
 	if err == nil {
 		return nil
 	}
@@ -130,7 +130,7 @@ func (c *configurator) upsertSecret(secretInterface v1.SecretInterface, secret *
 		return util.K8SErrorToAppError(err).Append("error creating Secret on Runtime")
 	}
 
-	_, err = secretInterface.Update(context.Background(), secret, meta.UpdateOptions{})
+	_, err = secretInterface.Update(ctx, secret, meta.UpdateOptions{})
 	if err != nil {
 		return util.K8SErrorToAppError(err).Append("error updating Secret on Runtime")
 	}
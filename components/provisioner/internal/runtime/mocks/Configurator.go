@@ -1,9 +1,12 @@
-// Code generated by mockery v1.0.0. DO NOT EDIT.
+// Code generated by mockery v2.30.1. DO NOT EDIT.
 
 package mocks
 
 import (
+	context "context"
+
 	apperrors "github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/kyma-project/control-plane/components/provisioner/internal/model"
@@ -14,13 +17,13 @@ type Configurator struct {
 	mock.Mock
 }
 
-// ConfigureRuntime provides a mock function with given fields: cluster, kubeconfigRaw
-func (_m *Configurator) ConfigureRuntime(cluster model.Cluster, kubeconfigRaw string) apperrors.AppError {
-	ret := _m.Called(cluster, kubeconfigRaw)
+// ConfigureRuntime provides a mock function with given fields: ctx, cluster, kubeconfigRaw
+func (_m *Configurator) ConfigureRuntime(ctx context.Context, cluster model.Cluster, kubeconfigRaw string) apperrors.AppError {
+	ret := _m.Called(ctx, cluster, kubeconfigRaw)
 
 	var r0 apperrors.AppError
-	if rf, ok := ret.Get(0).(func(model.Cluster, string) apperrors.AppError); ok {
-		r0 = rf(cluster, kubeconfigRaw)
+	if rf, ok := ret.Get(0).(func(context.Context, model.Cluster, string) apperrors.AppError); ok {
+		r0 = rf(ctx, cluster, kubeconfigRaw)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(apperrors.AppError)
@@ -29,3 +32,17 @@ func (_m *Configurator) ConfigureRuntime(cluster model.Cluster, kubeconfigRaw st
 
 	return r0
 }
+
+// NewConfigurator creates a new instance of Configurator. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewConfigurator(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *Configurator {
+	mock := &Configurator{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
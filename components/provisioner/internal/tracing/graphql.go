@@ -0,0 +1,39 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// GraphQLExtension starts a span for every root query and mutation field resolved by the GraphQL server
+type GraphQLExtension struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = GraphQLExtension{}
+
+func (GraphQLExtension) ExtensionName() string {
+	return "OpenTelemetryTracing"
+}
+
+func (GraphQLExtension) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (GraphQLExtension) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fieldContext := graphql.GetFieldContext(ctx)
+	if fieldContext == nil || fieldContext.Parent != nil {
+		return next(ctx)
+	}
+
+	ctx, span := StartSpan(ctx, fmt.Sprintf("%s.%s", fieldContext.Object, fieldContext.Field.Name), attribute.String("graphql.field", fieldContext.Field.Name))
+
+	result, err := next(ctx)
+	EndSpan(span, err)
+
+	return result, err
+}
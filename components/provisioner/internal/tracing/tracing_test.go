@@ -0,0 +1,106 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupInMemoryTracing(t *testing.T) *tracetest.InMemoryExporter {
+	_, err := Setup(Config{})
+	require.NoError(t, err)
+
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	return exporter
+}
+
+func TestInjectExtract(t *testing.T) {
+	exporter := setupInMemoryTracing(t)
+
+	t.Run("should continue the trace from the stored trace context", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		ctx, requestSpan := StartSpan(context.Background(), "request")
+		traceContext := Inject(ctx)
+		requestSpan.End()
+
+		// when
+		_, stepSpan := StartSpan(Extract(context.Background(), traceContext), "step")
+		stepSpan.End()
+
+		// then
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 2)
+		assert.Equal(t, spans[0].SpanContext.TraceID(), spans[1].SpanContext.TraceID())
+		assert.Equal(t, spans[0].SpanContext.SpanID(), spans[1].Parent.SpanID())
+	})
+
+	t.Run("should not store trace context when there is no span", func(t *testing.T) {
+		// when
+		traceContext := Inject(context.Background())
+
+		// then
+		assert.Empty(t, traceContext)
+	})
+
+	t.Run("should start a new trace when the trace context is empty or invalid", func(t *testing.T) {
+		for _, traceContext := range []string{"", "{invalid"} {
+			// when
+			ctx := Extract(context.Background(), traceContext)
+
+			// then
+			assert.False(t, trace.SpanContextFromContext(ctx).IsValid())
+		}
+	})
+}
+
+func TestGraphQLExtension_InterceptField(t *testing.T) {
+	exporter := setupInMemoryTracing(t)
+
+	resolver := func(ctx context.Context) (interface{}, error) {
+		return "result", nil
+	}
+
+	t.Run("should start span for root field", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+			Object: "Mutation",
+			Field:  graphql.CollectedField{Field: &ast.Field{Name: "provisionRuntime"}},
+		})
+
+		// when
+		result, err := GraphQLExtension{}.InterceptField(ctx, resolver)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "result", result)
+		spans := exporter.GetSpans()
+		require.Len(t, spans, 1)
+		assert.Equal(t, "Mutation.provisionRuntime", spans[0].Name)
+	})
+
+	t.Run("should not start span for nested field", func(t *testing.T) {
+		// given
+		exporter.Reset()
+		ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{Object: "Query"})
+		ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{Object: "RuntimeStatus"})
+
+		// when
+		_, err := GraphQLExtension{}.InterceptField(ctx, resolver)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, exporter.GetSpans())
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/kyma-project/control-plane/components/provisioner/internal/apperrors"
+	"github.com/kyma-project/control-plane/components/provisioner/internal/tracing"
 	"github.com/kyma-project/control-plane/components/provisioner/internal/util"
 )
 
@@ -25,6 +26,8 @@ func (c *k8sClientBuilder) CreateK8SClient(kubeconfigRaw string) (kubernetes.Int
 	if err != nil {
 		return nil, util.K8SErrorToAppError(errors.Wrap(err, "failed to parse kubeconfig"))
 	}
+	// Requests to the Runtime join the trace of the operation passed in their context
+	k8sConfig.Wrap(tracing.NewTransport)
 
 	coreClientset, err := kubernetes.NewForConfig(k8sConfig)
 	if err != nil {
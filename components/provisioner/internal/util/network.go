@@ -0,0 +1,46 @@
+package util
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ParseCIDRs parses the CIDRs, empty entries are skipped
+func ParseCIDRs(cidrs []string) ([]*net.IPNet, error) {
+	networks := make([]*net.IPNet, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %s: %s", cidr, err.Error())
+		}
+		networks = append(networks, network)
+	}
+	return networks, nil
+}
+
+// CIDRsOverlap returns true if the networks share any address
+func CIDRsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+// CIDRContains returns true if all addresses of the inner network belong to the outer network
+func CIDRContains(outer, inner *net.IPNet) bool {
+	outerOnes, outerBits := outer.Mask.Size()
+	innerOnes, innerBits := inner.Mask.Size()
+	return outerBits == innerBits && outerOnes <= innerOnes && outer.Contains(inner.IP)
+}
+
+// CIDRAddressCount returns the number of addresses in the network, capped at the maximum uint32 value
+func CIDRAddressCount(network *net.IPNet) uint64 {
+	ones, bits := network.Mask.Size()
+	if bits-ones >= 32 {
+		return 1<<32 - 1
+	}
+	return 1 << uint(bits-ones)
+}
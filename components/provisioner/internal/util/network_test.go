@@ -0,0 +1,53 @@
+package util
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseCIDRs(t *testing.T) {
+	t.Run("should parse CIDRs and skip empty entries", func(t *testing.T) {
+		// when
+		networks, err := ParseCIDRs([]string{"10.250.0.0/16", " ", " 100.64.0.0/13 "})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, networks, 2)
+		assert.Equal(t, "10.250.0.0/16", networks[0].String())
+		assert.Equal(t, "100.64.0.0/13", networks[1].String())
+	})
+
+	t.Run("should return error when CIDR is invalid", func(t *testing.T) {
+		// when
+		_, err := ParseCIDRs([]string{"10.250.0.0/16", "10.10.10.10/255"})
+
+		// then
+		require.Error(t, err)
+	})
+}
+
+func Test_CIDRRelations(t *testing.T) {
+	vpc := mustParseCIDR(t, "10.250.0.0/16")
+	subnet := mustParseCIDR(t, "10.250.32.0/19")
+	other := mustParseCIDR(t, "10.251.0.0/16")
+
+	assert.True(t, CIDRsOverlap(vpc, subnet))
+	assert.True(t, CIDRsOverlap(subnet, vpc))
+	assert.False(t, CIDRsOverlap(vpc, other))
+
+	assert.True(t, CIDRContains(vpc, subnet))
+	assert.False(t, CIDRContains(subnet, vpc))
+	assert.False(t, CIDRContains(vpc, other))
+
+	assert.Equal(t, uint64(8192), CIDRAddressCount(subnet))
+	assert.Equal(t, uint64(1<<32-1), CIDRAddressCount(mustParseCIDR(t, "fd00::/64")))
+}
+
+func mustParseCIDR(t *testing.T, cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	require.NoError(t, err)
+	return network
+}
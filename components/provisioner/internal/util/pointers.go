@@ -14,6 +14,11 @@ func IntPtr(val int) *int {
 	return &val
 }
 
+// Int32Ptr returns pointer to given int32
+func Int32Ptr(val int32) *int32 {
+	return &val
+}
+
 // StringPtr returns pointer to given string
 func StringPtr(str string) *string {
 	return &str
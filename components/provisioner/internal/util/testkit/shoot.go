@@ -92,6 +92,16 @@ func (ts *TestShoot) WithExposureClassName(exposureClassName string) *TestShoot
 	return ts
 }
 
+// WithControlPlaneFailureTolerance sets shoot.Spec.ControlPlane.HighAvailability.FailureTolerance.Type
+func (ts *TestShoot) WithControlPlaneFailureTolerance(failureTolerance string) *TestShoot {
+	ts.shoot.Spec.ControlPlane = &v1beta1.ControlPlane{
+		HighAvailability: &v1beta1.HighAvailability{
+			FailureTolerance: v1beta1.FailureTolerance{Type: v1beta1.FailureToleranceType(failureTolerance)},
+		},
+	}
+	return ts
+}
+
 // WithWorkers adds v1beta1 Workers to shoot.Spec.Provider.Workers.
 // See also testkit.TestWorker
 func (ts *TestShoot) WithWorkers(workers ...v1beta1.Worker) *TestShoot {
@@ -185,6 +195,12 @@ func (ts *TestShoot) WithHibernationState(hibernationPossible bool, hibernated b
 	return ts
 }
 
+// WithHibernationEnabled sets shoot.Spec.Hibernation.Enabled
+func (ts *TestShoot) WithHibernationEnabled(enabled bool) *TestShoot {
+	ts.shoot.Spec.Hibernation = &v1beta1.Hibernation{Enabled: &enabled}
+	return ts
+}
+
 // WithPSPAdmissionPluginDisabled sets shoot.Status.LastOperation to nil
 func (ts *TestShoot) WithPSPAdmissionPluginDisabled() *TestShoot {
 	disable := true
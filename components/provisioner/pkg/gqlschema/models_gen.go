@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"time"
 )
 
 type ProviderSpecificConfig interface {
@@ -38,6 +39,28 @@ type AWSZoneInput struct {
 	WorkerCidr   string `json:"workerCidr"`
 }
 
+type AlicloudProviderConfig struct {
+	VpcCidr       string          `json:"vpcCidr"`
+	AlicloudZones []*AlicloudZone `json:"alicloudZones"`
+}
+
+func (AlicloudProviderConfig) IsProviderSpecificConfig() {}
+
+type AlicloudProviderConfigInput struct {
+	VpcCidr       string               `json:"vpcCidr"`
+	AlicloudZones []*AlicloudZoneInput `json:"alicloudZones"`
+}
+
+type AlicloudZone struct {
+	Name       string `json:"name"`
+	WorkerCidr string `json:"workerCidr"`
+}
+
+type AlicloudZoneInput struct {
+	Name       string `json:"name"`
+	WorkerCidr string `json:"workerCidr"`
+}
+
 type AzureProviderConfig struct {
 	VnetCidr                     *string      `json:"vnetCidr"`
 	Zones                        []string     `json:"zones"`
@@ -122,10 +145,50 @@ type DNSProviderInput struct {
 	Type           string   `json:"type"`
 }
 
+type DryRunResult struct {
+	Shoot string  `json:"shoot"`
+	Diff  *string `json:"diff"`
+}
+
 type Error struct {
 	Message *string `json:"message"`
 }
 
+type EvictionThresholds struct {
+	MemoryAvailable   *string `json:"memoryAvailable"`
+	ImageFSAvailable  *string `json:"imageFSAvailable"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree"`
+}
+
+type EvictionThresholdsInput struct {
+	MemoryAvailable   *string `json:"memoryAvailable"`
+	ImageFSAvailable  *string `json:"imageFSAvailable"`
+	ImageFSInodesFree *string `json:"imageFSInodesFree"`
+	NodeFSAvailable   *string `json:"nodeFSAvailable"`
+	NodeFSInodesFree  *string `json:"nodeFSInodesFree"`
+}
+
+type ExpiringVersion struct {
+	Name           *string    `json:"name"`
+	Version        string     `json:"version"`
+	ExpirationDate *time.Time `json:"expirationDate"`
+	Expired        bool       `json:"expired"`
+}
+
+type Extension struct {
+	Type           string  `json:"type"`
+	Disabled       *bool   `json:"disabled"`
+	ProviderConfig *string `json:"providerConfig"`
+}
+
+type ExtensionInput struct {
+	Type           string  `json:"type"`
+	Disabled       *bool   `json:"disabled"`
+	ProviderConfig *string `json:"providerConfig"`
+}
+
 type GCPProviderConfig struct {
 	Zones []string `json:"zones"`
 }
@@ -166,58 +229,92 @@ type GardenerConfig struct {
 	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
 	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
 	EuAccess                            *bool                  `json:"euAccess"`
+	HibernationSchedules                []*HibernationSchedule `json:"hibernationSchedules"`
+	AdditionalWorkerPools               []*WorkerPool          `json:"additionalWorkerPools"`
+	WorkerLabels                        Labels                 `json:"workerLabels"`
+	WorkerTaints                        []*Taint               `json:"workerTaints"`
+	KubeletConfig                       *KubeletConfig         `json:"kubeletConfig"`
+	CriName                             *string                `json:"criName"`
+	Extensions                          []*Extension           `json:"extensions"`
 }
 
 type GardenerConfigInput struct {
-	Name                                string                 `json:"name"`
-	KubernetesVersion                   string                 `json:"kubernetesVersion"`
-	Provider                            string                 `json:"provider"`
-	TargetSecret                        string                 `json:"targetSecret"`
-	Region                              string                 `json:"region"`
-	MachineType                         string                 `json:"machineType"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	WorkerCidr                          string                 `json:"workerCidr"`
-	PodsCidr                            *string                `json:"podsCidr"`
-	ServicesCidr                        *string                `json:"servicesCidr"`
-	AutoScalerMin                       int                    `json:"autoScalerMin"`
-	AutoScalerMax                       int                    `json:"autoScalerMax"`
-	MaxSurge                            int                    `json:"maxSurge"`
-	MaxUnavailable                      int                    `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	LicenceType                         *string                `json:"licenceType"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	DNSConfig                           *DNSConfigInput        `json:"dnsConfig"`
-	Seed                                *string                `json:"seed"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
-	ControlPlaneFailureTolerance        *string                `json:"controlPlaneFailureTolerance"`
-	EuAccess                            *bool                  `json:"euAccess"`
+	Name                                string                      `json:"name"`
+	KubernetesVersion                   string                      `json:"kubernetesVersion"`
+	Provider                            string                      `json:"provider"`
+	TargetSecret                        string                      `json:"targetSecret"`
+	Region                              string                      `json:"region"`
+	MachineType                         string                      `json:"machineType"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	WorkerCidr                          string                      `json:"workerCidr"`
+	PodsCidr                            *string                     `json:"podsCidr"`
+	ServicesCidr                        *string                     `json:"servicesCidr"`
+	AutoScalerMin                       int                         `json:"autoScalerMin"`
+	AutoScalerMax                       int                         `json:"autoScalerMax"`
+	MaxSurge                            int                         `json:"maxSurge"`
+	MaxUnavailable                      int                         `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	LicenceType                         *string                     `json:"licenceType"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	DNSConfig                           *DNSConfigInput             `json:"dnsConfig"`
+	Seed                                *string                     `json:"seed"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	ControlPlaneFailureTolerance        *string                     `json:"controlPlaneFailureTolerance"`
+	EuAccess                            *bool                       `json:"euAccess"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	AdditionalWorkerPools               []*WorkerPoolInput          `json:"additionalWorkerPools"`
+	WorkerLabels                        Labels                      `json:"workerLabels"`
+	WorkerTaints                        []*TaintInput               `json:"workerTaints"`
+	KubeletConfig                       *KubeletConfigInput         `json:"kubeletConfig"`
+	CriName                             *string                     `json:"criName"`
+	Extensions                          []*ExtensionInput           `json:"extensions"`
 }
 
 type GardenerUpgradeInput struct {
-	KubernetesVersion                   *string                `json:"kubernetesVersion"`
-	MachineType                         *string                `json:"machineType"`
-	DiskType                            *string                `json:"diskType"`
-	VolumeSizeGb                        *int                   `json:"volumeSizeGB"`
-	AutoScalerMin                       *int                   `json:"autoScalerMin"`
-	AutoScalerMax                       *int                   `json:"autoScalerMax"`
-	MachineImage                        *string                `json:"machineImage"`
-	MachineImageVersion                 *string                `json:"machineImageVersion"`
-	MaxSurge                            *int                   `json:"maxSurge"`
-	MaxUnavailable                      *int                   `json:"maxUnavailable"`
-	Purpose                             *string                `json:"purpose"`
-	EnableKubernetesVersionAutoUpdate   *bool                  `json:"enableKubernetesVersionAutoUpdate"`
-	EnableMachineImageVersionAutoUpdate *bool                  `json:"enableMachineImageVersionAutoUpdate"`
-	ProviderSpecificConfig              *ProviderSpecificInput `json:"providerSpecificConfig"`
-	OidcConfig                          *OIDCConfigInput       `json:"oidcConfig"`
-	ExposureClassName                   *string                `json:"exposureClassName"`
-	ShootNetworkingFilterDisabled       *bool                  `json:"shootNetworkingFilterDisabled"`
+	KubernetesVersion                   *string                     `json:"kubernetesVersion"`
+	MachineType                         *string                     `json:"machineType"`
+	DiskType                            *string                     `json:"diskType"`
+	VolumeSizeGb                        *int                        `json:"volumeSizeGB"`
+	AutoScalerMin                       *int                        `json:"autoScalerMin"`
+	AutoScalerMax                       *int                        `json:"autoScalerMax"`
+	MachineImage                        *string                     `json:"machineImage"`
+	MachineImageVersion                 *string                     `json:"machineImageVersion"`
+	MaxSurge                            *int                        `json:"maxSurge"`
+	MaxUnavailable                      *int                        `json:"maxUnavailable"`
+	Purpose                             *string                     `json:"purpose"`
+	EnableKubernetesVersionAutoUpdate   *bool                       `json:"enableKubernetesVersionAutoUpdate"`
+	EnableMachineImageVersionAutoUpdate *bool                       `json:"enableMachineImageVersionAutoUpdate"`
+	ProviderSpecificConfig              *ProviderSpecificInput      `json:"providerSpecificConfig"`
+	OidcConfig                          *OIDCConfigInput            `json:"oidcConfig"`
+	ExposureClassName                   *string                     `json:"exposureClassName"`
+	ShootNetworkingFilterDisabled       *bool                       `json:"shootNetworkingFilterDisabled"`
+	HibernationSchedules                []*HibernationScheduleInput `json:"hibernationSchedules"`
+	AdditionalWorkerPools               []*WorkerPoolInput          `json:"additionalWorkerPools"`
+	WorkerLabels                        Labels                      `json:"workerLabels"`
+	WorkerTaints                        []*TaintInput               `json:"workerTaints"`
+	KubeletConfig                       *KubeletConfigInput         `json:"kubeletConfig"`
+	CriName                             *string                     `json:"criName"`
+	ControlPlaneFailureTolerance        *string                     `json:"controlPlaneFailureTolerance"`
+	Extensions                          []*ExtensionInput           `json:"extensions"`
+}
+
+type HibernationSchedule struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
+}
+
+type HibernationScheduleInput struct {
+	Start    *string `json:"start"`
+	End      *string `json:"end"`
+	Location *string `json:"location"`
 }
 
 type HibernationStatus struct {
@@ -225,6 +322,20 @@ type HibernationStatus struct {
 	HibernationPossible *bool `json:"hibernationPossible"`
 }
 
+type KubeletConfig struct {
+	MaxPods                 *int                `json:"maxPods"`
+	EvictionHard            *EvictionThresholds `json:"evictionHard"`
+	EvictionSoft            *EvictionThresholds `json:"evictionSoft"`
+	EvictionSoftGracePeriod *EvictionThresholds `json:"evictionSoftGracePeriod"`
+}
+
+type KubeletConfigInput struct {
+	MaxPods                 *int                     `json:"maxPods"`
+	EvictionHard            *EvictionThresholdsInput `json:"evictionHard"`
+	EvictionSoft            *EvictionThresholdsInput `json:"evictionSoft"`
+	EvictionSoftGracePeriod *EvictionThresholdsInput `json:"evictionSoftGracePeriod"`
+}
+
 type KymaConfig struct {
 	Version       *string                   `json:"version"`
 	Profile       *KymaProfile              `json:"profile"`
@@ -264,29 +375,88 @@ type OIDCConfigInput struct {
 	UsernamePrefix string   `json:"usernamePrefix"`
 }
 
+type OpenStackLoadBalancerClass struct {
+	Name              string  `json:"name"`
+	FloatingSubnetID  *string `json:"floatingSubnetID"`
+	FloatingNetworkID *string `json:"floatingNetworkID"`
+	SubnetID          *string `json:"subnetID"`
+}
+
+type OpenStackLoadBalancerClassInput struct {
+	Name              string  `json:"name"`
+	FloatingSubnetID  *string `json:"floatingSubnetID"`
+	FloatingNetworkID *string `json:"floatingNetworkID"`
+	SubnetID          *string `json:"subnetID"`
+}
+
 type OpenStackProviderConfig struct {
-	Zones                []string `json:"zones"`
-	FloatingPoolName     string   `json:"floatingPoolName"`
-	CloudProfileName     string   `json:"cloudProfileName"`
-	LoadBalancerProvider string   `json:"loadBalancerProvider"`
+	Zones                  []string                      `json:"zones"`
+	FloatingPoolName       string                        `json:"floatingPoolName"`
+	CloudProfileName       string                        `json:"cloudProfileName"`
+	LoadBalancerProvider   string                        `json:"loadBalancerProvider"`
+	FloatingPoolSubnetName *string                       `json:"floatingPoolSubnetName"`
+	RouterID               *string                       `json:"routerID"`
+	NetworkID              *string                       `json:"networkID"`
+	SubnetID               *string                       `json:"subnetID"`
+	ServerGroupPolicy      *string                       `json:"serverGroupPolicy"`
+	LoadBalancerClasses    []*OpenStackLoadBalancerClass `json:"loadBalancerClasses"`
 }
 
 func (OpenStackProviderConfig) IsProviderSpecificConfig() {}
 
 type OpenStackProviderConfigInput struct {
-	Zones                []string `json:"zones"`
-	FloatingPoolName     string   `json:"floatingPoolName"`
-	CloudProfileName     string   `json:"cloudProfileName"`
-	LoadBalancerProvider string   `json:"loadBalancerProvider"`
+	Zones                  []string                           `json:"zones"`
+	FloatingPoolName       string                             `json:"floatingPoolName"`
+	CloudProfileName       string                             `json:"cloudProfileName"`
+	LoadBalancerProvider   string                             `json:"loadBalancerProvider"`
+	FloatingPoolSubnetName *string                            `json:"floatingPoolSubnetName"`
+	RouterID               *string                            `json:"routerID"`
+	NetworkID              *string                            `json:"networkID"`
+	SubnetID               *string                            `json:"subnetID"`
+	ServerGroupPolicy      *string                            `json:"serverGroupPolicy"`
+	LoadBalancerClasses    []*OpenStackLoadBalancerClassInput `json:"loadBalancerClasses"`
+}
+
+type OperationPage struct {
+	Data     []*OperationStatus `json:"data"`
+	PageInfo *PageInfo          `json:"pageInfo"`
 }
 
 type OperationStatus struct {
-	ID        *string        `json:"id"`
-	Operation OperationType  `json:"operation"`
-	State     OperationState `json:"state"`
-	Message   *string        `json:"message"`
-	RuntimeID *string        `json:"runtimeID"`
-	LastError *LastError     `json:"lastError"`
+	ID             *string              `json:"id"`
+	Operation      OperationType        `json:"operation"`
+	State          OperationState       `json:"state"`
+	Message        *string              `json:"message"`
+	RuntimeID      *string              `json:"runtimeID"`
+	LastError      *LastError           `json:"lastError"`
+	StageHistory   []*StageHistoryEntry `json:"stageHistory"`
+	DryRunResult   *DryRunResult        `json:"dryRunResult"`
+	Warnings       []*UpgradeWarning    `json:"warnings"`
+	StartTimestamp *time.Time           `json:"startTimestamp"`
+	EndTimestamp   *time.Time           `json:"endTimestamp"`
+}
+
+type OperationsFilter struct {
+	RuntimeID       *string         `json:"runtimeID"`
+	Tenant          *string         `json:"tenant"`
+	SubAccountID    *string         `json:"subAccountID"`
+	Provider        *string         `json:"provider"`
+	Region          *string         `json:"region"`
+	State           *OperationState `json:"state"`
+	Type            *OperationType  `json:"type"`
+	StartedAfter    *time.Time      `json:"startedAfter"`
+	StartedBefore   *time.Time      `json:"startedBefore"`
+	LastErrorReason *string         `json:"lastErrorReason"`
+}
+
+type PageInfo struct {
+	EndCursor   *string `json:"endCursor"`
+	HasNextPage bool    `json:"hasNextPage"`
+}
+
+type PageInput struct {
+	First *int    `json:"first"`
+	After *string `json:"after"`
 }
 
 type ProviderSpecificInput struct {
@@ -294,6 +464,7 @@ type ProviderSpecificInput struct {
 	AzureConfig     *AzureProviderConfigInput     `json:"azureConfig"`
 	AwsConfig       *AWSProviderConfigInput       `json:"awsConfig"`
 	OpenStackConfig *OpenStackProviderConfigInput `json:"openStackConfig"`
+	AlicloudConfig  *AlicloudProviderConfigInput  `json:"alicloudConfig"`
 }
 
 type ProvisionRuntimeInput struct {
@@ -319,6 +490,11 @@ type RuntimeInput struct {
 	Labels      Labels  `json:"labels"`
 }
 
+type RuntimePage struct {
+	Data     []*RuntimeSummary `json:"data"`
+	PageInfo *PageInfo         `json:"pageInfo"`
+}
+
 type RuntimeStatus struct {
 	LastOperationStatus     *OperationStatus         `json:"lastOperationStatus"`
 	RuntimeConnectionStatus *RuntimeConnectionStatus `json:"runtimeConnectionStatus"`
@@ -326,6 +502,59 @@ type RuntimeStatus struct {
 	HibernationStatus       *HibernationStatus       `json:"hibernationStatus"`
 }
 
+type RuntimeSummary struct {
+	RuntimeID         string           `json:"runtimeID"`
+	Tenant            string           `json:"tenant"`
+	SubAccountID      *string          `json:"subAccountID"`
+	ShootName         string           `json:"shootName"`
+	Provider          string           `json:"provider"`
+	Region            string           `json:"region"`
+	KubernetesVersion string           `json:"kubernetesVersion"`
+	CreationTimestamp time.Time        `json:"creationTimestamp"`
+	Deleted           bool             `json:"deleted"`
+	LastOperation     *OperationStatus `json:"lastOperation"`
+}
+
+type RuntimeVersionDrift struct {
+	RuntimeID         string             `json:"runtimeID"`
+	ShootName         string             `json:"shootName"`
+	KubernetesVersion *ExpiringVersion   `json:"kubernetesVersion"`
+	MachineImages     []*ExpiringVersion `json:"machineImages"`
+}
+
+type RuntimesFilter struct {
+	Tenant          *string         `json:"tenant"`
+	SubAccountID    *string         `json:"subAccountID"`
+	Provider        *string         `json:"provider"`
+	Region          *string         `json:"region"`
+	State           *OperationState `json:"state"`
+	OperationType   *OperationType  `json:"operationType"`
+	CreatedAfter    *time.Time      `json:"createdAfter"`
+	CreatedBefore   *time.Time      `json:"createdBefore"`
+	LastErrorReason *string         `json:"lastErrorReason"`
+	IncludeDeleted  *bool           `json:"includeDeleted"`
+}
+
+type StageHistoryEntry struct {
+	Stage     string     `json:"stage"`
+	Message   *string    `json:"message"`
+	Timestamp time.Time  `json:"timestamp"`
+	LastError *LastError `json:"lastError"`
+	Retries   int        `json:"retries"`
+}
+
+type Taint struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value"`
+	Effect string  `json:"effect"`
+}
+
+type TaintInput struct {
+	Key    string  `json:"key"`
+	Value  *string `json:"value"`
+	Effect string  `json:"effect"`
+}
+
 type UpgradeRuntimeInput struct {
 	KymaConfig *KymaConfigInput `json:"kymaConfig"`
 }
@@ -335,6 +564,53 @@ type UpgradeShootInput struct {
 	Administrators []string              `json:"administrators"`
 }
 
+type UpgradeWarning struct {
+	Code    UpgradeWarningCode `json:"code"`
+	Message string             `json:"message"`
+}
+
+type VersionDriftGroup struct {
+	Provider string                 `json:"provider"`
+	Region   string                 `json:"region"`
+	Runtimes []*RuntimeVersionDrift `json:"runtimes"`
+}
+
+type WorkerPool struct {
+	Name                string         `json:"name"`
+	MachineType         string         `json:"machineType"`
+	MachineImage        *string        `json:"machineImage"`
+	MachineImageVersion *string        `json:"machineImageVersion"`
+	DiskType            *string        `json:"diskType"`
+	VolumeSizeGb        *int           `json:"volumeSizeGB"`
+	AutoScalerMin       int            `json:"autoScalerMin"`
+	AutoScalerMax       int            `json:"autoScalerMax"`
+	MaxSurge            int            `json:"maxSurge"`
+	MaxUnavailable      int            `json:"maxUnavailable"`
+	Zones               []string       `json:"zones"`
+	Labels              Labels         `json:"labels"`
+	Taints              []*Taint       `json:"taints"`
+	KubeletConfig       *KubeletConfig `json:"kubeletConfig"`
+	CriName             *string        `json:"criName"`
+}
+
+type WorkerPoolInput struct {
+	Name                string              `json:"name"`
+	MachineType         string              `json:"machineType"`
+	MachineImage        *string             `json:"machineImage"`
+	MachineImageVersion *string             `json:"machineImageVersion"`
+	DiskType            *string             `json:"diskType"`
+	VolumeSizeGb        *int                `json:"volumeSizeGB"`
+	AutoScalerMin       int                 `json:"autoScalerMin"`
+	AutoScalerMax       int                 `json:"autoScalerMax"`
+	MaxSurge            int                 `json:"maxSurge"`
+	MaxUnavailable      int                 `json:"maxUnavailable"`
+	Zones               []string            `json:"zones"`
+	Labels              Labels              `json:"labels"`
+	Taints              []*TaintInput       `json:"taints"`
+	KubeletConfig       *KubeletConfigInput `json:"kubeletConfig"`
+	CriName             *string             `json:"criName"`
+}
+
 type ConflictStrategy string
 
 const (
@@ -424,6 +700,7 @@ const (
 	OperationStateInProgress OperationState = "InProgress"
 	OperationStateSucceeded  OperationState = "Succeeded"
 	OperationStateFailed     OperationState = "Failed"
+	OperationStateCancelled  OperationState = "Cancelled"
 )
 
 var AllOperationState = []OperationState{
@@ -431,11 +708,12 @@ var AllOperationState = []OperationState{
 	OperationStateInProgress,
 	OperationStateSucceeded,
 	OperationStateFailed,
+	OperationStateCancelled,
 }
 
 func (e OperationState) IsValid() bool {
 	switch e {
-	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed:
+	case OperationStatePending, OperationStateInProgress, OperationStateSucceeded, OperationStateFailed, OperationStateCancelled:
 		return true
 	}
 	return false
@@ -473,6 +751,7 @@ const (
 	OperationTypeDeprovisionNoInstall OperationType = "DeprovisionNoInstall"
 	OperationTypeReconnectRuntime     OperationType = "ReconnectRuntime"
 	OperationTypeHibernate            OperationType = "Hibernate"
+	OperationTypeWakeUp               OperationType = "WakeUp"
 )
 
 var AllOperationType = []OperationType{
@@ -484,11 +763,12 @@ var AllOperationType = []OperationType{
 	OperationTypeDeprovisionNoInstall,
 	OperationTypeReconnectRuntime,
 	OperationTypeHibernate,
+	OperationTypeWakeUp,
 }
 
 func (e OperationType) IsValid() bool {
 	switch e {
-	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate:
+	case OperationTypeProvision, OperationTypeProvisionNoInstall, OperationTypeUpgrade, OperationTypeUpgradeShoot, OperationTypeDeprovision, OperationTypeDeprovisionNoInstall, OperationTypeReconnectRuntime, OperationTypeHibernate, OperationTypeWakeUp:
 		return true
 	}
 	return false
@@ -557,3 +837,52 @@ func (e *RuntimeAgentConnectionStatus) UnmarshalGQL(v interface{}) error {
 func (e RuntimeAgentConnectionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type UpgradeWarningCode string
+
+const (
+	UpgradeWarningCodeKubernetesVersionDeprecated   UpgradeWarningCode = "KUBERNETES_VERSION_DEPRECATED"
+	UpgradeWarningCodeKubernetesVersionExpiring     UpgradeWarningCode = "KUBERNETES_VERSION_EXPIRING"
+	UpgradeWarningCodeMachineImageVersionDeprecated UpgradeWarningCode = "MACHINE_IMAGE_VERSION_DEPRECATED"
+	UpgradeWarningCodeMachineImageVersionExpiring   UpgradeWarningCode = "MACHINE_IMAGE_VERSION_EXPIRING"
+	UpgradeWarningCodeRemovedAPIInUse               UpgradeWarningCode = "REMOVED_API_IN_USE"
+	UpgradeWarningCodeRemovedAPICheckSkipped        UpgradeWarningCode = "REMOVED_API_CHECK_SKIPPED"
+)
+
+var AllUpgradeWarningCode = []UpgradeWarningCode{
+	UpgradeWarningCodeKubernetesVersionDeprecated,
+	UpgradeWarningCodeKubernetesVersionExpiring,
+	UpgradeWarningCodeMachineImageVersionDeprecated,
+	UpgradeWarningCodeMachineImageVersionExpiring,
+	UpgradeWarningCodeRemovedAPIInUse,
+	UpgradeWarningCodeRemovedAPICheckSkipped,
+}
+
+func (e UpgradeWarningCode) IsValid() bool {
+	switch e {
+	case UpgradeWarningCodeKubernetesVersionDeprecated, UpgradeWarningCodeKubernetesVersionExpiring, UpgradeWarningCodeMachineImageVersionDeprecated, UpgradeWarningCodeMachineImageVersionExpiring, UpgradeWarningCodeRemovedAPIInUse, UpgradeWarningCodeRemovedAPICheckSkipped:
+		return true
+	}
+	return false
+}
+
+func (e UpgradeWarningCode) String() string {
+	return string(e)
+}
+
+func (e *UpgradeWarningCode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UpgradeWarningCode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UpgradeWarningCode", str)
+	}
+	return nil
+}
+
+func (e UpgradeWarningCode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
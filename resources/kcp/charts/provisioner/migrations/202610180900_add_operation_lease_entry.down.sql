@@ -0,0 +1,5 @@
+BEGIN;
+ALTER TABLE operation DROP COLUMN lease_owner;
+ALTER TABLE operation DROP COLUMN lease_heartbeat;
+ALTER TABLE operation DROP COLUMN lease_expiration;
+COMMIT;
\ No newline at end of file
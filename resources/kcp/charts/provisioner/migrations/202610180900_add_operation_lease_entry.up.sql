@@ -0,0 +1,5 @@
+BEGIN;
+ALTER TABLE operation ADD COLUMN lease_owner varchar(256);
+ALTER TABLE operation ADD COLUMN lease_heartbeat timestamp without time zone;
+ALTER TABLE operation ADD COLUMN lease_expiration timestamp without time zone;
+COMMIT;
\ No newline at end of file
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE operation DROP COLUMN cancel_requested;
+
+UPDATE operation SET state = 'FAILED' WHERE state = 'CANCELLED';
+
+ALTER TYPE operation_state RENAME TO operation_state_old;
+
+CREATE TYPE operation_state AS ENUM (
+    'IN_PROGRESS',
+    'SUCCEEDED',
+    'FAILED'
+    );
+
+ALTER TABLE operation ALTER COLUMN state TYPE operation_state USING state::text::operation_state;
+
+DROP TYPE operation_state_old;
+
+COMMIT;
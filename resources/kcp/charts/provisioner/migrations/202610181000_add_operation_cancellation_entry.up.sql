@@ -0,0 +1,2 @@
+ALTER TYPE operation_state ADD VALUE 'CANCELLED' AFTER 'FAILED';
+ALTER TABLE operation ADD COLUMN cancel_requested boolean NOT NULL DEFAULT false;
\ No newline at end of file
@@ -0,0 +1,3 @@
+BEGIN;
+DROP TABLE operation_stage_history;
+COMMIT;
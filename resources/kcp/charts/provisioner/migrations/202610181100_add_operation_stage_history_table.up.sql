@@ -0,0 +1,15 @@
+BEGIN;
+CREATE TABLE operation_stage_history
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE,
+    stage varchar(256) NOT NULL,
+    message text,
+    event_timestamp timestamp without time zone NOT NULL,
+    err_message text NOT NULL,
+    reason text NOT NULL,
+    component text NOT NULL
+);
+CREATE INDEX operation_stage_history_operation_id_idx ON operation_stage_history (operation_id);
+COMMIT;
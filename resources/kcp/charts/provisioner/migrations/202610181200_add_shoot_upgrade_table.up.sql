@@ -0,0 +1,9 @@
+BEGIN;
+CREATE TABLE shoot_upgrade
+(
+    id uuid PRIMARY KEY CHECK (id <> '00000000-0000-0000-0000-000000000000'),
+    operation_id uuid NOT NULL UNIQUE,
+    foreign key (operation_id) REFERENCES operation (id) ON DELETE CASCADE,
+    pre_upgrade_gardener_config jsonb NOT NULL
+);
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+ALTER TABLE worker_pool DROP COLUMN cri_name;
+ALTER TABLE worker_pool DROP COLUMN kubelet_config;
+ALTER TABLE gardener_config DROP COLUMN cri_name;
+ALTER TABLE gardener_config DROP COLUMN kubelet_config;
+ALTER TABLE gardener_config DROP COLUMN worker_taints;
+ALTER TABLE gardener_config DROP COLUMN worker_labels;
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+ALTER TABLE gardener_config ADD COLUMN worker_labels jsonb;
+ALTER TABLE gardener_config ADD COLUMN worker_taints jsonb;
+ALTER TABLE gardener_config ADD COLUMN kubelet_config jsonb;
+ALTER TABLE gardener_config ADD COLUMN cri_name varchar(256);
+ALTER TABLE worker_pool ADD COLUMN kubelet_config jsonb;
+ALTER TABLE worker_pool ADD COLUMN cri_name varchar(256);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation DROP COLUMN trace_context;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation ADD COLUMN trace_context text NOT NULL DEFAULT '';
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+DROP INDEX operation_cluster_id_start_timestamp_idx;
+DROP INDEX operation_start_timestamp_idx;
+DROP INDEX cluster_creation_timestamp_idx;
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+CREATE INDEX operation_cluster_id_start_timestamp_idx ON operation (cluster_id, start_timestamp);
+CREATE INDEX operation_start_timestamp_idx ON operation (start_timestamp, id);
+CREATE INDEX cluster_creation_timestamp_idx ON cluster (creation_timestamp, id);
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation ADD COLUMN resources_reverted boolean NOT NULL DEFAULT false;
+COMMIT;
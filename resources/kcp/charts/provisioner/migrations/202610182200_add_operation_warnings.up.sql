@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation ADD COLUMN warnings jsonb;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation_stage_history DROP COLUMN retries;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+ALTER TABLE operation_stage_history ADD COLUMN retries integer NOT NULL DEFAULT 0;
+COMMIT;